@@ -0,0 +1,88 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+// Annotations understood on OAM objects.
+const (
+	// AnnotationDeletionProtection set to true on a workload prevents it from
+	// being deleted.
+	AnnotationDeletionProtection = "app.oam.dev/deletion-protection"
+
+	// AnnotationForceSync set to a new value, e.g. the current time, makes
+	// the controller of a workload or trait re-apply its changes right away,
+	// even if the writes of its namespace are being rate limited.
+	AnnotationForceSync = "oam.dev/force-sync"
+
+	// AnnotationTraitManagedFields on a workload's child records, by trait
+	// kind and name, the JSON pointers of the fields each trait set along
+	// with their values before and after. The elements of lists merged on a
+	// key are addressed by key=value, e.g. containers/name=web. The fields are
+	// reverted when the trait is deleted.
+	AnnotationTraitManagedFields = "core.oam.dev/trait-managed-fields"
+
+	// AnnotationMaintenanceWindows on a namespace lists comma separated
+	// <start>/<end> intervals of RFC 3339 times, e.g.
+	// 2020-12-24T00:00:00Z/2020-12-27T00:00:00Z, during which the workloads
+	// and traits of the namespace are not changed. Changes made to them in
+	// the meantime are applied once the window ends.
+	AnnotationMaintenanceWindows = "core.oam.dev/maintenance-windows"
+
+	// AnnotationAllowedPriorityClasses on a namespace lists, comma separated,
+	// the priority classes PriorityClassTraits of the namespace may set. Any
+	// class is allowed in namespaces without it.
+	AnnotationAllowedPriorityClasses = "core.oam.dev/allowed-priority-classes"
+
+	// AnnotationLastModifiedBy on a workload or trait names the user who last
+	// created or changed its spec, as the admission webhook saw them. Scale
+	// events and the scale reason of a deployment include it.
+	AnnotationLastModifiedBy = "core.oam.dev/last-modified-by"
+
+	// AnnotationDependsOn on a trait lists, comma separated, the <kind>/<name>
+	// of other traits of its workload, e.g. CertTrait/web-cert, it is applied
+	// after. The trait waits until they are all synced.
+	AnnotationDependsOn = "core.oam.dev/depends-on"
+
+	// AnnotationKubeconfigContext on a ContainerizedWorkload names the
+	// context of the manager's --dev-kubeconfig, e.g. kind-staging, whose
+	// cluster the workload's children are applied to. Traits do not apply
+	// to such a workload. It is ignored unless the manager runs with
+	// --dev-kubeconfig.
+	AnnotationKubeconfigContext = "core.oam.dev/kubeconfig-context"
+
+	// AnnotationDeletionPropagation on a workload or trait selects how the
+	// resources created for it are garbage collected when it is deleted:
+	// Background, the default, deletes them and lets their own dependents be
+	// collected in the background, Foreground keeps the workload or trait
+	// until they and their dependents are gone, and Orphan leaves them in
+	// place, without their owner reference, e.g. to hand them over to manual
+	// management.
+	AnnotationDeletionPropagation = "core.oam.dev/deletion-propagation"
+
+	// AnnotationConfigChecksum on the pod template of the deployment of a
+	// ContainerizedWorkload that reloads on config changes is a checksum of
+	// the ConfigMaps and Secrets its containers refer to. The deployment
+	// rolls out whenever the checksum changes.
+	AnnotationConfigChecksum = "core.oam.dev/config-checksum"
+)
+
+// Labels understood on the objects OAM objects refer to.
+const (
+	// LabelReloadWorkloads set to true on a ConfigMap or Secret rolls out
+	// the ContainerizedWorkloads that reload on config changes and refer to
+	// it as soon as it changes. Without it they pick the change up the next
+	// time they are reconciled.
+	LabelReloadWorkloads = "core.oam.dev/reload-workloads"
+)
@@ -0,0 +1,569 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"fmt"
+	"time"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TypeOverridden traits do not apply their changes because a trait with a
+// higher priority changes the same fields of their workload.
+const TypeOverridden cpv1alpha1.ConditionType = "Overridden"
+
+// Reasons a trait is or is not overridden.
+const (
+	ReasonOverridden    cpv1alpha1.ConditionReason = "Overridden by a trait with a higher priority"
+	ReasonNotOverridden cpv1alpha1.ConditionReason = "Trait takes precedence"
+)
+
+// Overridden returns a condition indicating that the named trait overrides
+// the trait's changes.
+func Overridden(by string) cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeOverridden,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonOverridden,
+		Message:            fmt.Sprintf("overridden by %s", by),
+	}
+}
+
+// NotOverridden returns a condition indicating that the trait's changes are
+// applied.
+func NotOverridden() cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeOverridden,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNotOverridden,
+	}
+}
+
+// TypeScheduled workloads have all their pods scheduled to a node.
+const TypeScheduled cpv1alpha1.ConditionType = "Scheduled"
+
+// Reasons a workload is or is not scheduled.
+const (
+	ReasonScheduled     cpv1alpha1.ConditionReason = "Pods are scheduled"
+	ReasonUnschedulable cpv1alpha1.ConditionReason = "Pods cannot be scheduled"
+)
+
+// Scheduled returns a condition indicating that no pod of the workload is
+// waiting for a node.
+func Scheduled() cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeScheduled,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonScheduled,
+	}
+}
+
+// Unschedulable returns a condition indicating that a pod of the workload
+// cannot be scheduled, e.g. because no node offers its extended resources.
+func Unschedulable(msg string) cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeScheduled,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUnschedulable,
+		Message:            msg,
+	}
+}
+
+// TypeContainersHealthy workloads have no container failing to pull its image
+// or to keep running.
+const TypeContainersHealthy cpv1alpha1.ConditionType = "ContainersHealthy"
+
+// Reasons the containers of a workload are or are not healthy.
+const (
+	ReasonContainersHealthy cpv1alpha1.ConditionReason = "Containers are healthy"
+	ReasonContainersFailing cpv1alpha1.ConditionReason = "Containers are failing"
+)
+
+// ContainersHealthy returns a condition indicating that no container of the
+// workload is failing.
+func ContainersHealthy() cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeContainersHealthy,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonContainersHealthy,
+	}
+}
+
+// ContainersFailing returns a condition indicating that containers of the
+// workload fail, e.g. because their image cannot be pulled or they crash.
+func ContainersFailing(msg string) cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeContainersHealthy,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonContainersFailing,
+		Message:            msg,
+	}
+}
+
+// TypeEndpointsHealthy workloads answer their health probe as expected.
+const TypeEndpointsHealthy cpv1alpha1.ConditionType = "EndpointsHealthy"
+
+// Reasons the endpoints of a workload are or are not healthy.
+const (
+	ReasonEndpointsHealthy cpv1alpha1.ConditionReason = "Endpoints answer the health probe"
+	ReasonEndpointsFailing cpv1alpha1.ConditionReason = "Endpoints fail the health probe"
+)
+
+// EndpointsHealthy returns a condition indicating that the service of every
+// deployment of the workload answers the health probe as expected.
+func EndpointsHealthy() cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeEndpointsHealthy,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonEndpointsHealthy,
+	}
+}
+
+// EndpointsFailing returns a condition indicating that services of the
+// workload, described by msg, fail the health probe.
+func EndpointsFailing(msg string) cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeEndpointsHealthy,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonEndpointsFailing,
+		Message:            msg,
+	}
+}
+
+// TypePermissionDenied resources cannot be reconciled because the API server
+// forbids a request of the controller, e.g. because its RBAC role lacks a verb.
+const TypePermissionDenied cpv1alpha1.ConditionType = "PermissionDenied"
+
+// Reasons a controller is or is not denied permission.
+const (
+	ReasonPermissionDenied  cpv1alpha1.ConditionReason = "Request forbidden by the API server"
+	ReasonPermissionGranted cpv1alpha1.ConditionReason = "Requests permitted"
+)
+
+// PermissionDenied returns a condition indicating that the API server forbids
+// a request the controller needs to make, described by msg.
+func PermissionDenied(msg string) cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypePermissionDenied,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPermissionDenied,
+		Message:            msg,
+	}
+}
+
+// PermissionGranted returns a condition indicating that no request of the
+// controller was forbidden.
+func PermissionGranted() cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypePermissionDenied,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPermissionGranted,
+	}
+}
+
+// TypeExternalReferencesResolved workloads have all the pre-existing
+// resources they refer to.
+const TypeExternalReferencesResolved cpv1alpha1.ConditionType = "ExternalReferencesResolved"
+
+// Reasons the external references of a workload are or are not resolved.
+const (
+	ReasonExternalReferencesResolved cpv1alpha1.ConditionReason = "External resources exist"
+	ReasonExternalReferencesMissing  cpv1alpha1.ConditionReason = "External resources are missing"
+)
+
+// ExternalReferencesResolved returns a condition indicating that every
+// resource the workload refers to exists.
+func ExternalReferencesResolved() cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeExternalReferencesResolved,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonExternalReferencesResolved,
+	}
+}
+
+// ExternalReferencesMissing returns a condition indicating that resources the
+// workload refers to, described by msg, do not exist.
+func ExternalReferencesMissing(msg string) cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeExternalReferencesResolved,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonExternalReferencesMissing,
+		Message:            msg,
+	}
+}
+
+// TypeDeferred workloads and traits are waiting for a maintenance window of
+// their namespace to end before they are applied.
+const TypeDeferred cpv1alpha1.ConditionType = "Deferred"
+
+// Reasons the changes of a workload or trait are or are not deferred.
+const (
+	ReasonMaintenanceWindow cpv1alpha1.ConditionReason = "Namespace is in a maintenance window"
+	ReasonNotDeferred       cpv1alpha1.ConditionReason = "No maintenance window"
+)
+
+// Deferred returns a condition indicating that changes are deferred until
+// the maintenance window of the namespace ends.
+func Deferred(until time.Time) cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeDeferred,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonMaintenanceWindow,
+		Message:            "changes are deferred until " + until.UTC().Format(time.RFC3339),
+	}
+}
+
+// NotDeferred returns a condition indicating that changes are applied right
+// away.
+func NotDeferred() cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeDeferred,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNotDeferred,
+	}
+}
+
+// TypeDegraded workloads did not finish rolling out within their progress
+// deadline.
+const TypeDegraded cpv1alpha1.ConditionType = "Degraded"
+
+// Reasons a workload is or is not degraded.
+const (
+	ReasonProgressDeadlineExceeded cpv1alpha1.ConditionReason = "Rollout exceeded its progress deadline"
+	ReasonProgressing              cpv1alpha1.ConditionReason = "Rollout is progressing"
+)
+
+// Degraded returns a condition indicating that the rollout of the workload,
+// described by msg, is stuck.
+func Degraded(msg string) cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeDegraded,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonProgressDeadlineExceeded,
+		Message:            msg,
+	}
+}
+
+// NotDegraded returns a condition indicating that the rollout of the
+// workload is complete or progressing.
+func NotDegraded() cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeDegraded,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonProgressing,
+	}
+}
+
+// TypeDependenciesReady traits have the traits they depend on synced.
+const TypeDependenciesReady cpv1alpha1.ConditionType = "DependenciesReady"
+
+// Reasons the dependencies of a trait are or are not ready.
+const (
+	ReasonDependenciesReady   cpv1alpha1.ConditionReason = "Dependencies are synced"
+	ReasonDependenciesPending cpv1alpha1.ConditionReason = "Waiting for dependencies"
+)
+
+// DependenciesReady returns a condition indicating that every trait the
+// trait depends on is synced.
+func DependenciesReady() cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeDependenciesReady,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDependenciesReady,
+	}
+}
+
+// DependenciesPending returns a condition indicating that the trait waits for
+// the traits it depends on, described by msg, to be synced.
+func DependenciesPending(msg string) cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeDependenciesReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDependenciesPending,
+		Message:            msg,
+	}
+}
+
+// TypeIgnoredDueToHPA traits leave the scaling of their workload to a
+// HorizontalPodAutoscaler targeting its deployment.
+const TypeIgnoredDueToHPA cpv1alpha1.ConditionType = "IgnoredDueToHPA"
+
+// Reasons a trait does or does not leave scaling to a HorizontalPodAutoscaler.
+const (
+	ReasonIgnoredDueToHPA    cpv1alpha1.ConditionReason = "A HorizontalPodAutoscaler scales the workload"
+	ReasonNotIgnoredDueToHPA cpv1alpha1.ConditionReason = "Trait scales the workload"
+)
+
+// IgnoredDueToHPA returns a condition indicating that the named
+// HorizontalPodAutoscaler scales the workload instead of the trait.
+func IgnoredDueToHPA(hpa string) cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeIgnoredDueToHPA,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonIgnoredDueToHPA,
+		Message:            fmt.Sprintf("HorizontalPodAutoscaler %s scales the deployment", hpa),
+	}
+}
+
+// NotIgnoredDueToHPA returns a condition indicating that the trait scales the
+// workload, adjusting the HorizontalPodAutoscalers described by msg, if any.
+func NotIgnoredDueToHPA(msg string) cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeIgnoredDueToHPA,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNotIgnoredDueToHPA,
+		Message:            msg,
+	}
+}
+
+// TypeReconciling objects have changes that are yet to take effect, following
+// the kstatus conventions.
+const TypeReconciling cpv1alpha1.ConditionType = "Reconciling"
+
+// Reasons an object is or is not reconciling.
+const (
+	ReasonReconciling    cpv1alpha1.ConditionReason = "Changes are yet to take effect"
+	ReasonNotReconciling cpv1alpha1.ConditionReason = "Changes took effect"
+)
+
+// Reconciling returns a condition indicating that changes of the object,
+// described by msg, are yet to take effect.
+func Reconciling(msg string) cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeReconciling,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonReconciling,
+		Message:            msg,
+	}
+}
+
+// NotReconciling returns a condition indicating that the changes of the object
+// took effect.
+func NotReconciling() cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeReconciling,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNotReconciling,
+	}
+}
+
+// TypeStalled objects cannot take effect until something changes, following
+// the kstatus conventions.
+const TypeStalled cpv1alpha1.ConditionType = "Stalled"
+
+// Reasons an object is or is not stalled.
+const (
+	ReasonStalled    cpv1alpha1.ConditionReason = "Changes cannot take effect"
+	ReasonNotStalled cpv1alpha1.ConditionReason = "Changes can take effect"
+)
+
+// Stalled returns a condition indicating that the object cannot take effect
+// for the reason msg describes.
+func Stalled(msg string) cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeStalled,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonStalled,
+		Message:            msg,
+	}
+}
+
+// NotStalled returns a condition indicating that nothing keeps the object from
+// taking effect.
+func NotStalled() cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeStalled,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNotStalled,
+	}
+}
+
+// TypeBudgetExceeded traits would take the requests of a workload beyond the
+// budget of a CostTrait.
+const TypeBudgetExceeded cpv1alpha1.ConditionType = "BudgetExceeded"
+
+// Reasons the requests of a workload do or do not exceed its budget.
+const (
+	ReasonBudgetExceeded cpv1alpha1.ConditionReason = "Requests exceed the budget"
+	ReasonWithinBudget   cpv1alpha1.ConditionReason = "Requests are within the budget"
+)
+
+// BudgetExceeded returns a condition indicating that the requests of the
+// workload, described by msg, exceed its budget.
+func BudgetExceeded(msg string) cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeBudgetExceeded,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonBudgetExceeded,
+		Message:            msg,
+	}
+}
+
+// WithinBudget returns a condition indicating that the requests of the
+// workload are within its budget.
+func WithinBudget() cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeBudgetExceeded,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonWithinBudget,
+	}
+}
+
+// TypeScaleVerified traits saw the ready replicas of their workload reach the
+// replicas they scaled it to.
+const TypeScaleVerified cpv1alpha1.ConditionType = "ScaleVerified"
+
+// Reasons the scaling of a workload is or is not verified.
+const (
+	ReasonScaleVerified    cpv1alpha1.ConditionReason = "Ready replicas reached the replicas"
+	ReasonScaleNotVerified cpv1alpha1.ConditionReason = "Ready replicas did not reach the replicas"
+)
+
+// ScaleVerified returns a condition indicating that the ready replicas of the
+// workload reached the replicas it was scaled to after the wait described by
+// msg.
+func ScaleVerified(msg string) cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeScaleVerified,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonScaleVerified,
+		Message:            msg,
+	}
+}
+
+// ScaleNotVerified returns a condition indicating that the ready replicas of
+// the workload did not reach the replicas it was scaled to, as described by
+// msg.
+func ScaleNotVerified(msg string) cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeScaleVerified,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonScaleNotVerified,
+		Message:            msg,
+	}
+}
+
+// TypeExpiryBlocked workloads outlived their TTL but are protected from
+// deletion, so they are kept along with their traits.
+const TypeExpiryBlocked cpv1alpha1.ConditionType = "ExpiryBlocked"
+
+// Reasons the expiry of a workload is or is not blocked.
+const (
+	ReasonDeletionProtected    cpv1alpha1.ConditionReason = "Workload is protected from deletion"
+	ReasonDeletionNotProtected cpv1alpha1.ConditionReason = "Workload is deleted when its TTL ends"
+)
+
+// ExpiryBlocked returns a condition indicating that the TTL of the workload
+// ended but the deletion protection annotation keeps it.
+func ExpiryBlocked() cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeExpiryBlocked,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDeletionProtected,
+		Message: fmt.Sprintf("the TTL ended, remove the %s annotation to delete the workload",
+			AnnotationDeletionProtection),
+	}
+}
+
+// ExpiryNotBlocked returns a condition indicating that the workload is
+// deleted once its TTL ends.
+func ExpiryNotBlocked() cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeExpiryBlocked,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDeletionNotProtected,
+	}
+}
+
+// TypeAvailable workloads have enough of their replicas ready, as their health
+// policy requires.
+const TypeAvailable cpv1alpha1.ConditionType = "Available"
+
+// Reasons a workload is or is not available.
+const (
+	ReasonAvailable   cpv1alpha1.ConditionReason = "Enough replicas are ready"
+	ReasonUnavailable cpv1alpha1.ConditionReason = "Too few replicas are ready"
+	ReasonStarting    cpv1alpha1.ConditionReason = "Workload is starting"
+)
+
+// Available returns a condition indicating that enough replicas of the
+// workload are ready.
+func Available(msg string) cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeAvailable,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonAvailable,
+		Message:            msg,
+	}
+}
+
+// Unavailable returns a condition indicating that too few replicas of the
+// workload were ready for as many checks as its health policy tolerates.
+func Unavailable(msg string) cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeAvailable,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUnavailable,
+		Message:            msg,
+	}
+}
+
+// Starting returns a condition indicating that the workload has too few
+// replicas ready but is still within the startup grace period of a rollout.
+func Starting(msg string) cpv1alpha1.Condition {
+	return cpv1alpha1.Condition{
+		Type:               TypeAvailable,
+		Status:             corev1.ConditionUnknown,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonStarting,
+		Message:            msg,
+	}
+}
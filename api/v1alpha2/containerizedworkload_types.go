@@ -19,6 +19,7 @@ import (
 	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -54,8 +55,178 @@ type ContainerizedWorkloadSpec struct {
 	// +optional
 	CPUArchitecture *CPUArchitecture `json:"arch,omitempty"`
 
+	// ArchitectureImages select the images of containers of this workload by
+	// CPU architecture, for images that are not multi-arch manifest lists.
+	// Unless arch is set, the workload runs on the architecture it has images
+	// for that most ready nodes of the cluster have.
+	// +optional
+	ArchitectureImages []ArchitectureImages `json:"archImages,omitempty"`
+
+	// WindowsOptions of the pods of a windows workload, e.g. the user name
+	// its containers run as or its GMSA credential spec.
+	// +optional
+	WindowsOptions *corev1.WindowsSecurityContextOptions `json:"windowsOptions,omitempty"`
+
 	// Containers of which this workload consists.
 	Containers []corev1.Container `json:"containers"`
+
+	// InitContainers run to completion, in order, before the containers of
+	// this workload are started.
+	// +optional
+	InitContainers []corev1.Container `json:"initContainers,omitempty"`
+
+	// RevisionHistoryLimit is the number of old revisions of the rendered
+	// deployment to retain so that it can be rolled back. Defaults to 100.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+
+	// ExternalReferences to pre-existing resources in the workload's
+	// namespace the workload depends on. The workload is not rendered until
+	// they all exist.
+	// +optional
+	ExternalReferences []ExternalReference `json:"externalRefs,omitempty"`
+
+	// ProgressDeadlineSeconds is how long a rollout of this workload may
+	// take before it is considered stuck, in which case the workload is
+	// marked Degraded. Defaults to the deployment default of 600 seconds.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ProgressDeadlineSeconds *int32 `json:"progressDeadlineSeconds,omitempty"`
+
+	// Sharding partitions this workload into several independent shards.
+	// +optional
+	Sharding *Sharding `json:"sharding,omitempty"`
+
+	// HealthProbe, if set, is sent by the controller to the service of each
+	// deployment of this workload to catch failures pod readiness misses.
+	// +optional
+	HealthProbe *HTTPHealthProbe `json:"healthProbe,omitempty"`
+
+	// HealthPolicy, if set, decides when this workload is available from
+	// the readiness of its replicas, as reported by the Available condition.
+	// +optional
+	HealthPolicy *HealthPolicy `json:"healthPolicy,omitempty"`
+
+	// TTL after which the controller deletes this workload, the traits
+	// applied to it and its children, counted from the creation of the
+	// workload, e.g. for the preview environments of pull requests.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// ReloadOnConfigChange rolls out the deployments of this workload
+	// whenever a ConfigMap or Secret its containers refer to changes, right
+	// away if it carries the core.oam.dev/reload-workloads label.
+	// +optional
+	ReloadOnConfigChange bool `json:"reloadOnConfigChange,omitempty"`
+}
+
+// An HTTPHealthProbe is an HTTP GET request to the service of a workload,
+// which is healthy if it answers with the expected status in time.
+type HTTPHealthProbe struct {
+	// Path of the request. Defaults to /.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Port of the service the request is sent to. Defaults to the port the
+	// first container port is exposed on, 8080.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+
+	// ExpectedStatus of the response. Defaults to 200.
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
+	// +optional
+	ExpectedStatus *int32 `json:"expectedStatus,omitempty"`
+
+	// TimeoutSeconds after which the request fails. Defaults to 1 second.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// A HealthPolicy decides when a workload is available, tolerating the replicas
+// that are not ready during a rollout and short failures. Each reconcile of
+// the workload checks it, at least every 30 seconds while the check fails.
+type HealthPolicy struct {
+	// RequiredAvailablePercent of the desired replicas of the workload that
+	// must be ready for it to be available. Defaults to 100.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	RequiredAvailablePercent *int32 `json:"requiredAvailablePercent,omitempty"`
+
+	// StartupGracePeriod after the creation of the workload or a change of
+	// its spec during which too few ready replicas mark it as starting
+	// rather than unavailable.
+	// +optional
+	StartupGracePeriod *metav1.Duration `json:"startupGracePeriod,omitempty"`
+
+	// FailureThreshold is the number of consecutive failed checks after
+	// which the workload is unavailable. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+}
+
+// ArchitectureImages are the images of a container by CPU architecture.
+type ArchitectureImages struct {
+	// Container, or init container, whose image is selected.
+	Container string `json:"container"`
+
+	// Images by CPU architecture, e.g. arm64: registry.local/web:1.0-arm64.
+	// The container keeps its image on other architectures.
+	// +kubebuilder:validation:MinProperties=1
+	Images map[CPUArchitecture]string `json:"images"`
+}
+
+// DefaultShardKey is the environment variable holding the index of a shard
+// unless the workload names another one.
+const DefaultShardKey = "SHARD"
+
+// Sharding renders a deployment and a service per shard of a workload.
+// Each shard's containers find its index, counting from 0, in the ShardKey
+// environment variable and the number of shards in ShardKey_COUNT.
+type Sharding struct {
+	// Shards the workload is partitioned into.
+	// +kubebuilder:validation:Minimum=1
+	Shards int32 `json:"shards"`
+
+	// ShardKey is the environment variable holding the index of the shard.
+	// Defaults to SHARD.
+	// +optional
+	ShardKey string `json:"shardKey,omitempty"`
+}
+
+// An ExternalReferenceKind is a kind of resource a workload may depend on.
+type ExternalReferenceKind string
+
+// Kinds of external resources.
+const (
+	ExternalReferenceService               ExternalReferenceKind = "Service"
+	ExternalReferenceSecret                ExternalReferenceKind = "Secret"
+	ExternalReferencePersistentVolumeClaim ExternalReferenceKind = "PersistentVolumeClaim"
+)
+
+// An ExternalReference refers to a pre-existing resource a workload depends
+// on. A Service is exposed to the containers as <NAME>_SERVICE_HOST and
+// <NAME>_SERVICE_PORT environment variables, a Secret as environment
+// variables or, if a mount path is given, as files, and a
+// PersistentVolumeClaim as a volume mounted at the mount path.
+type ExternalReference struct {
+	// Kind of the referenced resource.
+	// +kubebuilder:validation:Enum=Service;Secret;PersistentVolumeClaim
+	Kind ExternalReferenceKind `json:"kind"`
+
+	// Name of the referenced resource.
+	Name string `json:"name"`
+
+	// MountPath of a Secret or PersistentVolumeClaim in every container.
+	// Required for PersistentVolumeClaims.
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
 }
 
 // A ResourceReference refers to an resource managed by an OAM resource.
@@ -69,9 +240,16 @@ type ResourceReference struct {
 	// Name of the referenced resource.
 	Name string `json:"name"`
 
-	// UID of the referenced resource.
+	// UID of the referenced resource. A resource of the same apiVersion, kind
+	// and name but another UID, e.g. one recreated by a GitOps tool, is still
+	// referenced unless StrictUID is set.
 	// +optional
 	UID *types.UID `json:"uid,omitempty"`
+
+	// StrictUID references only a resource with the UID of the reference.
+	// Without a UID, the reference then matches no resource.
+	// +optional
+	StrictUID bool `json:"strictUID,omitempty"`
 }
 
 // A ContainerizedWorkloadStatus represents the observed state of a
@@ -79,14 +257,68 @@ type ResourceReference struct {
 type ContainerizedWorkloadStatus struct {
 	cpv1alpha1.ConditionedStatus `json:",inline"`
 
+	// ObservedGeneration is the generation of the spec the status reflects.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
 	// Resources managed by this containerised workload, key the resource UID
 	Resources []ResourceReference `json:"resources,omitempty"`
+
+	// Replicas of the deployments of this workload.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// ReadyReplicas of the deployments of this workload.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// Restarts of the containers of the pods of this workload.
+	// +optional
+	Restarts int32 `json:"restarts,omitempty"`
+
+	// ExpiresAt is the time the TTL of this workload ends.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// RolloutGeneration is the generation of the spec whose rollout started
+	// at RolloutStartedAt, for the startup grace period of the health policy.
+	// +optional
+	RolloutGeneration int64 `json:"rolloutGeneration,omitempty"`
+
+	// RolloutStartedAt is the time the rollout of RolloutGeneration started.
+	// +optional
+	RolloutStartedAt *metav1.Time `json:"rolloutStartedAt,omitempty"`
+
+	// ConsecutiveFailures of the health policy's checks since one passed.
+	// +optional
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+
+	// LastAppliedSpec is the spec of this workload the controller applied
+	// last, which changes of the spec are summarized against.
+	// +optional
+	LastAppliedSpec *runtime.RawExtension `json:"lastAppliedSpec,omitempty"`
+
+	// LastChange summarizes how the spec of LastChangeGeneration differs
+	// from the one applied before it, e.g. the containers added or removed.
+	// +optional
+	LastChange string `json:"lastChange,omitempty"`
+
+	// LastChangeGeneration is the generation of the spec LastChange
+	// summarizes.
+	// +optional
+	LastChangeGeneration int64 `json:"lastChangeGeneration,omitempty"`
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 
 // ContainerizedWorkload is the Schema for the containerizedworkloads API
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status"
+// +kubebuilder:printcolumn:name="HEALTHY",type="string",JSONPath=".status.conditions[?(@.type==\"ContainersHealthy\")].status"
+// +kubebuilder:printcolumn:name="READY",type="integer",JSONPath=".status.readyReplicas",priority=1
+// +kubebuilder:printcolumn:name="DEGRADED",type="string",JSONPath=".status.conditions[?(@.type==\"Degraded\")].status",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 type ContainerizedWorkload struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
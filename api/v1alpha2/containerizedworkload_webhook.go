@@ -0,0 +1,236 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/oam-dev/core-resource-controller/pkg/envtemplate"
+)
+
+// log is for logging in this package.
+var containerizedworkloadlog = logf.Log.WithName("containerizedworkload-resource")
+
+func (r *ContainerizedWorkload) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-core-oam-dev-v1alpha2-containerizedworkload,mutating=false,failurePolicy=fail,groups=core.oam.dev,resources=containerizedworkloads,versions=v1alpha2,name=containerizedworkload.validate.core.oam.dev
+
+var _ webhook.Validator = &ContainerizedWorkload{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *ContainerizedWorkload) ValidateCreate() error {
+	containerizedworkloadlog.Info("validate create", "name", r.Name)
+	return r.validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *ContainerizedWorkload) ValidateUpdate(old runtime.Object) error {
+	containerizedworkloadlog.Info("validate update", "name", r.Name)
+	return r.validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *ContainerizedWorkload) ValidateDelete() error {
+	containerizedworkloadlog.Info("validate delete", "name", r.Name)
+	if protected, _ := strconv.ParseBool(r.GetAnnotations()[AnnotationDeletionProtection]); protected {
+		return fmt.Errorf("%s is protected from deletion, remove its %s annotation first", r.Name,
+			AnnotationDeletionProtection)
+	}
+	return nil
+}
+
+func (r *ContainerizedWorkload) validate() error {
+	var errs field.ErrorList
+	for i, c := range r.Spec.Containers {
+		path := field.NewPath("spec", "containers").Index(i)
+		errs = append(errs, validateProbe(c, c.LivenessProbe, path.Child("livenessProbe"))...)
+		errs = append(errs, validateProbe(c, c.ReadinessProbe, path.Child("readinessProbe"))...)
+		errs = append(errs, validateEnvTemplates(c, path.Child("env"))...)
+	}
+	for i, c := range r.Spec.InitContainers {
+		errs = append(errs, validateEnvTemplates(c, field.NewPath("spec", "initContainers").Index(i).Child("env"))...)
+	}
+	errs = append(errs, validatePlatform(r.Spec.OperatingSystem, r.Spec.CPUArchitecture)...)
+	errs = append(errs, validateWindows(r.Spec)...)
+	errs = append(errs, validateExternalReferences(r.Spec.ExternalReferences)...)
+	errs = append(errs, validateSharding(r.Spec.Sharding)...)
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(GroupVersion.WithKind("ContainerizedWorkload").GroupKind(), r.Name, errs)
+}
+
+// Windows nodes are only available for amd64
+func validatePlatform(os *OperatingSystem, arch *CPUArchitecture) field.ErrorList {
+	if os == nil || arch == nil || *os != OperatingSystemWindows || *arch == CPUArchitectureAMD64 {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(field.NewPath("spec", "arch"), *arch,
+		"windows workloads can only be scheduled on amd64 nodes")}
+}
+
+// windows containers cannot use the linux security settings, and only
+// windows pods the windows ones
+func validateWindows(spec ContainerizedWorkloadSpec) field.ErrorList {
+	if spec.OperatingSystem == nil || *spec.OperatingSystem != OperatingSystemWindows {
+		if spec.WindowsOptions != nil {
+			return field.ErrorList{field.Forbidden(field.NewPath("spec", "windowsOptions"),
+				"only windows workloads have windows options")}
+		}
+		return nil
+	}
+	var errs field.ErrorList
+	for i, c := range spec.Containers {
+		for _, f := range LinuxSecurityContextFields(c.SecurityContext) {
+			errs = append(errs, field.Forbidden(field.NewPath("spec", "containers").Index(i).Child("securityContext", f),
+				"windows containers do not support it"))
+		}
+	}
+	for i, c := range spec.InitContainers {
+		for _, f := range LinuxSecurityContextFields(c.SecurityContext) {
+			errs = append(errs, field.Forbidden(
+				field.NewPath("spec", "initContainers").Index(i).Child("securityContext", f),
+				"windows containers do not support it"))
+		}
+	}
+	return errs
+}
+
+// LinuxSecurityContextFields returns the fields of the security context that
+// only linux containers support.
+func LinuxSecurityContextFields(sc *corev1.SecurityContext) []string {
+	if sc == nil {
+		return nil
+	}
+	var fields []string
+	for _, f := range []struct {
+		name string
+		set  bool
+	}{
+		{"capabilities", sc.Capabilities != nil},
+		{"privileged", sc.Privileged != nil},
+		{"seLinuxOptions", sc.SELinuxOptions != nil},
+		{"runAsUser", sc.RunAsUser != nil},
+		{"runAsGroup", sc.RunAsGroup != nil},
+		{"readOnlyRootFilesystem", sc.ReadOnlyRootFilesystem != nil},
+		{"allowPrivilegeEscalation", sc.AllowPrivilegeEscalation != nil},
+		{"procMount", sc.ProcMount != nil},
+	} {
+		if f.set {
+			fields = append(fields, f.name)
+		}
+	}
+	return fields
+}
+
+// claims are only useful mounted, and a resource is only referred to once
+func validateExternalReferences(refs []ExternalReference) field.ErrorList {
+	var errs field.ErrorList
+	seen := map[ExternalReference]bool{}
+	for i, ref := range refs {
+		path := field.NewPath("spec", "externalRefs").Index(i)
+		if ref.Kind == ExternalReferencePersistentVolumeClaim && ref.MountPath == "" {
+			errs = append(errs, field.Required(path.Child("mountPath"),
+				"persistent volume claims must be mounted"))
+		}
+		if ref.Kind == ExternalReferenceService && ref.MountPath != "" {
+			errs = append(errs, field.Forbidden(path.Child("mountPath"), "services cannot be mounted"))
+		}
+		key := ExternalReference{Kind: ref.Kind, Name: ref.Name}
+		if seen[key] {
+			errs = append(errs, field.Duplicate(path, ref.Name))
+		}
+		seen[key] = true
+	}
+	return errs
+}
+
+// the shard key is set as an environment variable
+func validateSharding(s *Sharding) field.ErrorList {
+	if s == nil {
+		return nil
+	}
+	var errs field.ErrorList
+	path := field.NewPath("spec", "sharding")
+	if s.Shards < 1 {
+		errs = append(errs, field.Invalid(path.Child("shards"), s.Shards, "must be at least 1"))
+	}
+	if s.ShardKey != "" {
+		for _, msg := range validation.IsEnvVarName(s.ShardKey) {
+			errs = append(errs, field.Invalid(path.Child("shardKey"), s.ShardKey, msg))
+		}
+	}
+	return errs
+}
+
+// templated environment variable values must render
+func validateEnvTemplates(c corev1.Container, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for i, env := range c.Env {
+		if !envtemplate.IsTemplate(env.Value) {
+			continue
+		}
+		if err := envtemplate.Validate(env.Value); err != nil {
+			errs = append(errs, field.Invalid(path.Index(i).Child("value"), env.Value, err.Error()))
+		}
+	}
+	return errs
+}
+
+// a probe may only refer to a port by name if its container declares it
+func validateProbe(c corev1.Container, p *corev1.Probe, path *field.Path) field.ErrorList {
+	if p == nil {
+		return nil
+	}
+	var errs field.ErrorList
+	if p.HTTPGet != nil {
+		errs = append(errs, validateProbePort(c, p.HTTPGet.Port, path.Child("httpGet", "port"))...)
+	}
+	if p.TCPSocket != nil {
+		errs = append(errs, validateProbePort(c, p.TCPSocket.Port, path.Child("tcpSocket", "port"))...)
+	}
+	return errs
+}
+
+func validateProbePort(c corev1.Container, port intstr.IntOrString, path *field.Path) field.ErrorList {
+	if port.Type == intstr.Int {
+		if port.IntVal < 1 || port.IntVal > 65535 {
+			return field.ErrorList{field.Invalid(path, port.IntVal, "must be between 1 and 65535, inclusive")}
+		}
+		return nil
+	}
+	for _, cp := range c.Ports {
+		if cp.Name == port.StrVal {
+			return nil
+		}
+	}
+	return field.ErrorList{field.NotFound(path, port.StrVal)}
+}
@@ -0,0 +1,146 @@
+package v1alpha2
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestContainerizedWorkload_validate(t *testing.T) {
+	httpProbe := func(port intstr.IntOrString) *corev1.Probe {
+		return &corev1.Probe{Handler: corev1.Handler{HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: port}}}
+	}
+	tcpProbe := func(port intstr.IntOrString) *corev1.Probe {
+		return &corev1.Probe{Handler: corev1.Handler{TCPSocket: &corev1.TCPSocketAction{Port: port}}}
+	}
+	testCases := map[string]struct {
+		container corev1.Container
+		wantErr   bool
+	}{
+		"NoProbes": {
+			container: corev1.Container{Name: "web"},
+		},
+		"DeclaredNamedPort": {
+			container: corev1.Container{
+				Name:           "web",
+				Ports:          []corev1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+				LivenessProbe:  httpProbe(intstr.FromString("http")),
+				ReadinessProbe: tcpProbe(intstr.FromString("http")),
+			},
+		},
+		"UndeclaredNamedPort": {
+			container: corev1.Container{
+				Name:          "web",
+				Ports:         []corev1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+				LivenessProbe: httpProbe(intstr.FromString("admin")),
+			},
+			wantErr: true,
+		},
+		"NumericPort": {
+			container: corev1.Container{Name: "web", ReadinessProbe: tcpProbe(intstr.FromInt(9090))},
+		},
+		"PortOutOfRange": {
+			container: corev1.Container{Name: "web", ReadinessProbe: tcpProbe(intstr.FromInt(70000))},
+			wantErr:   true,
+		},
+		"EnvTemplate": {
+			container: corev1.Container{Name: "web", Env: []corev1.EnvVar{{Name: "APP", Value: "{{ .AppConfig.Name }}"}}},
+		},
+		"EnvTemplateUnknownField": {
+			container: corev1.Container{Name: "web", Env: []corev1.EnvVar{{Name: "APP", Value: "{{ .App.Name }}"}}},
+			wantErr:   true,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			w := &ContainerizedWorkload{Spec: ContainerizedWorkloadSpec{Containers: []corev1.Container{testCase.container}}}
+			if err := w.ValidateCreate(); (err != nil) != testCase.wantErr {
+				t.Errorf("ValidateCreate() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidatePlatform(t *testing.T) {
+	os := func(o OperatingSystem) *OperatingSystem { return &o }
+	arch := func(a CPUArchitecture) *CPUArchitecture { return &a }
+	testCases := map[string]struct {
+		os      *OperatingSystem
+		arch    *CPUArchitecture
+		wantErr bool
+	}{
+		"Unconstrained": {},
+		"LinuxARM":      {os: os(OperatingSystemLinux), arch: arch(CPUArchitectureARM)},
+		"WindowsAMD64":  {os: os(OperatingSystemWindows), arch: arch(CPUArchitectureAMD64)},
+		"WindowsARM64":  {os: os(OperatingSystemWindows), arch: arch(CPUArchitectureARM64), wantErr: true},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if errs := validatePlatform(testCase.os, testCase.arch); (len(errs) != 0) != testCase.wantErr {
+				t.Errorf("validatePlatform() = %v, wantErr %v", errs, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateWindows(t *testing.T) {
+	windows, linux := OperatingSystemWindows, OperatingSystemLinux
+	root := int64(0)
+	user := "ContainerUser"
+	testCases := map[string]struct {
+		spec    ContainerizedWorkloadSpec
+		wantErr bool
+	}{
+		"Windows": {spec: ContainerizedWorkloadSpec{
+			OperatingSystem: &windows,
+			WindowsOptions:  &corev1.WindowsSecurityContextOptions{RunAsUserName: &user},
+			Containers:      []corev1.Container{{Name: "web"}},
+		}},
+		"WindowsOptionsOfLinux": {spec: ContainerizedWorkloadSpec{
+			OperatingSystem: &linux,
+			WindowsOptions:  &corev1.WindowsSecurityContextOptions{RunAsUserName: &user},
+		}, wantErr: true},
+		"WindowsRunAsUser": {spec: ContainerizedWorkloadSpec{
+			OperatingSystem: &windows,
+			InitContainers: []corev1.Container{{Name: "init",
+				SecurityContext: &corev1.SecurityContext{RunAsUser: &root}}},
+		}, wantErr: true},
+		"LinuxRunAsUser": {spec: ContainerizedWorkloadSpec{
+			OperatingSystem: &linux,
+			Containers:      []corev1.Container{{Name: "web", SecurityContext: &corev1.SecurityContext{RunAsUser: &root}}},
+		}},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if errs := validateWindows(testCase.spec); (len(errs) != 0) != testCase.wantErr {
+				t.Errorf("validateWindows() = %v, wantErr %v", errs, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestContainerizedWorkload_ValidateDelete(t *testing.T) {
+	testCases := map[string]struct {
+		annotations map[string]string
+		wantErr     bool
+	}{
+		"Unprotected": {},
+		"Protected": {
+			annotations: map[string]string{AnnotationDeletionProtection: "true"},
+			wantErr:     true,
+		},
+		"ProtectionLifted": {
+			annotations: map[string]string{AnnotationDeletionProtection: "false"},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			w := &ContainerizedWorkload{}
+			w.SetAnnotations(testCase.annotations)
+			if err := w.ValidateDelete(); (err != nil) != testCase.wantErr {
+				t.Errorf("ValidateDelete() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A CostTraitSpec defines the desired state of a CostTrait.
+type CostTraitSpec struct {
+	// Labels stamped on every child of the workload and on the pods of its
+	// deployment, e.g. cost-center: cc-1234, for cost reporting tools.
+	// +kubebuilder:validation:MinProperties=1
+	Labels map[string]string `json:"labels"`
+
+	// MaxRequests the pods of the workload's deployment may request in
+	// total, i.e. their replicas times the requests of their containers.
+	// Scaling the workload beyond them is refused.
+	// +optional
+	MaxRequests corev1.ResourceList `json:"maxRequests,omitempty"`
+
+	// WorkloadReference to the workload this trait applies to.
+	WorkloadReference ResourceReference `json:"workloadRef"`
+}
+
+// A CostTraitStatus represents the observed state of a CostTrait.
+type CostTraitStatus struct {
+	cpv1alpha1.ConditionedStatus `json:",inline"`
+
+	// ObservedGeneration is the generation of the spec the status reflects.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Requests of the pods of the workload's deployment in total.
+	// +optional
+	Requests corev1.ResourceList `json:"requests,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+
+// CostTrait is the Schema for the costtraits API
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="WORKLOAD",type="string",JSONPath=".spec.workloadRef.name"
+// +kubebuilder:printcolumn:name="BUDGET-EXCEEDED",type="string",JSONPath=".status.conditions[?(@.type==\"BudgetExceeded\")].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+type CostTrait struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CostTraitSpec   `json:"spec,omitempty"`
+	Status CostTraitStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CostTraitList contains a list of CostTrait
+type CostTraitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CostTrait `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CostTrait{}, &CostTraitList{})
+}
@@ -0,0 +1,97 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DebugContainerName is the name of the container running the tools image in
+// the pods of DebugTraits.
+const DebugContainerName = "debug"
+
+// A DebugTraitSpec defines the desired state of a DebugTrait.
+type DebugTraitSpec struct {
+	// Image of the debug container, holding the tools to troubleshoot the
+	// workload with, e.g. busybox.
+	Image string `json:"image"`
+
+	// Command of the debug container. Defaults to sleeping until the debug
+	// pod expires, so that one can exec into it.
+	// +optional
+	Command []string `json:"command,omitempty"`
+
+	// TTL of the debug pod, after which it is deleted. Defaults to 1h.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// WorkloadReference to the workload this trait applies to.
+	WorkloadReference ResourceReference `json:"workloadRef"`
+}
+
+// A DebugTraitStatus represents the observed state of a DebugTrait.
+type DebugTraitStatus struct {
+	cpv1alpha1.ConditionedStatus `json:",inline"`
+
+	// ObservedGeneration is the generation of the spec the status reflects.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// PodName of the debug pod, a copy of a pod of the workload along with
+	// the debug container.
+	// +optional
+	PodName string `json:"podName,omitempty"`
+
+	// CopiedPod is the pod of the workload the debug pod is a copy of.
+	// +optional
+	CopiedPod string `json:"copiedPod,omitempty"`
+
+	// ExpiresAt is when the debug pod is deleted.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+
+// DebugTrait is the Schema for the debugtraits API
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="WORKLOAD",type="string",JSONPath=".spec.workloadRef.name"
+// +kubebuilder:printcolumn:name="POD",type="string",JSONPath=".status.podName"
+// +kubebuilder:printcolumn:name="EXPIRES",type="date",JSONPath=".status.expiresAt"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+type DebugTrait struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DebugTraitSpec   `json:"spec,omitempty"`
+	Status DebugTraitStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DebugTraitList contains a list of DebugTrait
+type DebugTraitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DebugTrait `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DebugTrait{}, &DebugTraitList{})
+}
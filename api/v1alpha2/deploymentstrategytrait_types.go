@@ -0,0 +1,99 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// A DeploymentStrategyTraitSpec defines the desired state of a
+// DeploymentStrategyTrait. Fields left unset keep the value of the workload's
+// deployment.
+type DeploymentStrategyTraitSpec struct {
+	// Type of the deployment strategy, Recreate to stop all the pods of the
+	// workload before starting new ones.
+	// +kubebuilder:validation:Enum=Recreate;RollingUpdate
+	// +optional
+	Type appsv1.DeploymentStrategyType `json:"type,omitempty"`
+
+	// MaxSurge is the number or percentage of pods a rolling update may start
+	// above the desired replicas. Only valid for the RollingUpdate type.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+
+	// MaxUnavailable is the number or percentage of pods a rolling update may
+	// stop below the desired replicas. Only valid for the RollingUpdate type.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// MinReadySeconds a new pod must be ready for to count as available.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinReadySeconds *int32 `json:"minReadySeconds,omitempty"`
+
+	// ProgressDeadlineSeconds is how long a rollout may take before it is
+	// considered stuck. Leave the workload's own progressDeadlineSeconds
+	// unset when setting it here.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ProgressDeadlineSeconds *int32 `json:"progressDeadlineSeconds,omitempty"`
+
+	// WorkloadReference to the workload this trait applies to.
+	WorkloadReference ResourceReference `json:"workloadRef"`
+}
+
+// A DeploymentStrategyTraitStatus represents the observed state of a
+// DeploymentStrategyTrait.
+type DeploymentStrategyTraitStatus struct {
+	cpv1alpha1.ConditionedStatus `json:",inline"`
+
+	// ObservedGeneration is the generation of the spec the status reflects.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+
+// DeploymentStrategyTrait is the Schema for the deploymentstrategytraits API
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="WORKLOAD",type="string",JSONPath=".spec.workloadRef.name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+type DeploymentStrategyTrait struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DeploymentStrategyTraitSpec   `json:"spec,omitempty"`
+	Status DeploymentStrategyTraitStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DeploymentStrategyTraitList contains a list of DeploymentStrategyTrait
+type DeploymentStrategyTraitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeploymentStrategyTrait `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DeploymentStrategyTrait{}, &DeploymentStrategyTraitList{})
+}
@@ -0,0 +1,94 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// A HelmChartTraitSpec defines the desired state of a HelmChartTrait.
+type HelmChartTraitSpec struct {
+	// Chart to install, the name of a chart of Repo or the URL of a chart
+	// archive.
+	// +kubebuilder:validation:MinLength=1
+	Chart string `json:"chart"`
+
+	// Repo is the URL of the chart repository.
+	// +optional
+	Repo string `json:"repo,omitempty"`
+
+	// Version of the chart. Defaults to the latest one.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// TargetNamespace the chart is installed into. Defaults to the namespace
+	// of the trait.
+	// +optional
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+
+	// Values the chart is rendered with. Unless they set it, oam.workload
+	// and oam.deployment name the workload and its deployment.
+	// +optional
+	Values *runtime.RawExtension `json:"values,omitempty"`
+
+	// WorkloadReference to the workload this trait applies to.
+	WorkloadReference ResourceReference `json:"workloadRef"`
+}
+
+// A HelmChartTraitStatus represents the observed state of a HelmChartTrait.
+type HelmChartTraitStatus struct {
+	cpv1alpha1.ConditionedStatus `json:",inline"`
+
+	// ObservedGeneration is the generation of the spec the status reflects.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Resources rendered by this trait.
+	Resources []ResourceReference `json:"resources,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+
+// HelmChartTrait is the Schema for the helmcharttraits API
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="CHART",type="string",JSONPath=".spec.chart"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.version"
+// +kubebuilder:printcolumn:name="WORKLOAD",type="string",JSONPath=".spec.workloadRef.name"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+type HelmChartTrait struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HelmChartTraitSpec   `json:"spec,omitempty"`
+	Status HelmChartTraitStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HelmChartTraitList contains a list of HelmChartTrait
+type HelmChartTraitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HelmChartTrait `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&HelmChartTrait{}, &HelmChartTraitList{})
+}
@@ -0,0 +1,108 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IdentityTokenMountPath is the directory the tokens of an IdentityTrait are
+// mounted at in the containers of the workload.
+const IdentityTokenMountPath = "/var/run/secrets/oam.dev/tokens"
+
+// An IdentityToken is a service account token projected into the pods of the
+// workload, e.g. to authenticate to a service other than the API server.
+type IdentityToken struct {
+	// Audience the token is intended for.
+	// +kubebuilder:validation:MinLength=1
+	Audience string `json:"audience"`
+
+	// ExpirationSeconds of the token. The kubelet rotates it before it
+	// expires. Defaults to 1 hour.
+	// +kubebuilder:validation:Minimum=600
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+
+	// Path of the token file, relative to IdentityTokenMountPath.
+	// +kubebuilder:validation:MinLength=1
+	Path string `json:"path"`
+}
+
+// An IdentityTraitSpec defines the desired state of an IdentityTrait.
+type IdentityTraitSpec struct {
+	// ServiceAccountName of the service account the pods of the workload run
+	// as. The trait creates the service account unless it exists.
+	// +kubebuilder:validation:MinLength=1
+	ServiceAccountName string `json:"serviceAccountName"`
+
+	// ServiceAccountAnnotations set on the service account, e.g. to bind it
+	// to a cloud identity through eks.amazonaws.com/role-arn or
+	// iam.gke.io/gcp-service-account. They are only set on service accounts
+	// the trait created.
+	// +optional
+	ServiceAccountAnnotations map[string]string `json:"serviceAccountAnnotations,omitempty"`
+
+	// Tokens projected into the containers of the workload.
+	// +optional
+	Tokens []IdentityToken `json:"tokens,omitempty"`
+
+	// WorkloadReference to the workload this trait applies to.
+	WorkloadReference ResourceReference `json:"workloadRef"`
+}
+
+// An IdentityTraitStatus represents the observed state of an IdentityTrait.
+type IdentityTraitStatus struct {
+	cpv1alpha1.ConditionedStatus `json:",inline"`
+
+	// ObservedGeneration is the generation of the spec the status reflects.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Resources created by this trait, i.e. the service account unless it
+	// existed before.
+	Resources []ResourceReference `json:"resources,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+
+// IdentityTrait is the Schema for the identitytraits API
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="SERVICEACCOUNT",type="string",JSONPath=".spec.serviceAccountName"
+// +kubebuilder:printcolumn:name="WORKLOAD",type="string",JSONPath=".spec.workloadRef.name"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+type IdentityTrait struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IdentityTraitSpec   `json:"spec,omitempty"`
+	Status IdentityTraitStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IdentityTraitList contains a list of IdentityTrait
+type IdentityTraitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IdentityTrait `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&IdentityTrait{}, &IdentityTraitList{})
+}
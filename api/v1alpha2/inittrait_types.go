@@ -0,0 +1,72 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// An InitTraitSpec defines the desired state of an InitTrait.
+type InitTraitSpec struct {
+	// InitContainers added to the pod template of the workload's deployment,
+	// after those of the workload. An init container of the workload with
+	// the same name is replaced.
+	// +kubebuilder:validation:MinItems=1
+	InitContainers []corev1.Container `json:"initContainers"`
+
+	// WorkloadReference to the workload this trait applies to.
+	WorkloadReference ResourceReference `json:"workloadRef"`
+}
+
+// An InitTraitStatus represents the observed state of an InitTrait.
+type InitTraitStatus struct {
+	cpv1alpha1.ConditionedStatus `json:",inline"`
+
+	// ObservedGeneration is the generation of the spec the status reflects.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+
+// InitTrait is the Schema for the inittraits API
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="WORKLOAD",type="string",JSONPath=".spec.workloadRef.name"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+type InitTrait struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InitTraitSpec   `json:"spec,omitempty"`
+	Status InitTraitStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InitTraitList contains a list of InitTrait
+type InitTraitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InitTrait `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&InitTrait{}, &InitTraitList{})
+}
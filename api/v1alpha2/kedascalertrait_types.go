@@ -0,0 +1,107 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A KEDATrigger activates scaling based on an external event source.
+type KEDATrigger struct {
+	// Type of the KEDA scaler, e.g. kafka, rabbitmq or prometheus.
+	Type string `json:"type"`
+
+	// Metadata configures the scaler. The accepted keys depend on its type.
+	Metadata map[string]string `json:"metadata"`
+
+	// AuthenticationRef names a KEDA TriggerAuthentication in the trait's
+	// namespace holding the credentials of the event source.
+	// +optional
+	AuthenticationRef string `json:"authenticationRef,omitempty"`
+}
+
+// A KEDAScalerTraitSpec defines the desired state of a KEDAScalerTrait.
+type KEDAScalerTraitSpec struct {
+	// MinReplicaCount the workload may be scaled down to.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinReplicaCount *int32 `json:"minReplicaCount,omitempty"`
+
+	// MaxReplicaCount the workload may be scaled up to.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxReplicaCount *int32 `json:"maxReplicaCount,omitempty"`
+
+	// PollingInterval in seconds at which the triggers are checked.
+	// +optional
+	PollingInterval *int32 `json:"pollingInterval,omitempty"`
+
+	// CooldownPeriod in seconds to wait after the last trigger reported
+	// active before scaling back to MinReplicaCount.
+	// +optional
+	CooldownPeriod *int32 `json:"cooldownPeriod,omitempty"`
+
+	// Triggers that drive the scaling of the workload.
+	// +kubebuilder:validation:MinItems=1
+	Triggers []KEDATrigger `json:"triggers"`
+
+	// WorkloadReference to the workload this trait applies to.
+	WorkloadReference ResourceReference `json:"workloadRef"`
+}
+
+// A KEDAScalerTraitStatus represents the observed state of a KEDAScalerTrait.
+type KEDAScalerTraitStatus struct {
+	cpv1alpha1.ConditionedStatus `json:",inline"`
+
+	// ObservedGeneration is the generation of the spec the status reflects.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Resources rendered by this trait.
+	Resources []ResourceReference `json:"resources,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+
+// KEDAScalerTrait is the Schema for the kedascalertraits API
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="MIN",type="integer",JSONPath=".spec.minReplicaCount"
+// +kubebuilder:printcolumn:name="MAX",type="integer",JSONPath=".spec.maxReplicaCount"
+// +kubebuilder:printcolumn:name="WORKLOAD",type="string",JSONPath=".spec.workloadRef.name"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+type KEDAScalerTrait struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KEDAScalerTraitSpec   `json:"spec,omitempty"`
+	Status KEDAScalerTraitStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KEDAScalerTraitList contains a list of KEDAScalerTrait
+type KEDAScalerTraitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KEDAScalerTrait `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KEDAScalerTrait{}, &KEDAScalerTraitList{})
+}
@@ -17,9 +17,45 @@ package v1alpha2
 
 import (
 	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// An HPAConflictPolicy tells a ManualScalerTrait what to do when a
+// HorizontalPodAutoscaler targets the deployment it scales.
+// +kubebuilder:validation:Enum=Yield;AdjustHPA
+type HPAConflictPolicy string
+
+// HPA conflict policies.
+const (
+	// HPAConflictYield leaves the scaling of the deployment to the
+	// HorizontalPodAutoscaler.
+	HPAConflictYield HPAConflictPolicy = "Yield"
+
+	// HPAConflictAdjustHPA sets the minReplicas and maxReplicas of the
+	// HorizontalPodAutoscaler to the replicas of the trait, so that both
+	// agree, and scales the deployment.
+	HPAConflictAdjustHPA HPAConflictPolicy = "AdjustHPA"
+)
+
+// A ReplicaSource feeds the replicas of a ManualScalerTrait from outside the
+// trait, e.g. from a capacity planning system. Exactly one of ConfigMapKeyRef
+// and URL must be set.
+type ReplicaSource struct {
+	// ConfigMapKeyRef selects the key of a ConfigMap in the trait's namespace
+	// whose value is the replicas.
+	// +optional
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// URL of an HTTP endpoint whose response body is the replicas.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Interval at which the source is read. Defaults to 1m.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
 // A ManualScalerTraitSpec defines the desired state of a ManualScalerTrait.
 type ManualScalerTraitSpec struct {
 	// ReplicaCount of the workload this trait applies to.
@@ -27,6 +63,56 @@ type ManualScalerTraitSpec struct {
 	// +kubebuilder:validation:Maximum = 5
 	ReplicaCount int32 `json:"replicaCount"`
 
+	// ReplicaSource, if set, is read for the replicas of the workload
+	// instead of ReplicaCount, which is used until the source is first read.
+	// It is ignored with ZoneReplicas.
+	// +optional
+	ReplicaSource *ReplicaSource `json:"replicaSource,omitempty"`
+
+	// Priority of this trait over other ManualScalerTraits applying to the
+	// same workload. Only the trait with the highest priority scales it, ties
+	// are won by the oldest trait.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// ZoneReplicas, if set, runs the given number of replicas in each zone
+	// instead of ReplicaCount. Every zone gets its own copy of the workload's
+	// deployment pinned to the nodes of the zone, while the deployment of the
+	// workload itself is scaled to zero. The replicas of all zones add up to
+	// at most the maximum replica count.
+	// +optional
+	ZoneReplicas map[string]int32 `json:"zoneReplicas,omitempty"`
+
+	// Suspend stops the trait from scaling the workload. The replicas of the
+	// workload are still reported in the trait's status.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// StepSize, if set, is the most replicas the workload is scaled up or
+	// down by at once. Larger changes are made in steps of StepSize, one every
+	// StepInterval. Zone replicas are not stepped.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	StepSize *int32 `json:"stepSize,omitempty"`
+
+	// StepInterval is the time to wait between two steps. Defaults to 1m.
+	// +optional
+	StepInterval *metav1.Duration `json:"stepInterval,omitempty"`
+
+	// HPAConflictPolicy tells the trait what to do when a
+	// HorizontalPodAutoscaler targets the workload's deployment, rather than
+	// the trait, so that the two do not fight over its replicas. Defaults to
+	// Yield.
+	// +optional
+	HPAConflictPolicy HPAConflictPolicy `json:"hpaConflictPolicy,omitempty"`
+
+	// VerifyTimeout, if set, is how long the trait waits after scaling the
+	// workload for the ready replicas of its deployment to reach the new
+	// replicas before it reports them in a ScaleVerified condition. The
+	// trait is not synced until they do. Waits are capped at 2m.
+	// +optional
+	VerifyTimeout *metav1.Duration `json:"verifyTimeout,omitempty"`
+
 	// WorkloadReference to the workload this trait applies to.
 	WorkloadReference ResourceReference `json:"workloadRef"`
 }
@@ -34,12 +120,51 @@ type ManualScalerTraitSpec struct {
 // A ManualScalerTraitStatus represents the observed state of a manualScaler Trait.
 type ManualScalerTraitStatus struct {
 	cpv1alpha1.ConditionedStatus `json:",inline"`
+
+	// ObservedGeneration is the generation of the spec the status reflects.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// ObservedReplicas of the workload's deployment.
+	// +optional
+	ObservedReplicas *int32 `json:"observedReplicas,omitempty"`
+
+	// TargetReplicas the workload is being scaled to in steps, unset once
+	// it is reached.
+	// +optional
+	TargetReplicas *int32 `json:"targetReplicas,omitempty"`
+
+	// LastStepTime is when the trait last scaled the workload by a step.
+	// +optional
+	LastStepTime *metav1.Time `json:"lastStepTime,omitempty"`
+
+	// SourcedReplicas last read from the trait's replica source.
+	// +optional
+	SourcedReplicas *int32 `json:"sourcedReplicas,omitempty"`
+
+	// LastSourceTime is when the trait last read its replica source.
+	// +optional
+	LastSourceTime *metav1.Time `json:"lastSourceTime,omitempty"`
+
+	// Selector of the pods of the workload, in the string form of a label
+	// selector, for autoscalers scaling the trait through its scale
+	// subresource.
+	// +optional
+	Selector string `json:"selector,omitempty"`
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 
 // ManualScalerTrait is the Schema for the manualscalertraits API
+// +kubebuilder:resource:shortName=mst
 // +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.replicaCount,statuspath=.status.observedReplicas,selectorpath=.status.selector
+// +kubebuilder:printcolumn:name="REPLICAS",type="integer",JSONPath=".spec.replicaCount"
+// +kubebuilder:printcolumn:name="OBSERVED",type="integer",JSONPath=".status.observedReplicas"
+// +kubebuilder:printcolumn:name="WORKLOAD",type="string",JSONPath=".spec.workloadRef.name"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 type ManualScalerTrait struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -16,12 +16,22 @@ limitations under the License.
 package v1alpha2
 
 import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 )
 
+// MaxReplicaCount is the most replicas a ManualScalerTrait scales a workload
+// to, whether they are set in its spec or read from its replica source.
+const MaxReplicaCount = 10
+
 // log is for logging in this package.
 var manualscalertraitlog = logf.Log.WithName("manualscalertrait-resource")
 
@@ -37,9 +47,9 @@ var _ webhook.Defaulter = &ManualScalerTrait{}
 
 // Default implements webhook.Defaulter so a webhook will be registered for the type
 func (r *ManualScalerTrait) Default() {
-	if r.Spec.ReplicaCount > 10 {
-		r.Spec.ReplicaCount = 10
-		manualscalertraitlog.Info("Maximum replica count set to 10")
+	if r.Spec.ReplicaCount > MaxReplicaCount {
+		r.Spec.ReplicaCount = MaxReplicaCount
+		manualscalertraitlog.Info("Maximum replica count set", "replicas", MaxReplicaCount)
 	}
 
 	if len(r.Spec.WorkloadReference.Kind) == 0 {
@@ -59,17 +69,13 @@ var _ webhook.Validator = &ManualScalerTrait{}
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (r *ManualScalerTrait) ValidateCreate() error {
 	manualscalertraitlog.Info("validate create", "name", r.Name)
-
-	// TODO(user): fill in your validation logic upon object creation.
-	return nil
+	return r.validate()
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (r *ManualScalerTrait) ValidateUpdate(old runtime.Object) error {
 	manualscalertraitlog.Info("validate update", "name", r.Name)
-
-	// TODO(user): fill in your validation logic upon object update.
-	return nil
+	return r.validate()
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -79,3 +85,47 @@ func (r *ManualScalerTrait) ValidateDelete() error {
 	// TODO(user): fill in your validation logic upon object deletion.
 	return nil
 }
+
+func (r *ManualScalerTrait) validate() error {
+	zones := make([]string, 0, len(r.Spec.ZoneReplicas))
+	for zone := range r.Spec.ZoneReplicas {
+		zones = append(zones, zone)
+	}
+	sort.Strings(zones)
+	var total int32
+	for _, zone := range zones {
+		// zones name the deployments running in them
+		if errs := validation.IsDNS1123Label(zone); len(errs) > 0 {
+			return fmt.Errorf("zone %q: %s", zone, strings.Join(errs, ", "))
+		}
+		if r.Spec.ZoneReplicas[zone] < 0 {
+			return fmt.Errorf("zone %q: replicas must not be negative", zone)
+		}
+		total += r.Spec.ZoneReplicas[zone]
+	}
+	// the zones run as many replicas as the workload may have in total
+	if total > MaxReplicaCount {
+		return fmt.Errorf("zoneReplicas: %d replicas in total, more than the maximum of %d", total,
+			MaxReplicaCount)
+	}
+	if src := r.Spec.ReplicaSource; src != nil {
+		return validateReplicaSource(src)
+	}
+	return nil
+}
+
+func validateReplicaSource(src *ReplicaSource) error {
+	if (src.ConfigMapKeyRef == nil) == (src.URL == "") {
+		return fmt.Errorf("replicaSource: exactly one of configMapKeyRef and url must be set")
+	}
+	if src.URL != "" {
+		u, err := url.Parse(src.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("replicaSource: url %q is not an http or https URL", src.URL)
+		}
+	}
+	if src.Interval != nil && src.Interval.Duration <= 0 {
+		return fmt.Errorf("replicaSource: interval must be positive")
+	}
+	return nil
+}
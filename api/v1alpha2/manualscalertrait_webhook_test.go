@@ -0,0 +1,61 @@
+package v1alpha2
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestManualScalerTrait_validate(t *testing.T) {
+	testCases := map[string]struct {
+		zones   map[string]int32
+		source  *ReplicaSource
+		wantErr bool
+	}{
+		"NoZones": {},
+		"Zones": {
+			zones: map[string]int32{"us-east-1a": 3, "us-east-1b": 0},
+		},
+		"ZonesWithinMaximum": {
+			zones: map[string]int32{"us-east-1a": MaxReplicaCount - 1, "us-east-1b": 1},
+		},
+		"ZonesBeyondMaximum": {
+			zones:   map[string]int32{"us-east-1a": MaxReplicaCount, "us-east-1b": 1},
+			wantErr: true,
+		},
+		"InvalidZoneName": {
+			zones:   map[string]int32{"us_east_1a": 3},
+			wantErr: true,
+		},
+		"NegativeReplicas": {
+			zones:   map[string]int32{"us-east-1a": -1},
+			wantErr: true,
+		},
+		"ConfigMapSource": {
+			source: &ReplicaSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "capacity"}, Key: "web"}},
+		},
+		"URLSource": {
+			source: &ReplicaSource{URL: "https://capacity.example.com/web"},
+		},
+		"EmptySource": {
+			source:  &ReplicaSource{},
+			wantErr: true,
+		},
+		"NotAnHTTPURL": {
+			source:  &ReplicaSource{URL: "file:///etc/replicas"},
+			wantErr: true,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			trait := &ManualScalerTrait{Spec: ManualScalerTraitSpec{
+				ZoneReplicas:  testCase.zones,
+				ReplicaSource: testCase.source,
+			}}
+			if err := trait.validate(); (err != nil) != testCase.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
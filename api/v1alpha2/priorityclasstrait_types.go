@@ -0,0 +1,80 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A PriorityClassTraitSpec defines the desired state of a PriorityClassTrait.
+type PriorityClassTraitSpec struct {
+	// PriorityClassName of the PriorityClass the pods of the workload are
+	// scheduled with. It must be allowed by the AnnotationAllowedPriorityClasses
+	// annotation of the namespace, if any.
+	// +kubebuilder:validation:MinLength=1
+	PriorityClassName string `json:"priorityClassName"`
+
+	// PreemptionPolicy of the pods of the workload, overriding that of the
+	// PriorityClass.
+	// +kubebuilder:validation:Enum=PreemptLowerPriority;Never
+	// +optional
+	PreemptionPolicy *corev1.PreemptionPolicy `json:"preemptionPolicy,omitempty"`
+
+	// WorkloadReference to the workload this trait applies to.
+	WorkloadReference ResourceReference `json:"workloadRef"`
+}
+
+// A PriorityClassTraitStatus represents the observed state of a
+// PriorityClassTrait.
+type PriorityClassTraitStatus struct {
+	cpv1alpha1.ConditionedStatus `json:",inline"`
+
+	// ObservedGeneration is the generation of the spec the status reflects.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+
+// PriorityClassTrait is the Schema for the priorityclasstraits API
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="PRIORITYCLASS",type="string",JSONPath=".spec.priorityClassName"
+// +kubebuilder:printcolumn:name="WORKLOAD",type="string",JSONPath=".spec.workloadRef.name"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+type PriorityClassTrait struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PriorityClassTraitSpec   `json:"spec,omitempty"`
+	Status PriorityClassTraitStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PriorityClassTraitList contains a list of PriorityClassTrait
+type PriorityClassTraitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PriorityClassTrait `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PriorityClassTrait{}, &PriorityClassTraitList{})
+}
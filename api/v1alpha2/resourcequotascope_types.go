@@ -0,0 +1,108 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A ScopedWorkload is a workload of a ResourceQuotaScope.
+type ScopedWorkload struct {
+	// APIVersion of the workload.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the workload.
+	Kind string `json:"kind"`
+
+	// Namespace of the workload.
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+
+	// Name of the workload.
+	Name string `json:"name"`
+}
+
+// A ResourceQuotaScopeSpec defines the desired state of a ResourceQuotaScope.
+type ResourceQuotaScopeSpec struct {
+	// WorkloadReferences to the workloads of the scope. The quota and the
+	// limits apply to their namespaces.
+	// +kubebuilder:validation:MinItems=1
+	WorkloadReferences []ScopedWorkload `json:"workloadRefs"`
+
+	// Hard limits of the resources the namespaces of the workloads may use
+	// in total, e.g. requests.cpu: "8". Each namespace gets a ResourceQuota
+	// with a share of them in proportion to the number of workloads of the
+	// scope it holds.
+	// +optional
+	Hard corev1.ResourceList `json:"hard,omitempty"`
+
+	// Limits of the LimitRange created in each namespace, e.g. the default
+	// requests of the containers that do not declare any.
+	// +optional
+	Limits []corev1.LimitRangeItem `json:"limits,omitempty"`
+}
+
+// A ResourceQuotaScopeStatus represents the observed state of a
+// ResourceQuotaScope.
+type ResourceQuotaScopeStatus struct {
+	cpv1alpha1.ConditionedStatus `json:",inline"`
+
+	// Namespaces the scope covers.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Used resources of the namespaces in total, as observed by their
+	// ResourceQuotas.
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty"`
+
+	// ObservedGeneration is the generation of the spec the status reflects.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+
+// ResourceQuotaScope is the Schema for the resourcequotascopes API
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="NAMESPACES",type="string",JSONPath=".status.namespaces"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+type ResourceQuotaScope struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourceQuotaScopeSpec   `json:"spec,omitempty"`
+	Status ResourceQuotaScopeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourceQuotaScopeList contains a list of ResourceQuotaScope
+type ResourceQuotaScopeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceQuotaScope `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ResourceQuotaScope{}, &ResourceQuotaScopeList{})
+}
@@ -0,0 +1,81 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// A TrackedResource identifies a resource recorded by a ResourceTracker.
+type TrackedResource struct {
+	// APIVersion of the tracked resource.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the tracked resource.
+	Kind string `json:"kind"`
+
+	// Namespace of the tracked resource. Empty for cluster scoped resources.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the tracked resource.
+	Name string `json:"name"`
+
+	// UID of the tracked resource.
+	// +optional
+	UID types.UID `json:"uid,omitempty"`
+}
+
+// A ResourceTrackerSpec records the resources created on behalf of an owner.
+type ResourceTrackerSpec struct {
+	// Owner the tracked resources were created for.
+	Owner TrackedResource `json:"owner"`
+
+	// Resources created for the owner. They are deleted together with it.
+	// +optional
+	Resources []TrackedResource `json:"resources,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+
+// ResourceTracker is the Schema for the resourcetrackers API
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="OWNER-KIND",type="string",JSONPath=".spec.owner.kind"
+// +kubebuilder:printcolumn:name="OWNER",type="string",JSONPath=".spec.owner.name"
+// +kubebuilder:printcolumn:name="OWNER-NAMESPACE",type="string",JSONPath=".spec.owner.namespace"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+type ResourceTracker struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ResourceTrackerSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourceTrackerList contains a list of ResourceTracker
+type ResourceTrackerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceTracker `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ResourceTracker{}, &ResourceTrackerList{})
+}
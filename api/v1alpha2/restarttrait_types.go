@@ -0,0 +1,93 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AnnotationRestartedAt is set on the pod template of a deployment to the
+// time its pods were last restarted, the way kubectl rollout restart does.
+const AnnotationRestartedAt = "kubectl.kubernetes.io/restartedAt"
+
+// A RestartTraitSpec defines the desired state of a RestartTrait.
+type RestartTraitSpec struct {
+	// RestartedAt is when the pods of the workload are to be restarted.
+	// Setting it to a later time restarts them again, rolling out new pods
+	// the way kubectl rollout restart does. A time in the future schedules
+	// the restart.
+	RestartedAt metav1.Time `json:"restartedAt"`
+
+	// WorkloadReference to the workload this trait applies to.
+	WorkloadReference ResourceReference `json:"workloadRef"`
+}
+
+// A Restart of the pods of a workload's deployment.
+type Restart struct {
+	// RestartedAt the trait requested.
+	RestartedAt metav1.Time `json:"restartedAt"`
+
+	// Deployment whose pods were restarted.
+	Deployment string `json:"deployment"`
+
+	// Time the restart was rolled out.
+	Time metav1.Time `json:"time"`
+}
+
+// A RestartTraitStatus represents the observed state of a RestartTrait.
+type RestartTraitStatus struct {
+	cpv1alpha1.ConditionedStatus `json:",inline"`
+
+	// ObservedGeneration is the generation of the spec the status reflects.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Restarts the trait rolled out, the latest last. Only the latest ten
+	// are kept.
+	// +optional
+	Restarts []Restart `json:"restarts,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+
+// RestartTrait is the Schema for the restarttraits API
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="WORKLOAD",type="string",JSONPath=".spec.workloadRef.name"
+// +kubebuilder:printcolumn:name="RESTARTED-AT",type="date",JSONPath=".spec.restartedAt"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+type RestartTrait struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RestartTraitSpec   `json:"spec,omitempty"`
+	Status RestartTraitStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RestartTraitList contains a list of RestartTrait
+type RestartTraitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RestartTrait `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RestartTrait{}, &RestartTraitList{})
+}
@@ -0,0 +1,73 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A RuntimeClassTraitSpec defines the desired state of a RuntimeClassTrait.
+type RuntimeClassTraitSpec struct {
+	// RuntimeClassName of the RuntimeClass the pods of the workload run with,
+	// e.g. a sandboxed runtime such as gVisor or Kata Containers. The trait
+	// waits for the RuntimeClass to exist before setting it.
+	// +kubebuilder:validation:MinLength=1
+	RuntimeClassName string `json:"runtimeClassName"`
+
+	// WorkloadReference to the workload this trait applies to.
+	WorkloadReference ResourceReference `json:"workloadRef"`
+}
+
+// A RuntimeClassTraitStatus represents the observed state of a
+// RuntimeClassTrait.
+type RuntimeClassTraitStatus struct {
+	cpv1alpha1.ConditionedStatus `json:",inline"`
+
+	// ObservedGeneration is the generation of the spec the status reflects.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+
+// RuntimeClassTrait is the Schema for the runtimeclasstraits API
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="RUNTIMECLASS",type="string",JSONPath=".spec.runtimeClassName"
+// +kubebuilder:printcolumn:name="WORKLOAD",type="string",JSONPath=".spec.workloadRef.name"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+type RuntimeClassTrait struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RuntimeClassTraitSpec   `json:"spec,omitempty"`
+	Status RuntimeClassTraitStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RuntimeClassTraitList contains a list of RuntimeClassTrait
+type RuntimeClassTraitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RuntimeClassTrait `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RuntimeClassTrait{}, &RuntimeClassTraitList{})
+}
@@ -0,0 +1,114 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A ScratchMedium backs scratch space.
+type ScratchMedium string
+
+// Scratch media.
+const (
+	// ScratchMediumDisk backs scratch space with the ephemeral storage of
+	// the node.
+	ScratchMediumDisk ScratchMedium = "Disk"
+
+	// ScratchMediumMemory backs scratch space with a tmpfs, counted against
+	// the memory limits of the containers.
+	ScratchMediumMemory ScratchMedium = "Memory"
+)
+
+// A ScratchVolume is scratch space mounted into the containers of a
+// workload, lost when its pod goes away.
+type ScratchVolume struct {
+	// Name of the volume in the pods of the workload.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// MountPath of the volume in the containers.
+	// +kubebuilder:validation:MinLength=1
+	MountPath string `json:"mountPath"`
+
+	// Size of the volume, e.g. 1Gi. Pods writing more to it are evicted.
+	Size resource.Quantity `json:"size"`
+
+	// Medium backing the volume, Disk by default.
+	// +kubebuilder:validation:Enum=Disk;Memory
+	// +optional
+	Medium ScratchMedium `json:"medium,omitempty"`
+
+	// Containers the volume is mounted into, all the containers of the
+	// workload by default.
+	// +optional
+	Containers []string `json:"containers,omitempty"`
+}
+
+// A ScratchStorageTraitSpec defines the desired state of a
+// ScratchStorageTrait.
+type ScratchStorageTraitSpec struct {
+	// Volumes of scratch space of the pods of the workload. The
+	// ephemeral-storage requests of the first container mounting each Disk
+	// volume are raised to at least its size, so that pods are scheduled to
+	// nodes with room for them.
+	// +kubebuilder:validation:MinItems=1
+	Volumes []ScratchVolume `json:"volumes"`
+
+	// WorkloadReference to the workload this trait applies to.
+	WorkloadReference ResourceReference `json:"workloadRef"`
+}
+
+// A ScratchStorageTraitStatus represents the observed state of a
+// ScratchStorageTrait.
+type ScratchStorageTraitStatus struct {
+	cpv1alpha1.ConditionedStatus `json:",inline"`
+
+	// ObservedGeneration is the generation of the spec the status reflects.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+
+// ScratchStorageTrait is the Schema for the scratchstoragetraits API
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="WORKLOAD",type="string",JSONPath=".spec.workloadRef.name"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+type ScratchStorageTrait struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ScratchStorageTraitSpec   `json:"spec,omitempty"`
+	Status ScratchStorageTraitStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScratchStorageTraitList contains a list of ScratchStorageTrait
+type ScratchStorageTraitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScratchStorageTrait `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ScratchStorageTrait{}, &ScratchStorageTraitList{})
+}
@@ -0,0 +1,102 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A SpreadTraitSpec defines the desired state of a SpreadTrait.
+type SpreadTraitSpec struct {
+	// TopologySpreadConstraints added to the pod template of the workload's
+	// deployment. A constraint of the workload with the same topology key is
+	// replaced. Constraints without a label selector spread the pods of the
+	// workload.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// AwayFrom spreads the pods of the workload away from the pods of other
+	// workloads, e.g. the other components of an application. Either it or
+	// TopologySpreadConstraints must be set.
+	// +optional
+	AwayFrom *SpreadAwayFrom `json:"awayFrom,omitempty"`
+
+	// WorkloadReference to the workload this trait applies to.
+	WorkloadReference ResourceReference `json:"workloadRef"`
+}
+
+// DefaultAwayFromTopologyKey is the topology key pods are spread away from
+// the pods of other workloads by unless another one is given.
+const DefaultAwayFromTopologyKey = "kubernetes.io/hostname"
+
+// SpreadAwayFrom adds a pod anti-affinity to the pods of a workload that
+// keeps them out of the topology domains running pods of other workloads.
+type SpreadAwayFrom struct {
+	// Workloads names the ContainerizedWorkloads of the namespace whose pods
+	// are avoided.
+	// +kubebuilder:validation:MinItems=1
+	Workloads []string `json:"workloads"`
+
+	// TopologyKey of the domains avoided. Defaults to
+	// kubernetes.io/hostname, i.e. nodes.
+	// +optional
+	TopologyKey string `json:"topologyKey,omitempty"`
+
+	// Required keeps pods pending rather than schedule them next to the
+	// pods avoided. By default the scheduler only prefers other domains.
+	// +optional
+	Required bool `json:"required,omitempty"`
+}
+
+// A SpreadTraitStatus represents the observed state of a SpreadTrait.
+type SpreadTraitStatus struct {
+	cpv1alpha1.ConditionedStatus `json:",inline"`
+
+	// ObservedGeneration is the generation of the spec the status reflects.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+
+// SpreadTrait is the Schema for the spreadtraits API
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="WORKLOAD",type="string",JSONPath=".spec.workloadRef.name"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+type SpreadTrait struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SpreadTraitSpec   `json:"spec,omitempty"`
+	Status SpreadTraitStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SpreadTraitList contains a list of SpreadTrait
+type SpreadTraitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SpreadTrait `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SpreadTrait{}, &SpreadTraitList{})
+}
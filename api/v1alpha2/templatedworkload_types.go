@@ -0,0 +1,76 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// A TemplatedWorkloadSpec defines the desired state of a TemplatedWorkload.
+type TemplatedWorkloadSpec struct {
+	// Template is the name of the WorkloadTemplate the children of this
+	// workload are rendered from.
+	// +kubebuilder:validation:MinLength=1
+	Template string `json:"template"`
+
+	// Parameters the template is rendered with.
+	// +optional
+	Parameters *runtime.RawExtension `json:"parameters,omitempty"`
+}
+
+// A TemplatedWorkloadStatus represents the observed state of a
+// TemplatedWorkload.
+type TemplatedWorkloadStatus struct {
+	cpv1alpha1.ConditionedStatus `json:",inline"`
+
+	// ObservedGeneration is the generation of the spec the status reflects.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Resources rendered by this workload.
+	Resources []ResourceReference `json:"resources,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+
+// TemplatedWorkload is the Schema for the templatedworkloads API
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="TEMPLATE",type="string",JSONPath=".spec.template"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+type TemplatedWorkload struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TemplatedWorkloadSpec   `json:"spec,omitempty"`
+	Status TemplatedWorkloadStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TemplatedWorkloadList contains a list of TemplatedWorkload
+type TemplatedWorkloadList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TemplatedWorkload `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TemplatedWorkload{}, &TemplatedWorkloadList{})
+}
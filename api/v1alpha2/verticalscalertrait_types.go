@@ -0,0 +1,130 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A VerticalScalerUpdateMode is how the resource requests recommended for a
+// workload are applied.
+type VerticalScalerUpdateMode string
+
+// Supported update modes.
+const (
+	// VerticalScalerUpdateModeOff only records the recommended requests in
+	// the status of the trait.
+	VerticalScalerUpdateModeOff VerticalScalerUpdateMode = "Off"
+	// VerticalScalerUpdateModeInitial lets the autoscaler set the requests of
+	// new pods only.
+	VerticalScalerUpdateModeInitial VerticalScalerUpdateMode = "Initial"
+	// VerticalScalerUpdateModeAuto lets the autoscaler evict pods to update
+	// their requests.
+	VerticalScalerUpdateModeAuto VerticalScalerUpdateMode = "Auto"
+	// VerticalScalerUpdateModeDeployment sets the recommended requests on
+	// the pod template of the workload's deployment, rolling them out like
+	// any other change of the workload.
+	VerticalScalerUpdateModeDeployment VerticalScalerUpdateMode = "Deployment"
+)
+
+// A VerticalScalerContainerPolicy bounds the requests recommended for a
+// container.
+type VerticalScalerContainerPolicy struct {
+	// ContainerName the policy applies to, * for every container.
+	ContainerName string `json:"containerName"`
+
+	// MinAllowed requests of the container.
+	// +optional
+	MinAllowed corev1.ResourceList `json:"minAllowed,omitempty"`
+
+	// MaxAllowed requests of the container.
+	// +optional
+	MaxAllowed corev1.ResourceList `json:"maxAllowed,omitempty"`
+}
+
+// A VerticalScalerTraitSpec defines the desired state of a
+// VerticalScalerTrait.
+type VerticalScalerTraitSpec struct {
+	// UpdateMode of the recommended requests. Defaults to Auto.
+	// +kubebuilder:validation:Enum=Off;Initial;Auto;Deployment
+	// +optional
+	UpdateMode VerticalScalerUpdateMode `json:"updateMode,omitempty"`
+
+	// ContainerPolicies bounding the recommended requests.
+	// +optional
+	ContainerPolicies []VerticalScalerContainerPolicy `json:"containerPolicies,omitempty"`
+
+	// WorkloadReference to the workload this trait applies to.
+	WorkloadReference ResourceReference `json:"workloadRef"`
+}
+
+// A VerticalScalerRecommendation is the requests recommended for a container.
+type VerticalScalerRecommendation struct {
+	// ContainerName the requests are recommended for.
+	ContainerName string `json:"containerName"`
+
+	// Target requests of the container.
+	Target corev1.ResourceList `json:"target"`
+}
+
+// A VerticalScalerTraitStatus represents the observed state of a
+// VerticalScalerTrait.
+type VerticalScalerTraitStatus struct {
+	cpv1alpha1.ConditionedStatus `json:",inline"`
+
+	// ObservedGeneration is the generation of the spec the status reflects.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Resources rendered by this trait.
+	Resources []ResourceReference `json:"resources,omitempty"`
+
+	// Recommendations of the vertical pod autoscaler.
+	// +optional
+	Recommendations []VerticalScalerRecommendation `json:"recommendations,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+
+// VerticalScalerTrait is the Schema for the verticalscalertraits API
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="MODE",type="string",JSONPath=".spec.updateMode"
+// +kubebuilder:printcolumn:name="WORKLOAD",type="string",JSONPath=".spec.workloadRef.name"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+type VerticalScalerTrait struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VerticalScalerTraitSpec   `json:"spec,omitempty"`
+	Status VerticalScalerTraitStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VerticalScalerTraitList contains a list of VerticalScalerTrait
+type VerticalScalerTraitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VerticalScalerTrait `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VerticalScalerTrait{}, &VerticalScalerTraitList{})
+}
@@ -0,0 +1,58 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A WorkloadTemplateSpec defines how the children of a TemplatedWorkload are
+// rendered.
+type WorkloadTemplateSpec struct {
+	// Template is a Go template rendering a YAML stream of the manifests of
+	// the children of a TemplatedWorkload. It is rendered with the
+	// .Workload's Name, Namespace, UID, Generation, Labels and Annotations
+	// and the workload's .Parameters.
+	// +kubebuilder:validation:MinLength=1
+	Template string `json:"template"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+
+// WorkloadTemplate is the Schema for the workloadtemplates API
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+type WorkloadTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec WorkloadTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkloadTemplateList contains a list of WorkloadTemplate
+type WorkloadTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkloadTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&WorkloadTemplate{}, &WorkloadTemplateList{})
+}
@@ -0,0 +1,109 @@
+package controllers
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// errListNodes is returned when the architectures of the nodes cannot be read.
+const errListNodes = "cannot list the nodes"
+
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch
+
+// imageArchitecture returns the CPU architecture the workload runs on: the
+// one it requires or, if it selects images by architecture, the one it has
+// images for that most ready nodes have. It returns nil if the workload may
+// run on any architecture.
+func (r *ContainerizedWorkloadReconciler) imageArchitecture(ctx context.Context,
+	workload *oamv1alpha2.ContainerizedWorkload) (*oamv1alpha2.CPUArchitecture, error) {
+	if workload.Spec.CPUArchitecture != nil || len(workload.Spec.ArchitectureImages) == 0 {
+		return workload.Spec.CPUArchitecture, nil
+	}
+	candidates := map[oamv1alpha2.CPUArchitecture]bool{}
+	for _, ai := range workload.Spec.ArchitectureImages {
+		for arch := range ai.Images {
+			candidates[arch] = true
+		}
+	}
+
+	var nodes corev1.NodeList
+	if err := r.List(ctx, &nodes); err != nil {
+		return nil, errors.Wrap(err, errListNodes)
+	}
+	ready := map[oamv1alpha2.CPUArchitecture]int{}
+	for _, n := range nodes.Items {
+		if !nodeReady(&n) {
+			continue
+		}
+		arch := oamv1alpha2.CPUArchitecture(n.Labels[labelArch])
+		for a, v := range archLabelValues {
+			if v == string(arch) {
+				arch = a
+			}
+		}
+		if candidates[arch] {
+			ready[arch]++
+		}
+	}
+	if len(ready) == 0 {
+		return nil, nil
+	}
+	archs := make([]oamv1alpha2.CPUArchitecture, 0, len(ready))
+	for arch := range ready {
+		archs = append(archs, arch)
+	}
+	// the most nodes win, ties are broken by name so that renders are stable
+	sort.Slice(archs, func(i, j int) bool {
+		if ready[archs[i]] != ready[archs[j]] {
+			return ready[archs[i]] > ready[archs[j]]
+		}
+		return archs[i] < archs[j]
+	})
+	return &archs[0], nil
+}
+
+func nodeReady(n *corev1.Node) bool {
+	for _, c := range n.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// architectureImages returns the containers with the images selected for the
+// architecture. The containers are only copied if an image changes.
+func architectureImages(images []oamv1alpha2.ArchitectureImages, arch *oamv1alpha2.CPUArchitecture,
+	containers []corev1.Container) []corev1.Container {
+	if arch == nil || len(images) == 0 {
+		return containers
+	}
+	var selected []corev1.Container
+	for _, ai := range images {
+		image, ok := ai.Images[*arch]
+		if !ok {
+			continue
+		}
+		for i := range containers {
+			if containers[i].Name != ai.Container || containers[i].Image == image {
+				continue
+			}
+			if selected == nil {
+				selected = make([]corev1.Container, len(containers))
+				for k := range containers {
+					containers[k].DeepCopyInto(&selected[k])
+				}
+			}
+			selected[i].Image = image
+		}
+	}
+	if selected == nil {
+		return containers
+	}
+	return selected
+}
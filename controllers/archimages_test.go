@@ -0,0 +1,119 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestImageArchitecture(t *testing.T) {
+	node := func(name, arch string, ready corev1.ConditionStatus) runtime.Object {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{labelArch: arch}},
+			Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: ready},
+			}},
+		}
+	}
+	arm64 := oamv1alpha2.CPUArchitectureARM64
+	amd64 := oamv1alpha2.CPUArchitectureAMD64
+	i386 := oamv1alpha2.CPUArchitectureI386
+	images := []oamv1alpha2.ArchitectureImages{{
+		Container: "web",
+		Images: map[oamv1alpha2.CPUArchitecture]string{
+			amd64: "registry.local/web:1.0-amd64",
+			arm64: "registry.local/web:1.0-arm64",
+			i386:  "registry.local/web:1.0-386",
+		},
+	}}
+	testCases := map[string]struct {
+		arch   *oamv1alpha2.CPUArchitecture
+		images []oamv1alpha2.ArchitectureImages
+		nodes  []runtime.Object
+		want   *oamv1alpha2.CPUArchitecture
+	}{
+		"NoImages": {
+			nodes: []runtime.Object{node("a", "arm64", corev1.ConditionTrue)},
+		},
+		"RequiredArch": {
+			arch:   &amd64,
+			images: images,
+			nodes:  []runtime.Object{node("a", "arm64", corev1.ConditionTrue)},
+			want:   &amd64,
+		},
+		"MostReadyNodes": {
+			images: images,
+			nodes: []runtime.Object{
+				node("a", "arm64", corev1.ConditionTrue),
+				node("b", "arm64", corev1.ConditionTrue),
+				node("c", "amd64", corev1.ConditionTrue),
+				node("d", "amd64", corev1.ConditionFalse),
+				node("e", "amd64", corev1.ConditionFalse),
+			},
+			want: &arm64,
+		},
+		"TieByName": {
+			images: images,
+			nodes: []runtime.Object{
+				node("a", "arm64", corev1.ConditionTrue),
+				node("b", "amd64", corev1.ConditionTrue),
+			},
+			want: &amd64,
+		},
+		"LabelValue": {
+			images: images,
+			nodes:  []runtime.Object{node("a", "386", corev1.ConditionTrue)},
+			want:   &i386,
+		},
+		"NoMatchingNodes": {
+			images: images,
+			nodes:  []runtime.Object{node("a", "s390x", corev1.ConditionTrue)},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := ContainerizedWorkloadReconciler{Client: fake.NewFakeClientWithScheme(testScheme, tc.nodes...)}
+			w := &oamv1alpha2.ContainerizedWorkload{Spec: oamv1alpha2.ContainerizedWorkloadSpec{
+				CPUArchitecture:    tc.arch,
+				ArchitectureImages: tc.images,
+			}}
+			got, err := r.imageArchitecture(context.Background(), w)
+			if err != nil {
+				t.Fatalf("imageArchitecture() error = %v", err)
+			}
+			if (got == nil) != (tc.want == nil) || got != nil && *got != *tc.want {
+				t.Errorf("imageArchitecture() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestArchitectureImages(t *testing.T) {
+	arm64 := oamv1alpha2.CPUArchitectureARM64
+	amd64 := oamv1alpha2.CPUArchitectureAMD64
+	images := []oamv1alpha2.ArchitectureImages{{
+		Container: "web",
+		Images:    map[oamv1alpha2.CPUArchitecture]string{arm64: "registry.local/web:1.0-arm64"},
+	}}
+	containers := []corev1.Container{
+		{Name: "web", Image: "registry.local/web:1.0"},
+		{Name: "sidecar", Image: "envoy"},
+	}
+
+	got := architectureImages(images, &arm64, containers)
+	if got[0].Image != "registry.local/web:1.0-arm64" || got[1].Image != "envoy" {
+		t.Errorf("architectureImages(arm64) = %v", got)
+	}
+	if containers[0].Image != "registry.local/web:1.0" {
+		t.Errorf("architectureImages(arm64) changed the workload's containers")
+	}
+	if got := architectureImages(images, &amd64, containers); &got[0] != &containers[0] {
+		t.Errorf("architectureImages(amd64) copied the containers, want them unchanged")
+	}
+}
@@ -0,0 +1,160 @@
+package controllers
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/simtest"
+)
+
+// the number of workloads the reconcile benchmarks spread their reconciles
+// over, e.g. go test ./controllers -run '^$' -bench Reconcile -load-workloads 500
+var loadWorkloads = flag.Int("load-workloads", 50, "The number of workloads the reconcile benchmarks create.")
+
+func benchmarkWorkload(name string) *oamv1alpha2.ContainerizedWorkload {
+	return &oamv1alpha2.ContainerizedWorkload{
+		TypeMeta: metav1.TypeMeta{APIVersion: oamv1alpha2.GroupVersion.String(), Kind: kindContainerizedWorkload},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID(name + "-uid"),
+			Labels: map[string]string{"app": name}},
+		Spec: oamv1alpha2.ContainerizedWorkloadSpec{
+			Containers: []corev1.Container{{
+				Name:  "web",
+				Image: "nginx:1.17",
+				Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 80}},
+				Env: []corev1.EnvVar{
+					{Name: "WORKLOAD", Value: "{{ .Workload.Name }}"},
+					{Name: "LOG_LEVEL", Value: "info"},
+				},
+			}},
+		},
+	}
+}
+
+func BenchmarkRenderWorkload(b *testing.B) {
+	r := &ContainerizedWorkloadReconciler{Scheme: testScheme}
+	workload := benchmarkWorkload("web")
+	ctx := context.Background()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		deploy, err := r.renderWorkload(ctx, workload)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := r.renderService(ctx, deploy, workload); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPatchedDeployment(b *testing.B) {
+	r := &ContainerizedWorkloadReconciler{Scheme: testScheme}
+	deploy, err := r.renderWorkload(context.Background(), benchmarkWorkload("web"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	trait := &oamv1alpha2.PatchTrait{ObjectMeta: metav1.ObjectMeta{Name: "web-patch", Namespace: "default"}}
+	trait.Spec.Patch = runtime.RawExtension{Raw: []byte(`{"metadata": {"annotations": {"team": "web"}},
+		"spec": {"containers": [{"name": "web", "env": [{"name": "LOG_LEVEL", "value": "debug"}]}]}}`)}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := patchedDeployment(trait, deploy); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkRecordManagedFields(b *testing.B) {
+	r := &ContainerizedWorkloadReconciler{Scheme: testScheme}
+	deploy, err := r.renderWorkload(context.Background(), benchmarkWorkload("web"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	changed := deploy.DeepCopy()
+	changed.Spec.Template.Spec.PriorityClassName = "high"
+	changed.Spec.Template.Labels["tier"] = "frontend"
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := recordManagedFields(deploy, changed.DeepCopy(), managedFieldsKey(kindPatchTrait, "web")); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkContainerizedWorkloadReconcile measures the throughput of
+// reconciling the workloads of a namespace, round robin.
+func BenchmarkContainerizedWorkloadReconcile(b *testing.B) {
+	workloads := make([]runtime.Object, *loadWorkloads)
+	for i := range workloads {
+		workloads[i] = benchmarkWorkload(fmt.Sprintf("web-%d", i))
+	}
+	h, err := simtest.New(benchmarkWorkload("web"), nil, workloads...)
+	if err != nil {
+		b.Fatal(err)
+	}
+	r := &ContainerizedWorkloadReconciler{Client: h, Log: ctrl.Log, Scheme: h.Scheme}
+	benchmarkReconcile(b, r, workloads)
+}
+
+// BenchmarkManualScalerTraitReconcile measures the throughput of reconciling
+// a ManualScalerTrait per workload, round robin.
+func BenchmarkManualScalerTraitReconcile(b *testing.B) {
+	var objs, traits []runtime.Object
+	for i := 0; i < *loadWorkloads; i++ {
+		name := fmt.Sprintf("web-%d", i)
+		w := benchmarkWorkload(name)
+		uid := w.UID
+		deploy := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID(name + "-deploy-uid")},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": name}},
+			},
+		}
+		w.Status.Resources = []oamv1alpha2.ResourceReference{{APIVersion: "apps/v1", Kind: "Deployment",
+			Name: name, UID: &deploy.UID}}
+		trait := &oamv1alpha2.ManualScalerTrait{
+			TypeMeta:   metav1.TypeMeta{APIVersion: oamv1alpha2.GroupVersion.String(), Kind: "ManualScalerTrait"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: oamv1alpha2.ManualScalerTraitSpec{ReplicaCount: 3, WorkloadReference: oamv1alpha2.ResourceReference{
+				APIVersion: oamv1alpha2.GroupVersion.String(), Kind: kindContainerizedWorkload, Name: name, UID: &uid,
+			}},
+		}
+		objs = append(objs, w, deploy, trait)
+		traits = append(traits, trait)
+	}
+	h, err := simtest.New(benchmarkWorkload("web"), nil, objs...)
+	if err != nil {
+		b.Fatal(err)
+	}
+	r := &ManualScalerTraitReconciler{Client: h, Log: ctrl.Log, Scheme: h.Scheme}
+	benchmarkReconcile(b, r, traits)
+}
+
+func benchmarkReconcile(b *testing.B, r reconcile.Reconciler, objs []runtime.Object) {
+	b.Helper()
+	reqs := make([]reconcile.Request, len(objs))
+	for i, obj := range objs {
+		m := obj.(metav1.Object)
+		reqs[i] = reconcile.Request{NamespacedName: types.NamespacedName{Namespace: m.GetNamespace(), Name: m.GetName()}}
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := r.Reconcile(reqs[i%len(reqs)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// errConfigChecksum is returned when the config of a workload cannot be read.
+const errConfigChecksum = "cannot compute the checksum of the config of the workload"
+
+// kinds of the config a workload reloads on
+const (
+	configKindConfigMap = "ConfigMap"
+	configKindSecret    = "Secret"
+)
+
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
+
+// a configReference is a ConfigMap or Secret the containers of a workload
+// refer to
+type configReference struct {
+	Kind string
+	Name string
+}
+
+// setConfigChecksum sets the AnnotationConfigChecksum annotation of the pod
+// template of the deployment of a workload that reloads on config changes.
+func (r *ContainerizedWorkloadReconciler) setConfigChecksum(ctx context.Context,
+	workload *oamv1alpha2.ContainerizedWorkload, deploy *appsv1.Deployment) error {
+	if !workload.Spec.ReloadOnConfigChange {
+		return nil
+	}
+	sum, err := configChecksum(ctx, r, workload.Namespace, configReferences(workload))
+	if err != nil {
+		return errors.Wrap(err, errConfigChecksum)
+	}
+	// the annotations may be shared with the workload's spec, copy them first
+	annotations := make(map[string]string, len(deploy.Spec.Template.Annotations)+1)
+	for k, v := range deploy.Spec.Template.Annotations {
+		annotations[k] = v
+	}
+	annotations[oamv1alpha2.AnnotationConfigChecksum] = sum
+	deploy.Spec.Template.Annotations = annotations
+	return nil
+}
+
+// configReferences returns the ConfigMaps and Secrets the containers of the
+// workload refer to, ordered by kind and name.
+func configReferences(workload *oamv1alpha2.ContainerizedWorkload) []configReference {
+	seen := map[configReference]bool{}
+	add := func(kind, name string) {
+		if name != "" {
+			seen[configReference{Kind: kind, Name: name}] = true
+		}
+	}
+	containers := append(append([]corev1.Container{}, workload.Spec.InitContainers...), workload.Spec.Containers...)
+	for _, c := range containers {
+		for _, env := range c.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if ref := env.ValueFrom.ConfigMapKeyRef; ref != nil {
+				add(configKindConfigMap, ref.Name)
+			}
+			if ref := env.ValueFrom.SecretKeyRef; ref != nil {
+				add(configKindSecret, ref.Name)
+			}
+		}
+		for _, from := range c.EnvFrom {
+			if from.ConfigMapRef != nil {
+				add(configKindConfigMap, from.ConfigMapRef.Name)
+			}
+			if from.SecretRef != nil {
+				add(configKindSecret, from.SecretRef.Name)
+			}
+		}
+	}
+	for _, sel := range templatedSecrets(workload) {
+		add(configKindSecret, sel.Name)
+	}
+	for _, ref := range workload.Spec.ExternalReferences {
+		if ref.Kind == oamv1alpha2.ExternalReferenceSecret {
+			add(configKindSecret, ref.Name)
+		}
+	}
+
+	refs := make([]configReference, 0, len(seen))
+	for ref := range seen {
+		refs = append(refs, ref)
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].Kind != refs[j].Kind {
+			return refs[i].Kind < refs[j].Kind
+		}
+		return refs[i].Name < refs[j].Name
+	})
+	return refs
+}
+
+// configChecksum returns a checksum of the data of the ConfigMaps and
+// Secrets. Missing ones count as empty, so that creating them changes the
+// checksum too.
+func configChecksum(ctx context.Context, c client.Client, namespace string, refs []configReference) (string, error) {
+	h := sha256.New()
+	for _, ref := range refs {
+		fmt.Fprintf(h, "%s/%s\n", ref.Kind, ref.Name)
+		key := client.ObjectKey{Namespace: namespace, Name: ref.Name}
+		data := map[string][]byte{}
+		switch ref.Kind {
+		case configKindConfigMap:
+			cm := &corev1.ConfigMap{}
+			err := c.Get(ctx, key, cm)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return "", err
+			}
+			for k, v := range cm.Data {
+				data[k] = []byte(v)
+			}
+			for k, v := range cm.BinaryData {
+				data[k] = v
+			}
+		case configKindSecret:
+			secret := &corev1.Secret{}
+			err := c.Get(ctx, key, secret)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return "", err
+			}
+			data = secret.Data
+		}
+		keys := make([]string, 0, len(data))
+		for k := range data {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(h, "%s=%d:%s\n", k, len(data[k]), data[k])
+		}
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// enqueue the workloads reloading on changes of a ConfigMap or Secret they
+// refer to
+func (r *ContainerizedWorkloadReconciler) configWorkloads(o handler.MapObject) []reconcile.Request {
+	// the changes of the many configs of the cluster nothing reloads on do
+	// not list the workloads of their namespace
+	if o.Meta.GetLabels()[oamv1alpha2.LabelReloadWorkloads] != "true" {
+		return nil
+	}
+	var changed configReference
+	switch o.Object.(type) {
+	case *corev1.ConfigMap:
+		changed = configReference{Kind: configKindConfigMap, Name: o.Meta.GetName()}
+	case *corev1.Secret:
+		changed = configReference{Kind: configKindSecret, Name: o.Meta.GetName()}
+	default:
+		return nil
+	}
+	var workloads oamv1alpha2.ContainerizedWorkloadList
+	if err := r.List(context.Background(), &workloads, client.InNamespace(o.Meta.GetNamespace())); err != nil {
+		r.Log.Error(err, "Failed to list the workloads referring to a config", "kind", changed.Kind,
+			"name", changed.Name)
+		return nil
+	}
+	var reqs []reconcile.Request
+	for i := range workloads.Items {
+		w := &workloads.Items[i]
+		if !w.Spec.ReloadOnConfigChange {
+			continue
+		}
+		for _, ref := range configReferences(w) {
+			if ref == changed {
+				reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: w.Name,
+					Namespace: w.Namespace}})
+				break
+			}
+		}
+	}
+	return reqs
+}
@@ -0,0 +1,113 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestSetConfigChecksum(t *testing.T) {
+	workload := &oamv1alpha2.ContainerizedWorkload{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: oamv1alpha2.ContainerizedWorkloadSpec{
+			ReloadOnConfigChange: true,
+			Containers: []corev1.Container{{
+				Name: "web",
+				EnvFrom: []corev1.EnvFromSource{{
+					ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "web-config"}},
+				}},
+				Env: []corev1.EnvVar{{Name: "TOKEN", ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "web-token"}, Key: "token"},
+				}}},
+			}},
+		},
+	}
+	config := func(level string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-config", Namespace: "default"},
+			Data:       map[string]string{"LOG_LEVEL": level},
+		}
+	}
+	token := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-token", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte("s3cr3t")},
+	}
+	other := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"},
+		Data:       map[string]string{"LOG_LEVEL": "debug"},
+	}
+	checksum := func(w *oamv1alpha2.ContainerizedWorkload, objs ...runtime.Object) string {
+		t.Helper()
+		r := &ContainerizedWorkloadReconciler{Client: fake.NewFakeClientWithScheme(testScheme, objs...)}
+		deploy := &appsv1.Deployment{}
+		deploy.Spec.Template.Annotations = map[string]string{"team": "web"}
+		if err := r.setConfigChecksum(context.Background(), w, deploy); err != nil {
+			t.Fatalf("setConfigChecksum() = %v", err)
+		}
+		if deploy.Spec.Template.Annotations["team"] != "web" {
+			t.Errorf("setConfigChecksum() dropped the annotations of the pod template")
+		}
+		return deploy.Spec.Template.Annotations[oamv1alpha2.AnnotationConfigChecksum]
+	}
+
+	sum := checksum(workload, config("info"), token, other)
+	if sum == "" {
+		t.Fatal("setConfigChecksum() set no checksum")
+	}
+	testCases := map[string]struct {
+		objs    []runtime.Object
+		changed bool
+	}{
+		"Unchanged": {
+			objs: []runtime.Object{config("info"), token, other},
+		},
+		"OtherConfigChanged": {
+			objs: []runtime.Object{config("info"), token},
+		},
+		"ConfigChanged": {
+			objs:    []runtime.Object{config("debug"), token, other},
+			changed: true,
+		},
+		"SecretDeleted": {
+			objs:    []runtime.Object{config("info"), other},
+			changed: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := checksum(workload, tc.objs...); (got != sum) != tc.changed {
+				t.Errorf("setConfigChecksum() = %q, changed %t, want changed %t", got, got != sum, tc.changed)
+			}
+		})
+	}
+
+	optedOut := workload.DeepCopy()
+	optedOut.Spec.ReloadOnConfigChange = false
+	if got := checksum(optedOut, config("info"), token); got != "" {
+		t.Errorf("setConfigChecksum() = %q for a workload not reloading on config changes", got)
+	}
+
+	optedOut.Name = "api"
+	r := &ContainerizedWorkloadReconciler{Client: fake.NewFakeClientWithScheme(testScheme, workload, optedOut)}
+	changed := config("debug")
+	if reqs := r.configWorkloads(handler.MapObject{Meta: changed, Object: changed}); len(reqs) != 0 {
+		t.Errorf("configWorkloads() = %v for a config without the %s label", reqs, oamv1alpha2.LabelReloadWorkloads)
+	}
+	changed.SetLabels(map[string]string{oamv1alpha2.LabelReloadWorkloads: "true"})
+	other.SetLabels(map[string]string{oamv1alpha2.LabelReloadWorkloads: "true"})
+	reqs := r.configWorkloads(handler.MapObject{Meta: changed, Object: changed})
+	if len(reqs) != 1 || reqs[0].Name != "web" {
+		t.Errorf("configWorkloads() = %v, want the web workload", reqs)
+	}
+	if reqs := r.configWorkloads(handler.MapObject{Meta: other, Object: other}); len(reqs) != 0 {
+		t.Errorf("configWorkloads() = %v for a config no workload refers to", reqs)
+	}
+}
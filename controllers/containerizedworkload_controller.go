@@ -18,6 +18,8 @@ package controllers
 import (
 	"context"
 	"github.com/pkg/errors"
+	"net/http"
+	"strings"
 	"time"
 
 	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
@@ -26,18 +28,30 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/debug"
+	"github.com/oam-dev/core-resource-controller/pkg/renderhook"
 )
 
 const (
 	oamReconcileWait = 30 * time.Second
 )
 
+// eventProgressDeadlineExceeded is the reason of the event recorded when the
+// rollout of a workload is stuck.
+const eventProgressDeadlineExceeded = "ProgressDeadlineExceeded"
+
 // Reconcile error strings.
 const (
 	errRenderWorkload   = "cannot render workload"
@@ -47,6 +61,10 @@ const (
 	errGCDeployment     = "cannot clean up stale deployments"
 	errUpdateDeployment = "cannot update the deployment"
 	errScaleDeployment  = "cannot scale the deployment"
+	errListPods         = "cannot list the pods of the deployment"
+	errRenderEnv        = "cannot render the environment variable"
+	errSummarizeChange  = "cannot summarize the change of the spec"
+	errRecordSpec       = "cannot record the applied spec"
 )
 
 // ContainerizedWorkloadReconciler reconciles a ContainerizedWorkload object
@@ -54,12 +72,39 @@ type ContainerizedWorkloadReconciler struct {
 	client.Client
 	Log    logr.Logger
 	Scheme *runtime.Scheme
+	// Limiter, if set, rate limits the writes of child resources per namespace.
+	Limiter *NamespaceWriteLimiter
+	// Debug, if set, records the outcome of each reconcile.
+	Debug *debug.Recorder
+	// Events, if set, records an event when a rollout exceeds its progress
+	// deadline, the TTL of a workload ends or its spec changes.
+	Events record.EventRecorder
+	// HTTPClient sends the health probes of workloads. Defaults to
+	// http.DefaultClient.
+	HTTPClient *http.Client
+	// PreRender, if set, may change a workload before it is rendered.
+	PreRender renderhook.Hook
+	// PostRender, if set, may change the deployments and services rendered
+	// from a workload before they are applied.
+	PostRender renderhook.Hook
+	// Limits, if set, bound the deployments and services a workload renders.
+	Limits *RenderLimits
+	// GitOps, if set, annotates the deployments and services for the GitOps
+	// tools managing their workload.
+	GitOps *GitOpsMetadata
+
+	// the pods of workloads in the manager's cluster, set up with the manager
+	pods toolscache.Indexer
 }
 
-// +kubebuilder:rbac:groups=core.oam.dev,resources=containerizedworkloads,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.oam.dev,resources=containerizedworkloads,verbs=get;list;watch;update
 // +kubebuilder:rbac:groups=core.oam.dev,resources=containerizedworkloads/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=secrets;persistentvolumeclaims,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.oam.dev,resources=resourcetrackers,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 func (r *ContainerizedWorkloadReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	ctx := context.Background()
@@ -71,84 +116,354 @@ func (r *ContainerizedWorkloadReconciler) Reconcile(req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 	log.Info("Get the workload", "apiVersion", workload.APIVersion, "kind", workload.Kind)
+	// in development, the children may be applied to the cluster of another
+	// kubeconfig context
+	ctx = withKubeconfigContext(ctx, &workload)
+
+	deleted, err := finalizeTrackedResources(ctx, r, &workload)
+	if err != nil {
+		log.Error(err, "Failed to finalize the tracked resources")
+		return reconcile.Result{RequeueAfter: oamReconcileWait}, err
+	}
+	if deleted {
+		forgetWorkload(workload.Namespace, workload.Name)
+		return reconcile.Result{}, nil
+	}
+
+	untilExpiry, expired, err := expireWorkload(ctx, r, r.Scheme, &workload, time.Now())
+	if err != nil {
+		workload.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Failed to delete the expired workload")
+		return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
+			errUpdateStatus)
+	}
+	if expired {
+		log.Info("Deleted the workload at the end of its TTL", "ttl", workload.Spec.TTL.Duration)
+		if r.Events != nil {
+			r.Events.Event(&workload, corev1.EventTypeNormal, eventWorkloadExpired, "The TTL of the workload ended")
+		}
+		return reconcile.Result{}, nil
+	}
 
-	deploy, err := r.renderWorkload(ctx, &workload)
+	if result, ok, err := gateReconcile(ctx, r, log, r.Limiter, "", &workload, &workload.Status.ConditionedStatus, nil); !ok {
+		return result, err
+	}
+
+	renderStart := time.Now()
+	// the workload as changed by the pre-render hook is only rendered, its
+	// status is still reported on the workload itself
+	rendered, err := r.preRender(ctx, &workload)
+	if err != nil {
+		workload.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Failed to run the pre-render hook")
+		return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
+			errUpdateStatus)
+	}
+	deploy, err := r.renderWorkload(ctx, rendered)
 	if err != nil {
-		workload.Status.SetConditions(cpv1alpha1.ReconcileError(errors.Wrap(err, errRenderWorkload)))
+		workload.Status.SetConditions(reconcileError(errors.Wrap(err, errRenderWorkload))...)
 		log.Error(err, "Failed to render a deployment")
 		return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
 			errUpdateStatus)
 	}
 	log.Info("Successfully rendered a deployment", "deployment", deploy.Name)
 
-	// server side apply, only the fields we set are touched
-	applyOpts := []client.PatchOption{client.ForceOwnership, client.FieldOwner(workload.ObjectMeta.Name)}
-	if err := r.Patch(ctx, deploy, client.Apply, applyOpts...); err != nil {
-		workload.Status.SetConditions(cpv1alpha1.ReconcileError(errors.Wrap(err, errApplyDeployment)))
-		log.Error(err, "Failed to apply to a deployment")
+	missing, err := r.injectExternalReferences(ctx, rendered, deploy)
+	if err != nil {
+		workload.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Failed to resolve the external references")
+		return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
+			errUpdateStatus)
+	}
+	if missing != "" {
+		// wait for the resources to be created rather than rolling out pods
+		// that cannot start
+		log.Info("External resources are missing", "reason", missing)
+		workload.Status.SetConditions(oamv1alpha2.ExternalReferencesMissing(missing))
 		return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
 			errUpdateStatus)
 	}
-	log.Info("Successfully applied a deployment", "UID", deploy.UID)
 
-	if err := r.Status().Update(ctx, &workload); err != nil {
-		return reconcile.Result{RequeueAfter: oamReconcileWait}, err
+	if err := r.setConfigChecksum(ctx, rendered, deploy); err != nil {
+		workload.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Failed to compute the config checksum")
+		return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
+			errUpdateStatus)
 	}
 
-	// create a service for the workload
+	deploys := renderShards(rendered, deploy)
+
+	// create a service for each deployment of the workload
 	// TODO(rz): Use ingress trait instead
-	service, err := r.renderService(ctx, deploy, &workload)
-	if err != nil {
-		log.Error(err, "Failed to render a deployment")
+	services := make([]*corev1.Service, 0, len(deploys))
+	for _, deploy := range deploys {
+		service, err := r.renderService(ctx, deploy, rendered)
+		if err != nil {
+			workload.Status.SetConditions(reconcileError(errors.Wrap(err, errApplyService))...)
+			log.Error(err, "Failed to render a service")
+			return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
+				errUpdateStatus)
+		}
+		services = append(services, service)
+	}
+	if err := r.postRender(ctx, rendered, deploys, services); err != nil {
+		workload.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Failed to run the post-render hook")
 		return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
 			errUpdateStatus)
 	}
+	observeRender("ContainerizedWorkload", renderStart)
 
-	// server side apply the service
-	if err := r.Patch(ctx, service, client.Apply, applyOpts...); err != nil {
-		workload.Status.SetConditions(cpv1alpha1.ReconcileError(errors.Wrap(err, errApplyService)))
-		log.Error(err, "Failed to apply a service")
+	objs := make([]runtime.Object, 0, len(deploys)+len(services))
+	for _, deploy := range deploys {
+		objs = append(objs, deploy)
+	}
+	for _, service := range services {
+		objs = append(objs, service)
+	}
+	r.GitOps.annotate(&workload, objs...)
+	if err := r.Limits.check(objs...); err != nil {
+		workload.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Rendered children exceed the render limits")
 		return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
 			errUpdateStatus)
 	}
-	log.Info("Successfully applied a service", "UID", service.UID)
+
+	// publish how the spec changed before the change is applied
+	if applied := workload.Status.LastAppliedSpec; applied != nil &&
+		workload.Status.LastChangeGeneration != workload.Generation {
+		change, err := summarizeSpecChange(applied, &workload.Spec)
+		if err != nil {
+			workload.Status.SetConditions(reconcileError(errors.Wrap(err, errSummarizeChange))...)
+			log.Error(err, "Failed to summarize the change of the spec")
+			return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
+				errUpdateStatus)
+		}
+		if change != "" {
+			log.Info("The spec of the workload changed", "change", change)
+			workload.Status.LastChange, workload.Status.LastChangeGeneration = change, workload.Generation
+			if r.Events != nil {
+				r.Events.Event(&workload, corev1.EventTypeNormal, eventSpecChanged, change)
+			}
+			if err := r.Status().Update(ctx, &workload); err != nil {
+				return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(err, errUpdateStatus)
+			}
+		}
+	}
+
+	// server side apply, only the fields we set are touched
+	applyOpts := []client.PatchOption{client.ForceOwnership, client.FieldOwner(workload.ObjectMeta.Name)}
+	for _, deploy := range deploys {
+		if err := applyChild(ctx, r, r.Scheme, deploy, applyOpts...); err != nil {
+			workload.Status.SetConditions(reconcileError(errors.Wrap(err, errApplyDeployment))...)
+			log.Error(err, "Failed to apply to a deployment")
+			return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
+				errUpdateStatus)
+		}
+		log.Info("Successfully applied a deployment", "UID", deploy.UID)
+	}
+
+	if err := r.Status().Update(ctx, &workload); err != nil {
+		return reconcile.Result{RequeueAfter: oamReconcileWait}, err
+	}
+
+	for _, service := range services {
+		// server side apply the service
+		if err := applyChild(ctx, r, r.Scheme, service, applyOpts...); err != nil {
+			workload.Status.SetConditions(reconcileError(errors.Wrap(err, errApplyService))...)
+			log.Error(err, "Failed to apply a service")
+			return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
+				errUpdateStatus)
+		}
+		log.Info("Successfully applied a service", "UID", service.UID)
+	}
 
 	// garbage collect the service/deployments that we created but not needed
-	if err := r.cleanupResources(ctx, &workload, &deploy.UID, &service.UID); err != nil {
-		workload.Status.SetConditions(cpv1alpha1.ReconcileError(errors.Wrap(err, errGCDeployment)))
+	keep := map[types.UID]bool{}
+	children := make([]runtime.Object, 0, 2*len(deploys))
+	for i := range deploys {
+		keep[deploys[i].UID] = true
+		keep[services[i].UID] = true
+		children = append(children, deploys[i], services[i])
+	}
+	if err := r.cleanupResources(ctx, &workload, keep); err != nil {
+		workload.Status.SetConditions(reconcileError(errors.Wrap(err, errGCDeployment))...)
 		log.Error(err, "Failed to clean up resources")
 		return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
 			errUpdateStatus)
 	}
+	if err := trackResources(ctx, r, r.Scheme, &workload, children...); err != nil {
+		workload.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Failed to track resources")
+		return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
+			errUpdateStatus)
+	}
 	workload.Status.Resources = nil
-	// record the new deployment
-	workload.Status.Resources = append(workload.Status.Resources, oamv1alpha2.ResourceReference{
-		APIVersion: deploy.APIVersion,
-		Kind:       deploy.Kind,
-		Name:       deploy.Name,
-		UID:        &deploy.UID,
-	})
-	// record the new service
-	workload.Status.Resources = append(workload.Status.Resources, oamv1alpha2.ResourceReference{
-		APIVersion: service.APIVersion,
-		Kind:       service.Kind,
-		Name:       service.Name,
-		UID:        &service.UID,
-	})
+	for i := range deploys {
+		// record the new deployment
+		workload.Status.Resources = append(workload.Status.Resources, oamv1alpha2.ResourceReference{
+			APIVersion: deploys[i].APIVersion,
+			Kind:       deploys[i].Kind,
+			Name:       deploys[i].Name,
+			UID:        &deploys[i].UID,
+		})
+		// record the new service
+		workload.Status.Resources = append(workload.Status.Resources, oamv1alpha2.ResourceReference{
+			APIVersion: services[i].APIVersion,
+			Kind:       services[i].Kind,
+			Name:       services[i].Name,
+			UID:        &services[i].UID,
+		})
+	}
+	applied, err := appliedSpec(&workload.Spec)
+	if err != nil {
+		workload.Status.SetConditions(reconcileError(errors.Wrap(err, errRecordSpec))...)
+		log.Error(err, "Failed to record the applied spec")
+		return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
+			errUpdateStatus)
+	}
+	workload.Status.LastAppliedSpec = applied
 
 	if err := r.Status().Update(ctx, &workload); err != nil {
 		return reconcile.Result{RequeueAfter: oamReconcileWait}, err
 	}
 
-	workload.Status.SetConditions(cpv1alpha1.ReconcileSuccess())
-	return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &workload), errUpdateStatus)
+	// surface pods the scheduler cannot place, e.g. for lack of GPUs, and
+	// containers that cannot pull their image or keep crashing
+	var unschedulable, failing []string
+	workload.Status.Replicas, workload.Status.ReadyReplicas, workload.Status.Restarts = 0, 0, 0
+	for _, deploy := range deploys {
+		u, err := r.unschedulablePod(ctx, deploy)
+		if err != nil {
+			workload.Status.SetConditions(reconcileError(errors.Wrap(err, errListPods))...)
+			log.Error(err, "Failed to list the pods of a deployment")
+			return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
+				errUpdateStatus)
+		}
+		f, err := r.failingContainers(ctx, deploy)
+		if err != nil {
+			workload.Status.SetConditions(reconcileError(errors.Wrap(err, errListPods))...)
+			log.Error(err, "Failed to list the pods of a deployment")
+			return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
+				errUpdateStatus)
+		}
+		restarts, err := r.containerRestarts(ctx, deploy)
+		if err != nil {
+			workload.Status.SetConditions(reconcileError(errors.Wrap(err, errListPods))...)
+			log.Error(err, "Failed to list the pods of a deployment")
+			return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
+				errUpdateStatus)
+		}
+		// the api server returned the status of the deployment along with it
+		workload.Status.Replicas += deploy.Status.Replicas
+		workload.Status.ReadyReplicas += deploy.Status.ReadyReplicas
+		workload.Status.Restarts += restarts
+		if u != "" {
+			unschedulable = append(unschedulable, u)
+		}
+		if f != "" {
+			failing = append(failing, f)
+		}
+	}
+
+	result := ctrl.Result{}
+	scheduled := oamv1alpha2.Scheduled()
+	if len(unschedulable) > 0 {
+		log.Info("A pod cannot be scheduled", "reason", unschedulable)
+		scheduled = oamv1alpha2.Unschedulable(strings.Join(unschedulable, "; "))
+		result.RequeueAfter = oamReconcileWait
+	}
+	healthy := oamv1alpha2.ContainersHealthy()
+	if len(failing) > 0 {
+		log.Info("Containers are failing", "reason", failing)
+		healthy = oamv1alpha2.ContainersFailing(strings.Join(failing, "; "))
+	}
+	// the api server returned the status of the deployments along with them
+	degraded := oamv1alpha2.NotDegraded()
+	if stuck := stuckDeployments(deploys); stuck != "" {
+		log.Info("Rollout exceeded its progress deadline", "reason", stuck)
+		if workload.Status.GetCondition(oamv1alpha2.TypeDegraded).Status != corev1.ConditionTrue && r.Events != nil {
+			r.Events.Event(&workload, corev1.EventTypeWarning, eventProgressDeadlineExceeded, stuck)
+		}
+		degraded = oamv1alpha2.Degraded(stuck)
+	}
+	r.Limiter.MarkForceSynced(&workload)
+	workload.Status.SetConditions(scheduled, healthy, degraded, oamv1alpha2.PermissionGranted(),
+		cpv1alpha1.ReconcileSuccess())
+	if probe := workload.Spec.HealthProbe; probe != nil {
+		endpoints := oamv1alpha2.EndpointsHealthy()
+		if failing := r.failingEndpoints(ctx, probe, services); failing != "" {
+			log.Info("Endpoints fail the health probe", "reason", failing)
+			endpoints = oamv1alpha2.EndpointsFailing(failing)
+		}
+		workload.Status.SetConditions(endpoints)
+		// nothing else triggers a reconcile when an endpoint starts failing
+		result.RequeueAfter = oamReconcileWait
+	}
+	if workload.Spec.HealthPolicy != nil {
+		available, after := checkHealthPolicy(&workload, deploys, time.Now())
+		if available.Status != corev1.ConditionTrue {
+			log.Info("Too few replicas are ready", "reason", available.Message)
+		}
+		workload.Status.SetConditions(available)
+		if after > 0 && (result.RequeueAfter == 0 || after < result.RequeueAfter) {
+			result.RequeueAfter = after
+		}
+	} else {
+		workload.Status.RolloutGeneration, workload.Status.RolloutStartedAt = 0, nil
+		workload.Status.ConsecutiveFailures = 0
+	}
+	if kubeconfigContext(ctx) != "" {
+		// the children of another cluster are not watched
+		result.RequeueAfter = oamReconcileWait
+	}
+	if len(workload.Spec.ExternalReferences) > 0 || len(templatedSecrets(&workload)) > 0 {
+		workload.Status.SetConditions(oamv1alpha2.ExternalReferencesResolved())
+	}
+	if untilExpiry > 0 && (result.RequeueAfter == 0 || untilExpiry < result.RequeueAfter) {
+		result.RequeueAfter = untilExpiry
+	}
+	observeWorkload(workload.Namespace, workload.Name, &workload.Status)
+	return result, errors.Wrap(r.Status().Update(ctx, &workload), errUpdateStatus)
 }
 
 func (r *ContainerizedWorkloadReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	src := &oamv1alpha2.ContainerizedWorkload{}
+	// only the pods of workloads are watched and cached
+	pods, err := selectedInformer(mgr, "pods", &corev1.Pod{},
+		labels.SelectorFromSet(labels.Set{OAMResourceTypeLabel: string(workloadType)}))
+	if err != nil {
+		return err
+	}
+	r.pods = pods.GetIndexer()
+	// only the config labelled to reload workloads is watched
+	reload := labels.SelectorFromSet(labels.Set{oamv1alpha2.LabelReloadWorkloads: "true"})
+	configMaps, err := selectedInformer(mgr, "configmaps", &corev1.ConfigMap{}, reload)
+	if err != nil {
+		return err
+	}
+	secrets, err := selectedInformer(mgr, "secrets", &corev1.Secret{}, reload)
+	if err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(src).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Service{}).
-		Complete(r)
+		Watches(&source.Informer{
+			Informer: pods,
+		}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(podWorkload),
+		}).
+		Watches(&source.Informer{
+			Informer: configMaps,
+		}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.configWorkloads),
+		}).
+		Watches(&source.Informer{
+			Informer: secrets,
+		}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.configWorkloads),
+		}).
+		Complete(r.Debug.Wrap("ContainerizedWorkload", r))
 }
@@ -2,8 +2,9 @@ package controllers
 
 import (
 	"context"
-	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"fmt"
 	"github.com/pkg/errors"
+	"strconv"
 	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -12,10 +13,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	toolscache "k8s.io/client-go/tools/cache"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/envtemplate"
 )
 
 const (
@@ -33,11 +38,38 @@ const (
 	workloadType OAMResourceTypes = "workload"
 )
 
+// defaultRevisionHistoryLimit is used when a workload does not specify how
+// many old deployment revisions to keep.
+const defaultRevisionHistoryLimit int32 = 100
+
+// deploymentNameSuffix is appended to the workload name to name its deployment.
+const deploymentNameSuffix = "-deployment"
+
+// defaultServicePort the service of a workload listens on.
+const defaultServicePort int32 = 8080
+
 // create a corresponding deployment
-func (r *ContainerizedWorkloadReconciler) renderWorkload(_ context.Context,
+func (r *ContainerizedWorkloadReconciler) renderWorkload(ctx context.Context,
 	workload *oamv1alpha2.ContainerizedWorkload) (*appsv1.Deployment, error) {
-	var RevisionHistoryLimit int32 = 100
-	deployName := workload.Name + "-deployment"
+	RevisionHistoryLimit := defaultRevisionHistoryLimit
+	if workload.Spec.RevisionHistoryLimit != nil {
+		RevisionHistoryLimit = *workload.Spec.RevisionHistoryLimit
+	}
+	initContainers, err := renderEnvTemplates(workload, workload.Spec.InitContainers)
+	if err != nil {
+		return nil, err
+	}
+	containers, err := renderEnvTemplates(workload, workload.Spec.Containers)
+	if err != nil {
+		return nil, err
+	}
+	arch, err := r.imageArchitecture(ctx, workload)
+	if err != nil {
+		return nil, err
+	}
+	initContainers = architectureImages(workload.Spec.ArchitectureImages, arch, initContainers)
+	containers = architectureImages(workload.Spec.ArchitectureImages, arch, containers)
+	deployName := workload.Name + deploymentNameSuffix
 	depl := appsv1.Deployment{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: appsv1.SchemeGroupVersion.String(),
@@ -51,17 +83,21 @@ func (r *ContainerizedWorkloadReconciler) renderWorkload(_ context.Context,
 			Selector: &metav1.LabelSelector{
 				MatchLabels: map[string]string{OAMResourceTypeLabel: string(workloadType), OAMResourceNameLabel: deployName},
 			},
-			RevisionHistoryLimit: &RevisionHistoryLimit,
+			RevisionHistoryLimit:    &RevisionHistoryLimit,
+			ProgressDeadlineSeconds: workload.Spec.ProgressDeadlineSeconds,
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: map[string]string{OAMResourceTypeLabel: string(workloadType), OAMResourceNameLabel: deployName},
 				},
 				Spec: corev1.PodSpec{
-					Containers: workload.Spec.Containers,
+					InitContainers: initContainers,
+					Containers:     containers,
+					Affinity:       platformAffinity(workload.Spec.OperatingSystem, arch),
 				},
 			},
 		},
 	}
+	windowsPodSpec(workload, &depl.Spec.Template.Spec)
 
 	// always set the controller reference so that we can watch this deployment
 	if err := ctrl.SetControllerReference(workload, &depl, r.Scheme); err != nil {
@@ -71,20 +107,200 @@ func (r *ContainerizedWorkloadReconciler) renderWorkload(_ context.Context,
 	return &depl, nil
 }
 
+// renderEnvTemplates returns the containers with their templated environment
+// variable values rendered for the workload, and those referring to a secret
+// read from it. The containers are only copied if they have any.
+func renderEnvTemplates(workload *oamv1alpha2.ContainerizedWorkload,
+	containers []corev1.Container) ([]corev1.Container, error) {
+	var rendered []corev1.Container
+	data := envtemplate.ForObject(workload)
+	for i := range containers {
+		for j, env := range containers[i].Env {
+			if !envtemplate.IsTemplate(env.Value) {
+				continue
+			}
+			if rendered == nil {
+				rendered = make([]corev1.Container, len(containers))
+				for k := range containers {
+					containers[k].DeepCopyInto(&rendered[k])
+				}
+			}
+			if sel, ok := envtemplate.SecretReference(env.Value); ok {
+				rendered[i].Env[j].Value = ""
+				rendered[i].Env[j].ValueFrom = &corev1.EnvVarSource{SecretKeyRef: sel}
+				continue
+			}
+			v, err := envtemplate.Render(env.Value, data)
+			if err != nil {
+				return nil, errors.Wrapf(err, "%s %s of container %s", errRenderEnv, env.Name, containers[i].Name)
+			}
+			rendered[i].Env[j].Value = v
+		}
+	}
+	if rendered == nil {
+		return containers, nil
+	}
+	return rendered, nil
+}
+
+// OAMShardLabel holds the index of the shard on the pods of a sharded workload.
+const OAMShardLabel = "oam.dev/shard"
+
+// renderShards returns the deployment of every shard of the workload, or the
+// deployment itself if the workload is not sharded. The shards are named
+// after the deployment and their index, and tell their containers which shard
+// they are through the workload's shard key environment variable.
+func renderShards(workload *oamv1alpha2.ContainerizedWorkload, deploy *appsv1.Deployment) []*appsv1.Deployment {
+	sharding := workload.Spec.Sharding
+	if sharding == nil {
+		return []*appsv1.Deployment{deploy}
+	}
+	key := sharding.ShardKey
+	if key == "" {
+		key = oamv1alpha2.DefaultShardKey
+	}
+	count := strconv.Itoa(int(sharding.Shards))
+	shards := make([]*appsv1.Deployment, 0, sharding.Shards)
+	for i := 0; i < int(sharding.Shards); i++ {
+		index := strconv.Itoa(i)
+		shard := deploy.DeepCopy()
+		shard.Name = deploy.Name + "-" + index
+		labels := map[string]string{
+			OAMResourceTypeLabel: string(workloadType),
+			OAMResourceNameLabel: shard.Name,
+		}
+		shard.Spec.Selector.MatchLabels = labels
+		shard.Spec.Template.Labels = map[string]string{OAMShardLabel: index}
+		for k, v := range labels {
+			shard.Spec.Template.Labels[k] = v
+		}
+		env := []corev1.EnvVar{{Name: key, Value: index}, {Name: key + "_COUNT", Value: count}}
+		for c := range shard.Spec.Template.Spec.Containers {
+			container := &shard.Spec.Template.Spec.Containers[c]
+			container.Env = append(container.Env, env...)
+		}
+		shards = append(shards, shard)
+	}
+	return shards
+}
+
+// node labels of the platform a node runs on
+const (
+	labelOS   = "kubernetes.io/os"
+	labelArch = "kubernetes.io/arch"
+)
+
+// kubernetes reports i386 nodes as 386, the other architectures match
+var archLabelValues = map[oamv1alpha2.CPUArchitecture]string{
+	oamv1alpha2.CPUArchitectureI386: "386",
+}
+
+// require nodes matching the workload's operating system and architecture
+func platformAffinity(os *oamv1alpha2.OperatingSystem, arch *oamv1alpha2.CPUArchitecture) *corev1.Affinity {
+	var exprs []corev1.NodeSelectorRequirement
+	if os != nil {
+		exprs = append(exprs, corev1.NodeSelectorRequirement{
+			Key:      labelOS,
+			Operator: corev1.NodeSelectorOpIn,
+			Values:   []string{string(*os)},
+		})
+	}
+	if arch != nil {
+		v, ok := archLabelValues[*arch]
+		if !ok {
+			v = string(*arch)
+		}
+		exprs = append(exprs, corev1.NodeSelectorRequirement{
+			Key:      labelArch,
+			Operator: corev1.NodeSelectorOpIn,
+			Values:   []string{v},
+		})
+	}
+	if len(exprs) == 0 {
+		return nil
+	}
+	return &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{{MatchExpressions: exprs}},
+		},
+	}}
+}
+
+// windowsTaint is the taint keeping linux pods off windows nodes, see
+// https://kubernetes.io/docs/setup/production-environment/windows/user-guide-windows-containers/
+var windowsTaint = corev1.Toleration{
+	Key:      "os",
+	Operator: corev1.TolerationOpEqual,
+	Value:    string(oamv1alpha2.OperatingSystemWindows),
+	Effect:   corev1.TaintEffectNoSchedule,
+}
+
+// windowsPodSpec lets the pods of a windows workload run on tainted windows
+// nodes, with the workload's windows options.
+func windowsPodSpec(workload *oamv1alpha2.ContainerizedWorkload, spec *corev1.PodSpec) {
+	if os := workload.Spec.OperatingSystem; os == nil || *os != oamv1alpha2.OperatingSystemWindows {
+		return
+	}
+	spec.Tolerations = append(spec.Tolerations, windowsTaint)
+	if workload.Spec.WindowsOptions != nil {
+		spec.SecurityContext = &corev1.PodSecurityContext{WindowsOptions: workload.Spec.WindowsOptions.DeepCopy()}
+	}
+}
+
+// runsOnWindows tells whether the pods of the deployment require windows
+// nodes.
+func runsOnWindows(deploy *appsv1.Deployment) bool {
+	a := deploy.Spec.Template.Spec.Affinity
+	if a == nil || a.NodeAffinity == nil || a.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return false
+	}
+	for _, term := range a.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+		for _, expr := range term.MatchExpressions {
+			if expr.Key == labelOS && expr.Operator == corev1.NodeSelectorOpIn && len(expr.Values) == 1 &&
+				expr.Values[0] == string(oamv1alpha2.OperatingSystemWindows) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // create a service for the deployment
 func (r *ContainerizedWorkloadReconciler) renderService(_ context.Context, deploy *appsv1.Deployment,
 	workload *oamv1alpha2.ContainerizedWorkload) (*corev1.Service, error) {
-	// create a default in case there is no container port
-	port := &corev1.ContainerPort{
-		Name:          "OAM default",
-		Protocol:      "TCP",
-		ContainerPort: 8080,
-	}
+	// expose the first container port on the default service port, further
+	// ports of the containers are exposed on their own port number
+	ports := []corev1.ServicePort{}
 	for _, c := range deploy.Spec.Template.Spec.Containers {
-		if len(c.Ports) != 0 {
-			port = &c.Ports[0]
+		for _, p := range c.Ports {
+			protocol := p.Protocol
+			if protocol == "" {
+				protocol = corev1.ProtocolTCP
+			}
+			sp := corev1.ServicePort{
+				Name:       strings.ToLower(string(protocol)),
+				Port:       defaultServicePort,
+				Protocol:   protocol,
+				TargetPort: intstr.FromInt(int(p.ContainerPort)),
+			}
+			if len(ports) != 0 {
+				sp.Name = fmt.Sprintf("%s-%d", sp.Name, p.ContainerPort)
+				sp.Port = p.ContainerPort
+			}
+			if !hasServicePort(ports, sp) {
+				ports = append(ports, sp)
+			}
 		}
 	}
+	// create a default in case there is no container port
+	if len(ports) == 0 {
+		ports = append(ports, corev1.ServicePort{
+			Name:       "tcp",
+			Port:       defaultServicePort,
+			Protocol:   corev1.ProtocolTCP,
+			TargetPort: intstr.FromInt(int(defaultServicePort)),
+		})
+	}
 
 	svc := corev1.Service{
 		TypeMeta: metav1.TypeMeta{
@@ -96,14 +312,7 @@ func (r *ContainerizedWorkloadReconciler) renderService(_ context.Context, deplo
 			Namespace: deploy.Namespace,
 		},
 		Spec: corev1.ServiceSpec{
-			Ports: []corev1.ServicePort{
-				{
-					Name:       strings.ToLower(string(port.Protocol)),
-					Port:       8080,
-					Protocol:   port.Protocol,
-					TargetPort: intstr.FromInt(int(port.ContainerPort)),
-				},
-			},
+			Ports:    ports,
 			Selector: map[string]string{OAMResourceNameLabel: deploy.Name},
 			Type:     corev1.ServiceTypeClusterIP,
 		},
@@ -111,50 +320,194 @@ func (r *ContainerizedWorkloadReconciler) renderService(_ context.Context, deplo
 
 	// always set the controller reference so that we can watch this service
 	if err := ctrl.SetControllerReference(workload, &svc, r.Scheme); err != nil {
-		workload.Status.SetConditions(cpv1alpha1.ReconcileError(errors.Wrap(err, errApplyService)))
+		workload.Status.SetConditions(reconcileError(errors.Wrap(err, errApplyService))...)
 		return nil, err
 	}
 	return &svc, nil
 }
 
-// delete deployments/services that are not the same as the existing
+func (r *ContainerizedWorkloadReconciler) deploymentPods(ctx context.Context,
+	deploy *appsv1.Deployment) ([]corev1.Pod, error) {
+	// the pods of other clusters are not cached
+	if r.pods != nil && kubeconfigContext(ctx) == "" {
+		objs, err := r.pods.ByIndex(toolscache.NamespaceIndex, deploy.Namespace)
+		var pods []corev1.Pod
+		for _, o := range objs {
+			if p, ok := o.(*corev1.Pod); ok && p.Labels[OAMResourceNameLabel] == deploy.Name {
+				pods = append(pods, *p)
+			}
+		}
+		return pods, err
+	}
+	var pods corev1.PodList
+	err := r.List(ctx, &pods, client.InNamespace(deploy.Namespace),
+		client.MatchingLabels{OAMResourceNameLabel: deploy.Name})
+	return pods.Items, err
+}
+
+// describe the first pod of the deployment that the scheduler cannot place, or
+// return "" if there is none
+func (r *ContainerizedWorkloadReconciler) unschedulablePod(ctx context.Context,
+	deploy *appsv1.Deployment) (string, error) {
+	pods, err := r.deploymentPods(ctx, deploy)
+	if err != nil {
+		return "", err
+	}
+	for _, p := range pods {
+		for _, c := range p.Status.Conditions {
+			if c.Type == corev1.PodScheduled && c.Status == corev1.ConditionFalse &&
+				c.Reason == corev1.PodReasonUnschedulable {
+				return fmt.Sprintf("pod %s: %s", p.Name, c.Message), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// waiting reasons of containers that will not start without a change
+var failingWaitingReasons = map[string]bool{
+	"ErrImagePull":     true,
+	"ImagePullBackOff": true,
+	"CrashLoopBackOff": true,
+}
+
+// reasonOOMKilled is the reason of a container terminated for exceeding its
+// memory limit.
+const reasonOOMKilled = "OOMKilled"
+
+// describe the containers of the deployment's pods that fail to pull their
+// image, crash or were killed for lack of memory, or return "" if there are
+// none
+func (r *ContainerizedWorkloadReconciler) failingContainers(ctx context.Context,
+	deploy *appsv1.Deployment) (string, error) {
+	pods, err := r.deploymentPods(ctx, deploy)
+	if err != nil {
+		return "", err
+	}
+	var failures []string
+	for _, p := range pods {
+		statuses := append(append([]corev1.ContainerStatus{}, p.Status.InitContainerStatuses...),
+			p.Status.ContainerStatuses...)
+		for _, cs := range statuses {
+			var reasons []string
+			if w := cs.State.Waiting; w != nil && failingWaitingReasons[w.Reason] {
+				reasons = append(reasons, w.Reason)
+			}
+			// a container that recovered from being OOMKilled is healthy again
+			if t := cs.LastTerminationState.Terminated; t != nil && t.Reason == reasonOOMKilled && !cs.Ready {
+				reasons = append(reasons, fmt.Sprintf("%s after %d restarts", reasonOOMKilled, cs.RestartCount))
+			}
+			if len(reasons) > 0 {
+				failures = append(failures, fmt.Sprintf("pod %s container %s: %s", p.Name, cs.Name,
+					strings.Join(reasons, ", ")))
+			}
+		}
+	}
+	return strings.Join(failures, "; "), nil
+}
+
+// containerRestarts returns the restarts of the containers of the
+// deployment's pods
+func (r *ContainerizedWorkloadReconciler) containerRestarts(ctx context.Context,
+	deploy *appsv1.Deployment) (int32, error) {
+	pods, err := r.deploymentPods(ctx, deploy)
+	if err != nil {
+		return 0, err
+	}
+	var restarts int32
+	for _, p := range pods {
+		for _, cs := range p.Status.InitContainerStatuses {
+			restarts += cs.RestartCount
+		}
+		for _, cs := range p.Status.ContainerStatuses {
+			restarts += cs.RestartCount
+		}
+	}
+	return restarts, nil
+}
+
+// reasonProgressDeadlineExceeded is the reason of the Progressing condition
+// of a deployment whose rollout is stuck.
+const reasonProgressDeadlineExceeded = "ProgressDeadlineExceeded"
+
+// stuckDeployments describes the deployments whose rollout exceeded its
+// progress deadline, or returns "" if there are none
+func stuckDeployments(deploys []*appsv1.Deployment) string {
+	var stuck []string
+	for _, d := range deploys {
+		for _, c := range d.Status.Conditions {
+			if c.Type == appsv1.DeploymentProgressing && c.Status == corev1.ConditionFalse &&
+				c.Reason == reasonProgressDeadlineExceeded {
+				stuck = append(stuck, fmt.Sprintf("deployment %s: %s", d.Name, c.Message))
+			}
+		}
+	}
+	return strings.Join(stuck, "; ")
+}
+
+// enqueue the workload whose deployment a pod belongs to
+func podWorkload(o handler.MapObject) []reconcile.Request {
+	labels := o.Meta.GetLabels()
+	deployName := labels[OAMResourceNameLabel]
+	if shard, ok := labels[OAMShardLabel]; ok {
+		deployName = strings.TrimSuffix(deployName, "-"+shard)
+	}
+	if labels[OAMResourceTypeLabel] != string(workloadType) || !strings.HasSuffix(deployName, deploymentNameSuffix) {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Namespace: o.Meta.GetNamespace(),
+		Name:      strings.TrimSuffix(deployName, deploymentNameSuffix),
+	}}}
+}
+
+// a service can not expose the same port and protocol twice
+func hasServicePort(ports []corev1.ServicePort, sp corev1.ServicePort) bool {
+	for _, p := range ports {
+		if p.Port == sp.Port && p.Protocol == sp.Protocol {
+			return true
+		}
+	}
+	return false
+}
+
+// delete the deployments and services the workload no longer renders
 func (r *ContainerizedWorkloadReconciler) cleanupResources(ctx context.Context,
-	workload *oamv1alpha2.ContainerizedWorkload, deployUID, serviceUID *types.UID) error {
+	workload *oamv1alpha2.ContainerizedWorkload, keep map[types.UID]bool) error {
 	log := r.Log.WithValues("gc deployment", workload.Name)
 	var deploy appsv1.Deployment
 	var service corev1.Service
 	for _, res := range workload.Status.Resources {
 		uid := *res.UID
+		if keep[uid] {
+			continue
+		}
 		if res.Kind == KindDeployment {
-			if uid != *deployUID {
-				log.Info("Found an orphaned deployment", "deployment UID", *deployUID, "orphaned  UID", uid)
-				dn := client.ObjectKey{Name: res.Name, Namespace: workload.Namespace}
-				if err := r.Get(ctx, dn, &deploy); err != nil {
-					if apierrors.IsNotFound(err) {
-						continue
-					}
-					return err
-				}
-				if err := r.Delete(ctx, &deploy); err != nil {
-					return err
+			log.Info("Found an orphaned deployment", "orphaned UID", uid)
+			dn := client.ObjectKey{Name: res.Name, Namespace: workload.Namespace}
+			if err := r.Get(ctx, dn, &deploy); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
 				}
-				log.Info("Removed an orphaned deployment", "deployment UID", *deployUID, "orphaned UID", uid)
+				return err
 			}
+			if err := r.Delete(ctx, &deploy); err != nil {
+				return err
+			}
+			log.Info("Removed an orphaned deployment", "orphaned UID", uid)
 		} else if res.Kind == KindService {
-			if uid != *serviceUID {
-				log.Info("Found an orphaned service", "orphaned  UID", uid)
-				sn := client.ObjectKey{Name: res.Name, Namespace: workload.Namespace}
-				if err := r.Get(ctx, sn, &service); err != nil {
-					if apierrors.IsNotFound(err) {
-						continue
-					}
-					return err
-				}
-				if err := r.Delete(ctx, &service); err != nil {
-					return err
+			log.Info("Found an orphaned service", "orphaned UID", uid)
+			sn := client.ObjectKey{Name: res.Name, Namespace: workload.Namespace}
+			if err := r.Get(ctx, sn, &service); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
 				}
-				log.Info("Removed an orphaned service", "orphaned UID", uid)
+				return err
+			}
+			if err := r.Delete(ctx, &service); err != nil {
+				return err
 			}
+			log.Info("Removed an orphaned service", "orphaned UID", uid)
 		}
 	}
 	return nil
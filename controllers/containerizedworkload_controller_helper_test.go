@@ -2,20 +2,117 @@ package controllers
 
 import (
 	"context"
+	"fmt"
 	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	toolscache "k8s.io/client-go/tools/cache"
 	"reflect"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"strconv"
 	"testing"
 )
 
+var (
+	testScheme    = runtime.NewScheme()
+	containerized = oamv1alpha2.ContainerizedWorkload{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: oamv1alpha2.GroupVersion.String(),
+			Kind:       "ContainerizedWorkload",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+			UID:       "test-uid",
+		},
+		Spec: oamv1alpha2.ContainerizedWorkloadSpec{
+			Containers: []corev1.Container{{Name: "nginx", Image: "nginx"}},
+		},
+	}
+)
+
+func init() {
+	_ = clientgoscheme.AddToScheme(testScheme)
+	_ = oamv1alpha2.AddToScheme(testScheme)
+}
+
+// renderedDeployment returns the deployment we expect to be rendered for the
+// given workload.
+func renderedDeployment(w *oamv1alpha2.ContainerizedWorkload, revisionHistoryLimit int32) *appsv1.Deployment {
+	isController := true
+	bod := true
+	labels := map[string]string{OAMResourceTypeLabel: string(workloadType), OAMResourceNameLabel: w.Name + "-deployment"}
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+			Kind:       KindDeployment,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      w.Name + "-deployment",
+			Namespace: w.Namespace,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion:         w.APIVersion,
+				Kind:               w.Kind,
+				Name:               w.Name,
+				UID:                w.UID,
+				Controller:         &isController,
+				BlockOwnerDeletion: &bod,
+			}},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Selector:             &metav1.LabelSelector{MatchLabels: labels},
+			RevisionHistoryLimit: &revisionHistoryLimit,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec:       corev1.PodSpec{InitContainers: w.Spec.InitContainers, Containers: w.Spec.Containers},
+			},
+		},
+	}
+}
+
+// renderedService returns the service we expect to be rendered for the
+// given deployment.
+func renderedService(w *oamv1alpha2.ContainerizedWorkload, d *appsv1.Deployment,
+	ports ...corev1.ServicePort) *corev1.Service {
+	isController := true
+	bod := true
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       KindService,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      d.Name + "-service",
+			Namespace: d.Namespace,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion:         w.APIVersion,
+				Kind:               w.Kind,
+				Name:               w.Name,
+				UID:                w.UID,
+				Controller:         &isController,
+				BlockOwnerDeletion: &bod,
+			}},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports:    ports,
+			Selector: map[string]string{OAMResourceNameLabel: d.Name},
+			Type:     corev1.ServiceTypeClusterIP,
+		},
+	}
+}
+
 func TestContainerizedWorkloadReconciler_cleanupResources(t *testing.T) {
 	type args struct {
-		ctx        context.Context
-		workload   *oamv1alpha2.ContainerizedWorkload
-		deployUID  *types.UID
-		serviceUID *types.UID
+		ctx      context.Context
+		workload *oamv1alpha2.ContainerizedWorkload
+		keep     map[types.UID]bool
 	}
 	testCases := map[string]struct {
 		reconciler ContainerizedWorkloadReconciler
@@ -26,8 +123,8 @@ func TestContainerizedWorkloadReconciler_cleanupResources(t *testing.T) {
 	}
 	for name, testCase := range testCases {
 		t.Run(name, func(t *testing.T) {
-			if err := testCase.reconciler.cleanupResources(testCase.args.ctx, testCase.args.workload, testCase.args.deployUID,
-				testCase.args.serviceUID); (err != nil) != testCase.wantErr {
+			if err := testCase.reconciler.cleanupResources(testCase.args.ctx, testCase.args.workload,
+				testCase.args.keep); (err != nil) != testCase.wantErr {
 				t.Errorf("cleanupResources() error = %v, wantErr %v", err, testCase.wantErr)
 			}
 		})
@@ -35,6 +132,12 @@ func TestContainerizedWorkloadReconciler_cleanupResources(t *testing.T) {
 }
 
 func TestContainerizedWorkloadReconciler_renderService(t *testing.T) {
+	multiPortDeployment := renderedDeployment(&containerized, 100)
+	multiPortDeployment.Spec.Template.Spec.Containers = []corev1.Container{
+		{Name: "web", Ports: []corev1.ContainerPort{{ContainerPort: 80}}},
+		{Name: "metrics", Ports: []corev1.ContainerPort{{ContainerPort: 9090, Protocol: corev1.ProtocolTCP}}},
+		{Name: "dns", Ports: []corev1.ContainerPort{{ContainerPort: 53, Protocol: corev1.ProtocolUDP}}},
+	}
 	type args struct {
 		ctx      context.Context
 		deploy   *appsv1.Deployment
@@ -46,7 +149,23 @@ func TestContainerizedWorkloadReconciler_renderService(t *testing.T) {
 		want       *corev1.Service
 		wantErr    bool
 	}{
-		// TODO: Add test cases.
+		"DefaultPort": {
+			reconciler: ContainerizedWorkloadReconciler{Scheme: testScheme},
+			args:       args{deploy: renderedDeployment(&containerized, 100), workload: &containerized},
+			want: renderedService(&containerized, renderedDeployment(&containerized, 100), corev1.ServicePort{
+				Name: "tcp", Port: 8080, Protocol: corev1.ProtocolTCP, TargetPort: intstr.FromInt(8080),
+			}),
+		},
+		"MultipleContainers": {
+			reconciler: ContainerizedWorkloadReconciler{Scheme: testScheme},
+			args:       args{deploy: multiPortDeployment, workload: &containerized},
+			want: renderedService(&containerized, multiPortDeployment,
+				corev1.ServicePort{Name: "tcp", Port: 8080, Protocol: corev1.ProtocolTCP, TargetPort: intstr.FromInt(80)},
+				corev1.ServicePort{Name: "tcp-9090", Port: 9090, Protocol: corev1.ProtocolTCP,
+					TargetPort: intstr.FromInt(9090)},
+				corev1.ServicePort{Name: "udp-53", Port: 53, Protocol: corev1.ProtocolUDP, TargetPort: intstr.FromInt(53)},
+			),
+		},
 	}
 	for name, testCase := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -63,6 +182,43 @@ func TestContainerizedWorkloadReconciler_renderService(t *testing.T) {
 }
 
 func TestContainerizedWorkloadReconciler_renderWorkload(t *testing.T) {
+	withInitContainers := containerized.DeepCopy()
+	withInitContainers.Spec.InitContainers = []corev1.Container{{Name: "migrate", Image: "migrate"}}
+	onLinuxI386 := containerized.DeepCopy()
+	linux, i386 := oamv1alpha2.OperatingSystemLinux, oamv1alpha2.CPUArchitectureI386
+	onLinuxI386.Spec.OperatingSystem, onLinuxI386.Spec.CPUArchitecture = &linux, &i386
+	onLinuxI386Deployment := renderedDeployment(onLinuxI386, 100)
+	onLinuxI386Deployment.Spec.Template.Spec.Affinity = &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{{MatchExpressions: []corev1.NodeSelectorRequirement{
+				{Key: "kubernetes.io/os", Operator: corev1.NodeSelectorOpIn, Values: []string{"linux"}},
+				{Key: "kubernetes.io/arch", Operator: corev1.NodeSelectorOpIn, Values: []string{"386"}},
+			}}},
+		},
+	}}
+	onWindows := containerized.DeepCopy()
+	windows, user := oamv1alpha2.OperatingSystemWindows, "ContainerUser"
+	onWindows.Spec.OperatingSystem = &windows
+	onWindows.Spec.WindowsOptions = &corev1.WindowsSecurityContextOptions{RunAsUserName: &user}
+	onWindowsDeployment := renderedDeployment(onWindows, 100)
+	onWindowsDeployment.Spec.Template.Spec.Affinity = &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{{MatchExpressions: []corev1.NodeSelectorRequirement{
+				{Key: "kubernetes.io/os", Operator: corev1.NodeSelectorOpIn, Values: []string{"windows"}},
+			}}},
+		},
+	}}
+	onWindowsDeployment.Spec.Template.Spec.Tolerations = []corev1.Toleration{
+		{Key: "os", Operator: corev1.TolerationOpEqual, Value: "windows", Effect: corev1.TaintEffectNoSchedule},
+	}
+	onWindowsDeployment.Spec.Template.Spec.SecurityContext = &corev1.PodSecurityContext{
+		WindowsOptions: &corev1.WindowsSecurityContextOptions{RunAsUserName: &user},
+	}
+	withGPU := containerized.DeepCopy()
+	withGPU.Spec.Containers[0].Resources.Limits = corev1.ResourceList{
+		"nvidia.com/gpu":                       resource.MustParse("1"),
+		corev1.ResourceHugePagesPrefix + "2Mi": resource.MustParse("64Mi"),
+	}
 	type args struct {
 		ctx      context.Context
 		workload *oamv1alpha2.ContainerizedWorkload
@@ -73,7 +229,41 @@ func TestContainerizedWorkloadReconciler_renderWorkload(t *testing.T) {
 		want       *appsv1.Deployment
 		wantErr    bool
 	}{
-		// TODO: Add test cases.
+		"DefaultRevisionHistoryLimit": {
+			reconciler: ContainerizedWorkloadReconciler{Scheme: testScheme},
+			args:       args{ctx: context.Background(), workload: containerized.DeepCopy()},
+			want:       renderedDeployment(&containerized, defaultRevisionHistoryLimit),
+		},
+		"CustomRevisionHistoryLimit": {
+			reconciler: ContainerizedWorkloadReconciler{Scheme: testScheme},
+			args: args{ctx: context.Background(), workload: func() *oamv1alpha2.ContainerizedWorkload {
+				w := containerized.DeepCopy()
+				limit := int32(3)
+				w.Spec.RevisionHistoryLimit = &limit
+				return w
+			}()},
+			want: renderedDeployment(&containerized, 3),
+		},
+		"ExtendedResources": {
+			reconciler: ContainerizedWorkloadReconciler{Scheme: testScheme},
+			args:       args{ctx: context.Background(), workload: withGPU},
+			want:       renderedDeployment(withGPU, 100),
+		},
+		"Platform": {
+			reconciler: ContainerizedWorkloadReconciler{Scheme: testScheme},
+			args:       args{ctx: context.Background(), workload: onLinuxI386},
+			want:       onLinuxI386Deployment,
+		},
+		"Windows": {
+			reconciler: ContainerizedWorkloadReconciler{Scheme: testScheme},
+			args:       args{ctx: context.Background(), workload: onWindows},
+			want:       onWindowsDeployment,
+		},
+		"InitContainers": {
+			reconciler: ContainerizedWorkloadReconciler{Scheme: testScheme},
+			args:       args{ctx: context.Background(), workload: withInitContainers},
+			want:       renderedDeployment(withInitContainers, 100),
+		},
 	}
 	for name, testCase := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -88,3 +278,214 @@ func TestContainerizedWorkloadReconciler_renderWorkload(t *testing.T) {
 		})
 	}
 }
+
+func TestContainerizedWorkloadReconciler_unschedulablePod(t *testing.T) {
+	pod := func(name, deploy string, conditions ...corev1.PodCondition) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default",
+				Labels: map[string]string{OAMResourceNameLabel: deploy}},
+			Status: corev1.PodStatus{Conditions: conditions},
+		}
+	}
+	unschedulable := corev1.PodCondition{
+		Type:    corev1.PodScheduled,
+		Status:  corev1.ConditionFalse,
+		Reason:  corev1.PodReasonUnschedulable,
+		Message: "0/3 nodes are available: 3 Insufficient nvidia.com/gpu.",
+	}
+	scheduled := corev1.PodCondition{Type: corev1.PodScheduled, Status: corev1.ConditionTrue}
+	deploy := renderedDeployment(&containerized, 100)
+
+	testCases := map[string]struct {
+		pods []runtime.Object
+		want string
+	}{
+		"AllScheduled": {
+			pods: []runtime.Object{pod("a", deploy.Name, scheduled)},
+		},
+		"Unschedulable": {
+			pods: []runtime.Object{pod("a", deploy.Name, scheduled), pod("b", deploy.Name, unschedulable)},
+			want: "pod b: 0/3 nodes are available: 3 Insufficient nvidia.com/gpu.",
+		},
+		"OtherDeployment": {
+			pods: []runtime.Object{pod("a", "other-deployment", unschedulable)},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := ContainerizedWorkloadReconciler{Client: fake.NewFakeClientWithScheme(testScheme, testCase.pods...)}
+			got, err := r.unschedulablePod(context.Background(), deploy)
+			if err != nil {
+				t.Fatalf("unschedulablePod() error = %v", err)
+			}
+			if got != testCase.want {
+				t.Errorf("unschedulablePod() = %q, want %q", got, testCase.want)
+			}
+
+			// the same pods read from the workload pod informer
+			r.pods = toolscache.NewIndexer(toolscache.MetaNamespaceKeyFunc,
+				toolscache.Indexers{toolscache.NamespaceIndex: toolscache.MetaNamespaceIndexFunc})
+			for _, p := range testCase.pods {
+				if err := r.pods.Add(p); err != nil {
+					t.Fatal(err)
+				}
+			}
+			r.Client = fake.NewFakeClientWithScheme(testScheme)
+			got, err = r.unschedulablePod(context.Background(), deploy)
+			if err != nil {
+				t.Fatalf("unschedulablePod() error = %v", err)
+			}
+			if got != testCase.want {
+				t.Errorf("unschedulablePod() from the informer = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestContainerizedWorkloadReconciler_failingContainers(t *testing.T) {
+	deploy := renderedDeployment(&containerized, 100)
+	pod := func(name string, statuses ...corev1.ContainerStatus) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default",
+				Labels: map[string]string{OAMResourceNameLabel: deploy.Name}},
+			Status: corev1.PodStatus{ContainerStatuses: statuses},
+		}
+	}
+	waiting := func(reason string) corev1.ContainerStatus {
+		return corev1.ContainerStatus{Name: "app", State: corev1.ContainerState{
+			Waiting: &corev1.ContainerStateWaiting{Reason: reason}}}
+	}
+	oomKilled := func(ready bool) corev1.ContainerStatus {
+		return corev1.ContainerStatus{Name: "app", Ready: ready, RestartCount: 4,
+			LastTerminationState: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{Reason: reasonOOMKilled}}}
+	}
+
+	testCases := map[string]struct {
+		pods []runtime.Object
+		want string
+	}{
+		"Running": {
+			pods: []runtime.Object{pod("a", corev1.ContainerStatus{Name: "app", Ready: true})},
+		},
+		"ContainerCreating": {
+			pods: []runtime.Object{pod("a", waiting("ContainerCreating"))},
+		},
+		"ImagePullBackOff": {
+			pods: []runtime.Object{pod("a", waiting("ImagePullBackOff"))},
+			want: "pod a container app: ImagePullBackOff",
+		},
+		"CrashLoopAfterOOM": {
+			pods: []runtime.Object{pod("a", func() corev1.ContainerStatus {
+				cs := oomKilled(false)
+				cs.State = waiting("CrashLoopBackOff").State
+				return cs
+			}()), pod("b", oomKilled(false))},
+			want: "pod a container app: CrashLoopBackOff, OOMKilled after 4 restarts; " +
+				"pod b container app: OOMKilled after 4 restarts",
+		},
+		"RecoveredFromOOM": {
+			pods: []runtime.Object{pod("a", oomKilled(true))},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := ContainerizedWorkloadReconciler{Client: fake.NewFakeClientWithScheme(testScheme, testCase.pods...)}
+			got, err := r.failingContainers(context.Background(), deploy)
+			if err != nil {
+				t.Fatalf("failingContainers() error = %v", err)
+			}
+			if got != testCase.want {
+				t.Errorf("failingContainers() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestContainerizedWorkloadReconciler_containerRestarts(t *testing.T) {
+	deploy := renderedDeployment(&containerized, 100)
+	pod := func(name, deployName string, restarts int32) runtime.Object {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default",
+				Labels: map[string]string{OAMResourceNameLabel: deployName}},
+			Status: corev1.PodStatus{
+				InitContainerStatuses: []corev1.ContainerStatus{{Name: "init", RestartCount: 1}},
+				ContainerStatuses:     []corev1.ContainerStatus{{Name: "app", RestartCount: restarts}},
+			},
+		}
+	}
+	r := ContainerizedWorkloadReconciler{Client: fake.NewFakeClientWithScheme(testScheme,
+		pod("a", deploy.Name, 2), pod("b", deploy.Name, 3), pod("c", "other", 10))}
+	got, err := r.containerRestarts(context.Background(), deploy)
+	if err != nil {
+		t.Fatalf("containerRestarts() error = %v", err)
+	}
+	if got != 7 {
+		t.Errorf("containerRestarts() = %d, want 7", got)
+	}
+}
+
+func TestRenderShards(t *testing.T) {
+	workload := containerized.DeepCopy()
+	workload.Spec.Sharding = &oamv1alpha2.Sharding{Shards: 2, ShardKey: "PARTITION"}
+	deploy := renderedDeployment(workload, 100)
+
+	shards := renderShards(workload, deploy)
+	if len(shards) != 2 {
+		t.Fatalf("renderShards() = %d deployments, want 2", len(shards))
+	}
+	for i, shard := range shards {
+		wantName := fmt.Sprintf("%s-%d", deploy.Name, i)
+		if shard.Name != wantName || shard.Spec.Selector.MatchLabels[OAMResourceNameLabel] != wantName {
+			t.Errorf("shard %d is named %s, selects %v, want %s", i, shard.Name, shard.Spec.Selector.MatchLabels, wantName)
+		}
+		wantEnv := []corev1.EnvVar{
+			{Name: "PARTITION", Value: strconv.Itoa(i)},
+			{Name: "PARTITION_COUNT", Value: "2"},
+		}
+		if got := shard.Spec.Template.Spec.Containers[0].Env; !reflect.DeepEqual(got, wantEnv) {
+			t.Errorf("shard %d env = %v, want %v", i, got, wantEnv)
+		}
+
+		// the pods of a shard enqueue the workload
+		got := podWorkload(handler.MapObject{Meta: &metav1.ObjectMeta{Labels: shard.Spec.Template.Labels}})
+		if len(got) != 1 || got[0].Name != workload.Name {
+			t.Errorf("podWorkload() = %v for shard %d, want %s", got, i, workload.Name)
+		}
+	}
+	if len(deploy.Spec.Template.Spec.Containers[0].Env) != 0 {
+		t.Errorf("renderShards() changed the deployment")
+	}
+}
+
+func TestStuckDeployments(t *testing.T) {
+	progressing := func(status corev1.ConditionStatus, reason string) *appsv1.Deployment {
+		d := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web-deployment"}}
+		d.Status.Conditions = []appsv1.DeploymentCondition{{
+			Type:    appsv1.DeploymentProgressing,
+			Status:  status,
+			Reason:  reason,
+			Message: `ReplicaSet "web-deployment-5d4f" has timed out progressing.`,
+		}}
+		return d
+	}
+	testCases := map[string]struct {
+		deploy *appsv1.Deployment
+		want   string
+	}{
+		"Progressing": {
+			deploy: progressing(corev1.ConditionTrue, "ReplicaSetUpdated"),
+		},
+		"Stuck": {
+			deploy: progressing(corev1.ConditionFalse, "ProgressDeadlineExceeded"),
+			want:   `deployment web-deployment: ReplicaSet "web-deployment-5d4f" has timed out progressing.`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := stuckDeployments([]*appsv1.Deployment{testCase.deploy}); got != testCase.want {
+				t.Errorf("stuckDeployments() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
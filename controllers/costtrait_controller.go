@@ -0,0 +1,332 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/debug"
+)
+
+// costFinalizer holds a cost trait until its labels have been removed from
+// the children of its workload.
+const costFinalizer = "cost.core.oam.dev/finalizer"
+
+// Reconcile error strings.
+const (
+	errLabelChild   = "cannot label the child of the workload"
+	errUnlabelChild = "cannot remove the labels from the child of the workload"
+	errListCosts    = "cannot list the cost traits of the workload"
+)
+
+// CostTraitReconciler reconciles a CostTrait object
+type CostTraitReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// Limiter, if set, rate limits the writes of child resources per namespace.
+	Limiter *NamespaceWriteLimiter
+	// Debug, if set, records the outcome of each reconcile.
+	Debug *debug.Recorder
+	// Workloads, if set, resolves the workload the trait refers to, see
+	// WorkloadResolver.
+	Workloads *WorkloadResolver
+}
+
+// +kubebuilder:rbac:groups=core.oam.dev,resources=costtraits,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=core.oam.dev,resources=costtraits/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;update;patch
+
+func (r *CostTraitReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("cost trait", req.NamespacedName)
+	log.Info("Reconcile cost trait")
+
+	var trait oamv1alpha2.CostTrait
+	if err := r.Get(ctx, req.NamespacedName, &trait); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	deleted, err := finalize(ctx, r, &trait, costFinalizer, func() error {
+		return r.removeLabels(ctx, log, &trait)
+	})
+	if err != nil {
+		log.Error(err, "Failed to remove the labels set by the trait")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, err
+	}
+	if deleted {
+		return ctrl.Result{}, nil
+	}
+
+	_, resources, err := r.Workloads.fetchWorkloadResources(ctx, r, log, req.Namespace, trait.Spec.WorkloadReference)
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+
+	if result, ok, err := gateReconcile(ctx, r, log, r.Limiter, kindCostTrait, &trait, &trait.Status.ConditionedStatus,
+		&trait.Spec.WorkloadReference); !ok {
+		return result, err
+	}
+
+	var deploy *appsv1.Deployment
+	for _, res := range resources {
+		if res.APIVersion == appsv1.SchemeGroupVersion.String() && res.Kind == KindDeployment {
+			deploy, err = r.labelDeployment(ctx, &trait, res.Name)
+		} else {
+			err = r.labelChild(ctx, &trait, res)
+		}
+		if err != nil {
+			trait.Status.SetConditions(reconcileError(err)...)
+			log.Error(err, "Failed to label a child of the workload", "kind", res.Kind, "name", res.Name)
+			return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+				errUpdateStatus)
+		}
+	}
+	log.Info("Successfully labelled the children of the workload", "total resources", len(resources))
+
+	trait.Status.Requests = nil
+	trait.Status.SetConditions(oamv1alpha2.WithinBudget())
+	if deploy != nil {
+		trait.Status.Requests = workloadRequests(&deploy.Spec.Template.Spec, deploymentReplicas(deploy))
+		if msg := exceedsBudget(trait.Status.Requests, trait.Spec.MaxRequests); msg != "" {
+			log.Info("The workload exceeds its budget", "reason", msg)
+			trait.Status.SetConditions(oamv1alpha2.BudgetExceeded(msg))
+		}
+	}
+
+	r.Limiter.MarkForceSynced(&trait)
+	trait.Status.SetConditions(oamv1alpha2.PermissionGranted(), cpv1alpha1.ReconcileSuccess())
+	return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &trait), errUpdateStatus)
+}
+
+// labelDeployment labels the deployment and its pods, refetching it if it
+// changed under us, and returns the labelled deployment.
+func (r *CostTraitReconciler) labelDeployment(ctx context.Context, trait *oamv1alpha2.CostTrait,
+	name string) (*appsv1.Deployment, error) {
+	deploy := &appsv1.Deployment{}
+	key := client.ObjectKey{Namespace: trait.Namespace, Name: name}
+	if err := r.Get(ctx, key, deploy); err != nil {
+		return nil, errors.Wrapf(err, "%s %s %s", errLabelChild, KindDeployment, name)
+	}
+	labelled := deploy
+	err := retryTransient(applyBackoff, func() error {
+		return r.Get(ctx, key, deploy)
+	}, func() error {
+		labelled = labelledDeployment(trait, deploy)
+		if err := recordManagedFields(deploy, labelled, managedFieldsKey(kindCostTrait, trait.Name)); err != nil {
+			return err
+		}
+		return r.Patch(ctx, labelled, client.MergeFrom(deploy))
+	})
+	return labelled, errors.Wrapf(err, "%s %s %s", errLabelChild, KindDeployment, name)
+}
+
+// labelledDeployment returns a copy of the deployment carrying the trait's
+// labels, as do its pods
+func labelledDeployment(trait *oamv1alpha2.CostTrait, deploy *appsv1.Deployment) *appsv1.Deployment {
+	ld := deploy.DeepCopy()
+	ld.SetLabels(withLabels(ld.GetLabels(), trait.Spec.Labels))
+	ld.Spec.Template.SetLabels(withLabels(ld.Spec.Template.GetLabels(), trait.Spec.Labels))
+	// always set the owner reference so that we can watch this deployment
+	setTraitOwnerReference(ld, trait.APIVersion, trait.Kind, trait)
+	return ld
+}
+
+// labelChild labels a child of the workload other than its deployment.
+func (r *CostTraitReconciler) labelChild(ctx context.Context, trait *oamv1alpha2.CostTrait,
+	res oamv1alpha2.ResourceReference) error {
+	child := &unstructured.Unstructured{}
+	child.SetAPIVersion(res.APIVersion)
+	child.SetKind(res.Kind)
+	if err := r.Get(ctx, client.ObjectKey{Namespace: trait.Namespace, Name: res.Name}, child); err != nil {
+		return errors.Wrapf(err, "%s %s %s", errLabelChild, res.Kind, res.Name)
+	}
+	labelled := child.DeepCopy()
+	labelled.SetLabels(withLabels(child.GetLabels(), trait.Spec.Labels))
+	if equalLabels(labelled.GetLabels(), child.GetLabels()) {
+		return nil
+	}
+	return errors.Wrapf(r.Patch(ctx, labelled, client.MergeFrom(child)), "%s %s %s", errLabelChild, res.Kind, res.Name)
+}
+
+// removeLabels removes the trait's labels from the children of its workload,
+// leaving labels changed since alone, and reverts the labels of the pods.
+func (r *CostTraitReconciler) removeLabels(ctx context.Context, log logr.Logger, trait *oamv1alpha2.CostTrait) error {
+	_, resources, err := r.Workloads.fetchWorkloadResources(ctx, r, log, trait.Namespace, trait.Spec.WorkloadReference)
+	if err != nil {
+		if apierrors.IsForbidden(errors.Cause(err)) {
+			return err
+		}
+		// the workload is gone along with its children
+		log.Info("Cannot find the children to remove the labels from", "reason", err.Error())
+		return nil
+	}
+	for _, res := range resources {
+		child := &unstructured.Unstructured{}
+		child.SetAPIVersion(res.APIVersion)
+		child.SetKind(res.Kind)
+		if err := r.Get(ctx, client.ObjectKey{Namespace: trait.Namespace, Name: res.Name}, child); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return errors.Wrapf(err, "%s %s %s", errUnlabelChild, res.Kind, res.Name)
+		}
+		unlabelled := child.DeepCopy()
+		unlabelled.SetLabels(withoutLabels(child.GetLabels(), trait.Spec.Labels))
+		if !equalLabels(unlabelled.GetLabels(), child.GetLabels()) {
+			if err := r.Patch(ctx, unlabelled, client.MergeFrom(child)); err != nil {
+				return errors.Wrapf(err, "%s %s %s", errUnlabelChild, res.Kind, res.Name)
+			}
+		}
+		if res.APIVersion != appsv1.SchemeGroupVersion.String() || res.Kind != KindDeployment {
+			continue
+		}
+		deploy := &appsv1.Deployment{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: trait.Namespace, Name: res.Name}, deploy); err != nil {
+			return errors.Wrapf(err, "%s %s %s", errUnlabelChild, res.Kind, res.Name)
+		}
+		reverted, err := revertManagedFields(deploy, managedFieldsKey(kindCostTrait, trait.Name))
+		if err != nil {
+			return err
+		}
+		if err := r.Patch(ctx, reverted, client.MergeFrom(deploy)); err != nil {
+			return errors.Wrap(err, errRevertManagedFields)
+		}
+	}
+	return nil
+}
+
+// withLabels returns a copy of labels with the extra labels set
+func withLabels(labels, extra map[string]string) map[string]string {
+	l := make(map[string]string, len(labels)+len(extra))
+	for k, v := range labels {
+		l[k] = v
+	}
+	for k, v := range extra {
+		l[k] = v
+	}
+	return l
+}
+
+// withoutLabels returns a copy of labels without those still set to the
+// value of the removed labels
+func withoutLabels(labels, removed map[string]string) map[string]string {
+	l := make(map[string]string, len(labels))
+	for k, v := range labels {
+		if rv, ok := removed[k]; !ok || rv != v {
+			l[k] = v
+		}
+	}
+	return l
+}
+
+func equalLabels(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
+
+// workloadRequests returns the total requests of the replicas of the pod,
+// the requests of a pod being those of its containers as init containers
+// run before them.
+func workloadRequests(spec *corev1.PodSpec, replicas int32) corev1.ResourceList {
+	requests := corev1.ResourceList{}
+	for _, c := range spec.Containers {
+		for name, q := range c.Resources.Requests {
+			total := requests[name]
+			total.Add(q)
+			requests[name] = total
+		}
+	}
+	for name, q := range requests {
+		requests[name] = *resource.NewMilliQuantity(q.MilliValue()*int64(replicas), q.Format)
+	}
+	return requests
+}
+
+// exceedsBudget returns a message describing the requests exceeding the
+// budget, if any.
+func exceedsBudget(requests, budget corev1.ResourceList) string {
+	var exceeded []string
+	for name, max := range budget {
+		q, ok := requests[name]
+		if ok && q.Cmp(max) > 0 {
+			exceeded = append(exceeded, fmt.Sprintf("%s requests %s exceed %s", name, q.String(), max.String()))
+		}
+	}
+	sort.Strings(exceeded)
+	return strings.Join(exceeded, ", ")
+}
+
+// budgetExceeded returns a message describing how the replicas of the
+// deployment would exceed the budget of a cost trait of the workload, if
+// they would.
+func budgetExceeded(ctx context.Context, c client.Reader, namespace string, ref oamv1alpha2.ResourceReference,
+	deploy *appsv1.Deployment, replicas int32) (string, error) {
+	var costs oamv1alpha2.CostTraitList
+	if err := c.List(ctx, &costs, client.InNamespace(namespace)); err != nil {
+		return "", errors.Wrap(err, errListCosts)
+	}
+	requests := workloadRequests(&deploy.Spec.Template.Spec, replicas)
+	for _, cost := range costs.Items {
+		if cost.DeletionTimestamp != nil || cost.Spec.WorkloadReference.Name != ref.Name {
+			continue
+		}
+		if msg := exceedsBudget(requests, cost.Spec.MaxRequests); msg != "" {
+			return fmt.Sprintf("%d replicas exceed the budget of CostTrait %s: %s", replicas, cost.Name, msg), nil
+		}
+	}
+	return "", nil
+}
+
+func (r *CostTraitReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&oamv1alpha2.CostTrait{}).
+		Watches(&source.Kind{
+			Type: &appsv1.Deployment{},
+		}, &handler.EnqueueRequestForOwner{
+			OwnerType:    &oamv1alpha2.CostTrait{},
+			IsController: false, // other traits may own the deployment too
+		}).
+		Complete(r.Debug.Wrap("CostTrait", r))
+}
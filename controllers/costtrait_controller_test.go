@@ -0,0 +1,149 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/simtest"
+)
+
+// a workload whose deployment runs two pods requesting 500m of cpu each
+func costWorkload() (*oamv1alpha2.ContainerizedWorkload, *appsv1.Deployment, *corev1.Service) {
+	two := int32(2)
+	workload := &oamv1alpha2.ContainerizedWorkload{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-deployment", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &two,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}},
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{
+					Name: "web",
+					Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("500m"),
+					}},
+				}}},
+			},
+		},
+	}
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "web-service", Namespace: "default"}}
+	return workload, deploy, svc
+}
+
+func TestCostTraitReconcile(t *testing.T) {
+	cases := map[string]struct {
+		maxCPU string
+		want   corev1.ConditionStatus
+	}{
+		"WithinBudget":   {maxCPU: "1", want: corev1.ConditionFalse},
+		"BudgetExceeded": {maxCPU: "800m", want: corev1.ConditionTrue},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			workload, deploy, svc := costWorkload()
+			trait := &oamv1alpha2.CostTrait{
+				ObjectMeta: metav1.ObjectMeta{Name: "cost", Namespace: "default"},
+				Spec: oamv1alpha2.CostTraitSpec{
+					Labels:      map[string]string{"cost-center": "cc-1234"},
+					MaxRequests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(tc.maxCPU)},
+				},
+			}
+			h, err := simtest.New(workload, []runtime.Object{deploy, svc})
+			if err != nil {
+				t.Fatal(err)
+			}
+			trait.Spec.WorkloadReference = h.WorkloadReference()
+			if err := h.Create(context.Background(), trait); err != nil {
+				t.Fatal(err)
+			}
+
+			r := &CostTraitReconciler{Client: h, Log: ctrl.Log, Scheme: h.Scheme}
+			if _, err := h.Reconcile(r, trait); err != nil {
+				t.Fatalf("Reconcile() = %v", err)
+			}
+			h.AssertPatched(t, deploy, "metadata.labels.cost-center", "cc-1234")
+			h.AssertPatched(t, deploy, "spec.template.metadata.labels.cost-center", "cc-1234")
+			h.AssertPatched(t, svc, "metadata.labels.cost-center", "cc-1234")
+
+			var got oamv1alpha2.CostTrait
+			if err := h.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "cost"}, &got); err != nil {
+				t.Fatal(err)
+			}
+			if q := got.Status.Requests[corev1.ResourceCPU]; q.Cmp(resource.MustParse("1")) != 0 {
+				t.Errorf("Reconcile() cpu requests = %s, want 1", q.String())
+			}
+			if c := got.Status.GetCondition(oamv1alpha2.TypeBudgetExceeded); c.Status != tc.want {
+				t.Errorf("Reconcile() %s condition = %+v, want %s", oamv1alpha2.TypeBudgetExceeded, c, tc.want)
+			}
+		})
+	}
+}
+
+func TestManualScalerTraitBudget(t *testing.T) {
+	cases := map[string]struct {
+		replicas int32
+		exceeded bool
+	}{
+		"WithinBudget":   {replicas: 4},
+		"BudgetExceeded": {replicas: 5, exceeded: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			workload, deploy, _ := costWorkload()
+			cost := &oamv1alpha2.CostTrait{
+				ObjectMeta: metav1.ObjectMeta{Name: "cost", Namespace: "default"},
+				Spec: oamv1alpha2.CostTraitSpec{
+					Labels:      map[string]string{"cost-center": "cc-1234"},
+					MaxRequests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+				},
+			}
+			trait := &oamv1alpha2.ManualScalerTrait{
+				ObjectMeta: metav1.ObjectMeta{Name: "scaler", Namespace: "default"},
+				Spec:       oamv1alpha2.ManualScalerTraitSpec{ReplicaCount: tc.replicas},
+			}
+			h, err := simtest.New(workload, []runtime.Object{deploy})
+			if err != nil {
+				t.Fatal(err)
+			}
+			cost.Spec.WorkloadReference = h.WorkloadReference()
+			trait.Spec.WorkloadReference = h.WorkloadReference()
+			for _, o := range []runtime.Object{cost, trait} {
+				if err := h.Create(context.Background(), o); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			r := &ManualScalerTraitReconciler{Client: h, Log: ctrl.Log, Scheme: h.Scheme}
+			if _, err := h.Reconcile(r, trait); err != nil {
+				t.Fatalf("Reconcile() = %v", err)
+			}
+			if tc.exceeded {
+				h.AssertNotPatched(t, deploy)
+			} else {
+				h.AssertPatched(t, deploy, "spec.replicas", tc.replicas)
+			}
+
+			var got oamv1alpha2.ManualScalerTrait
+			if err := h.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "scaler"}, &got); err != nil {
+				t.Fatal(err)
+			}
+			want := corev1.ConditionFalse
+			if tc.exceeded {
+				want = corev1.ConditionTrue
+			}
+			if c := got.Status.GetCondition(oamv1alpha2.TypeBudgetExceeded); c.Status != want {
+				t.Errorf("Reconcile() %s condition = %+v, want %s", oamv1alpha2.TypeBudgetExceeded, c, want)
+			}
+		})
+	}
+}
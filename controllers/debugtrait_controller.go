@@ -0,0 +1,268 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/debug"
+)
+
+// Reconcile error strings.
+const (
+	errNoPodToCopy    = "the deployment has no pod to copy"
+	errGetDebugPod    = "cannot get the debug pod"
+	errCreateDebugPod = "cannot create the debug pod"
+	errDeleteDebugPod = "cannot delete the expired debug pod"
+)
+
+// defaultDebugTTL of the debug pods of DebugTraits.
+const defaultDebugTTL = time.Hour
+
+// debugTraitLabel names the DebugTrait of a debug pod. Debug pods carry none
+// of the labels of the pod they copy, so that neither services nor the
+// deployment's replica sets select them.
+const debugTraitLabel = "debug.core.oam.dev/trait"
+
+// DebugTraitReconciler reconciles a DebugTrait object
+type DebugTraitReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// Limiter, if set, rate limits the writes of child resources per namespace.
+	Limiter *NamespaceWriteLimiter
+	// Debug, if set, records the outcome of each reconcile.
+	Debug *debug.Recorder
+	// Workloads, if set, resolves the workload the trait refers to, see
+	// WorkloadResolver.
+	Workloads *WorkloadResolver
+}
+
+// +kubebuilder:rbac:groups=core.oam.dev,resources=debugtraits,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=core.oam.dev,resources=debugtraits/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.oam.dev,resources=resourcetrackers,verbs=get;list;watch;create;update;delete
+
+func (r *DebugTraitReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("debug trait", req.NamespacedName)
+	log.Info("Reconcile debug trait")
+
+	var trait oamv1alpha2.DebugTrait
+	if err := r.Get(ctx, req.NamespacedName, &trait); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	deleted, err := finalizeTrackedResources(ctx, r, &trait)
+	if err != nil {
+		log.Error(err, "Failed to finalize the tracked resources")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, err
+	}
+	if deleted {
+		return ctrl.Result{}, nil
+	}
+
+	now := time.Now()
+	if exp := trait.Status.ExpiresAt; exp != nil && !now.Before(exp.Time) {
+		if err := r.deleteDebugPod(ctx, &trait); err != nil {
+			trait.Status.SetConditions(reconcileError(err)...)
+			log.Error(err, "Failed to delete an expired debug pod")
+			return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+				errUpdateStatus)
+		}
+		trait.Status.PodName = ""
+		trait.Status.SetConditions(oamv1alpha2.PermissionGranted(),
+			cpv1alpha1.ReconcileSuccess().WithMessage("the debug pod expired"))
+		return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &trait), errUpdateStatus)
+	}
+
+	existing := &corev1.Pod{}
+	err = r.Get(ctx, client.ObjectKey{Namespace: trait.Namespace, Name: debugPodName(&trait)}, existing)
+	if err == nil && metav1.IsControlledBy(existing, &trait) {
+		trait.Status.SetConditions(oamv1alpha2.PermissionGranted(), cpv1alpha1.ReconcileSuccess())
+		return ctrl.Result{RequeueAfter: debugPodExpiry(&trait, now)}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	if err != nil && !apierrors.IsNotFound(err) {
+		trait.Status.SetConditions(reconcileError(errors.Wrap(err, errGetDebugPod))...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+
+	deploy, err := r.Workloads.fetchWorkloadDeployment(ctx, r, log, req.Namespace, trait.Spec.WorkloadReference)
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+
+	// debug pods leave the workload alone, they neither wait for maintenance
+	// windows nor for other traits
+	if !r.Limiter.TryAcceptObject(&trait) {
+		log.Info("Throttling writes in namespace", "namespace", req.Namespace)
+		return ctrl.Result{RequeueAfter: throttledWait}, nil
+	}
+
+	source, err := r.podToCopy(ctx, deploy)
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		log.Info("Cannot find a pod to copy", "reason", err.Error())
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	if trait.Status.ExpiresAt == nil {
+		exp := metav1.NewTime(now.Add(debugTTL(&trait)))
+		trait.Status.ExpiresAt = &exp
+	}
+	pod := debugPod(&trait, source, trait.Status.ExpiresAt.Sub(now))
+	if err := ctrl.SetControllerReference(&trait, pod, r.Scheme); err != nil {
+		trait.Status.SetConditions(reconcileError(errors.Wrap(err, errCreateDebugPod))...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	if err := r.Create(ctx, pod); err != nil {
+		trait.Status.SetConditions(reconcileError(errors.Wrap(err, errCreateDebugPod))...)
+		log.Error(err, "Failed to create a debug pod")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	if err := trackResources(ctx, r, r.Scheme, &trait, pod); err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Failed to track resources")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	log.Info("Successfully created a debug pod", "pod", pod.Name, "copy of", source.Name,
+		"expires at", trait.Status.ExpiresAt.Time)
+
+	trait.Status.PodName = pod.Name
+	trait.Status.CopiedPod = source.Name
+	r.Limiter.MarkForceSynced(&trait)
+	trait.Status.SetConditions(oamv1alpha2.PermissionGranted(), cpv1alpha1.ReconcileSuccess())
+	return ctrl.Result{RequeueAfter: debugPodExpiry(&trait, now)}, errors.Wrap(r.Status().Update(ctx, &trait),
+		errUpdateStatus)
+}
+
+// podToCopy returns a pod of the deployment to copy, its first running pod by
+// name if it has one.
+func (r *DebugTraitReconciler) podToCopy(ctx context.Context, deploy *appsv1.Deployment) (*corev1.Pod, error) {
+	sel, err := metav1.LabelSelectorAsSelector(deploy.Spec.Selector)
+	if err != nil {
+		return nil, errors.Wrap(err, errListPods)
+	}
+	var pods corev1.PodList
+	if err := r.List(ctx, &pods, client.InNamespace(deploy.Namespace),
+		client.MatchingLabelsSelector{Selector: sel}); err != nil {
+		return nil, errors.Wrap(err, errListPods)
+	}
+	if len(pods.Items) == 0 {
+		return nil, errors.New(errNoPodToCopy)
+	}
+	sort.Slice(pods.Items, func(i, j int) bool {
+		a, b := &pods.Items[i], &pods.Items[j]
+		if ar, br := a.Status.Phase == corev1.PodRunning, b.Status.Phase == corev1.PodRunning; ar != br {
+			return ar
+		}
+		return a.Name < b.Name
+	})
+	return &pods.Items[0], nil
+}
+
+// deleteDebugPod deletes the debug pod of the trait and stops tracking it.
+func (r *DebugTraitReconciler) deleteDebugPod(ctx context.Context, trait *oamv1alpha2.DebugTrait) error {
+	pod := &corev1.Pod{}
+	pod.SetNamespace(trait.Namespace)
+	pod.SetName(debugPodName(trait))
+	if err := r.Delete(ctx, pod); client.IgnoreNotFound(err) != nil {
+		return errors.Wrap(err, errDeleteDebugPod)
+	}
+	return trackResources(ctx, r, r.Scheme, trait)
+}
+
+func debugPodName(trait *oamv1alpha2.DebugTrait) string {
+	return trait.Name + "-debug"
+}
+
+func debugTTL(trait *oamv1alpha2.DebugTrait) time.Duration {
+	if trait.Spec.TTL != nil {
+		return trait.Spec.TTL.Duration
+	}
+	return defaultDebugTTL
+}
+
+// the time until the debug pod of the trait expires
+func debugPodExpiry(trait *oamv1alpha2.DebugTrait, now time.Time) time.Duration {
+	return trait.Status.ExpiresAt.Sub(now)
+}
+
+// debugPod returns a copy of the pod, left to the scheduler and without
+// probes, along with a debug container running the trait's image and sharing
+// the process namespace of the other containers. Unless the trait sets a
+// command, the debug container sleeps for ttl.
+func debugPod(trait *oamv1alpha2.DebugTrait, source *corev1.Pod, ttl time.Duration) *corev1.Pod {
+	spec := source.Spec.DeepCopy()
+	spec.NodeName = ""
+	spec.RestartPolicy = corev1.RestartPolicyNever
+	share := true
+	spec.ShareProcessNamespace = &share
+	for i := range spec.Containers {
+		c := &spec.Containers[i]
+		c.LivenessProbe, c.ReadinessProbe, c.StartupProbe = nil, nil, nil
+	}
+	command := trait.Spec.Command
+	if len(command) == 0 {
+		command = []string{"sleep", strconv.Itoa(int(ttl.Seconds()))}
+	}
+	spec.Containers = append(spec.Containers, corev1.Container{
+		Name:    oamv1alpha2.DebugContainerName,
+		Image:   trait.Spec.Image,
+		Command: command,
+		Stdin:   true,
+		TTY:     true,
+	})
+	return &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{APIVersion: corev1.SchemeGroupVersion.String(), Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: trait.Namespace,
+			Name:      debugPodName(trait),
+			Labels:    map[string]string{debugTraitLabel: trait.Name},
+		},
+		Spec: *spec,
+	}
+}
+
+func (r *DebugTraitReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&oamv1alpha2.DebugTrait{}).
+		Owns(&corev1.Pod{}).
+		Complete(r.Debug.Wrap("DebugTrait", r))
+}
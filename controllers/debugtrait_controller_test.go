@@ -0,0 +1,116 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/simtest"
+)
+
+func TestDebugTraitReconcile(t *testing.T) {
+	cases := map[string]struct {
+		// the debug pod expired before the reconcile
+		expired bool
+		wantPod bool
+	}{
+		"CreateDebugPod": {wantPod: true},
+		"Expired":        {expired: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			workload := &oamv1alpha2.ContainerizedWorkload{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+			deploy := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "web-deployment", Namespace: "default"},
+				Spec: appsv1.DeploymentSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				},
+			}
+			pod := func(name string, phase corev1.PodPhase) *corev1.Pod {
+				return &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"app": "web"}},
+					Spec: corev1.PodSpec{
+						NodeName: "node-1",
+						Containers: []corev1.Container{{
+							Name:          "web",
+							Image:         "nginx",
+							LivenessProbe: &corev1.Probe{},
+						}},
+					},
+					Status: corev1.PodStatus{Phase: phase},
+				}
+			}
+			trait := &oamv1alpha2.DebugTrait{
+				ObjectMeta: metav1.ObjectMeta{Name: "debug", Namespace: "default", UID: "debug-uid"},
+				Spec: oamv1alpha2.DebugTraitSpec{
+					Image: "busybox",
+					TTL:   &metav1.Duration{Duration: 10 * time.Minute},
+				},
+			}
+			objs := []runtime.Object{pod("web-a", corev1.PodPending), pod("web-b", corev1.PodRunning)}
+			if tc.expired {
+				exp := metav1.NewTime(time.Now().Add(-time.Minute))
+				trait.Status.ExpiresAt = &exp
+				objs = append(objs, pod("debug-debug", corev1.PodRunning))
+			}
+			h, err := simtest.New(workload, []runtime.Object{deploy}, objs...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			trait.Spec.WorkloadReference = h.WorkloadReference()
+			if err := h.Create(context.Background(), trait); err != nil {
+				t.Fatal(err)
+			}
+
+			r := &DebugTraitReconciler{Client: h, Log: ctrl.Log, Scheme: h.Scheme}
+			result, err := h.Reconcile(r, trait)
+			if err != nil {
+				t.Fatalf("Reconcile() = %v", err)
+			}
+
+			var got corev1.Pod
+			err = h.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "debug-debug"}, &got)
+			if !tc.wantPod {
+				if !apierrors.IsNotFound(err) {
+					t.Errorf("Get(debug pod) = %v, want not found", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Get(debug pod) = %v", err)
+			}
+			if result.RequeueAfter <= 0 || result.RequeueAfter > 10*time.Minute {
+				t.Errorf("Reconcile() requeue after = %s, want the TTL", result.RequeueAfter)
+			}
+			if !metav1.IsControlledBy(&got, trait) {
+				t.Errorf("debug pod owner references = %+v, want the trait", got.OwnerReferences)
+			}
+			if _, ok := got.Labels["app"]; ok {
+				t.Errorf("debug pod labels = %v, want none of the copied pod", got.Labels)
+			}
+			if got.Spec.NodeName != "" || got.Spec.Containers[0].LivenessProbe != nil {
+				t.Errorf("debug pod spec = %+v, want no node or probes", got.Spec)
+			}
+			if n := len(got.Spec.Containers); n != 2 || got.Spec.Containers[1].Image != "busybox" {
+				t.Errorf("debug pod containers = %+v, want web and a busybox debug container", got.Spec.Containers)
+			}
+
+			var gotTrait oamv1alpha2.DebugTrait
+			if err := h.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "debug"}, &gotTrait); err != nil {
+				t.Fatal(err)
+			}
+			if gotTrait.Status.CopiedPod != "web-b" {
+				t.Errorf("Reconcile() copied pod = %q, want the running web-b", gotTrait.Status.CopiedPod)
+			}
+		})
+	}
+}
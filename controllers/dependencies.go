@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// dependencyWait is how long a trait waits before checking again whether the
+// traits it depends on are synced.
+const dependencyWait = 10 * time.Second
+
+// Dependency error strings.
+const (
+	errParseDependsOn  = "cannot parse the depends-on annotation"
+	errFetchDependency = "cannot fetch the trait depended on"
+	errOtherWorkload   = "depends on a trait of another workload"
+	errDependencyCycle = "depends on itself"
+)
+
+// a dependency is a trait another trait of the same workload is applied after
+type dependency struct {
+	kind, name string
+}
+
+func (d dependency) String() string {
+	return d.kind + "/" + d.name
+}
+
+// parseDependsOn returns the traits listed in the depends-on annotation.
+func parseDependsOn(o metav1.Object) ([]dependency, error) {
+	v := o.GetAnnotations()[oamv1alpha2.AnnotationDependsOn]
+	var deps []dependency
+	for _, d := range strings.Split(v, ",") {
+		d = strings.TrimSpace(d)
+		if d == "" {
+			continue
+		}
+		parts := strings.Split(d, "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.Errorf("%s: %q is not <kind>/<name>", errParseDependsOn, d)
+		}
+		deps = append(deps, dependency{kind: parts[0], name: parts[1]})
+	}
+	return deps, nil
+}
+
+// pendingDependencies describes the traits the trait of the kind depends on
+// that are not synced yet, or returns "" if there are none. It fails if a
+// dependency applies to another workload or the dependencies form a cycle.
+func pendingDependencies(ctx context.Context, c client.Reader, kind string, trait metav1.Object,
+	ref oamv1alpha2.ResourceReference) (string, error) {
+	deps, err := parseDependsOn(trait)
+	if err != nil || len(deps) == 0 {
+		return "", err
+	}
+	self := dependency{kind: kind, name: trait.GetName()}
+	var pending []string
+	for _, d := range deps {
+		u, err := fetchDependency(ctx, c, trait.GetNamespace(), d)
+		if apierrors.IsNotFound(errors.Cause(err)) {
+			pending = append(pending, fmt.Sprintf("%s does not exist", d))
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		if name, _, _ := unstructured.NestedString(u.Object, "spec", "workloadRef", "name"); name != ref.Name {
+			return "", errors.Errorf("%s %s", errOtherWorkload, d)
+		}
+		if err := checkCycle(ctx, c, trait.GetNamespace(), self, d, map[dependency]bool{}); err != nil {
+			return "", err
+		}
+		if !synced(u) {
+			pending = append(pending, fmt.Sprintf("%s is not synced", d))
+		}
+	}
+	return strings.Join(pending, ", "), nil
+}
+
+func fetchDependency(ctx context.Context, c client.Reader, namespace string,
+	d dependency) (*unstructured.Unstructured, error) {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(oamv1alpha2.GroupVersion.WithKind(d.kind))
+	err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: d.name}, u)
+	return u, errors.Wrapf(err, "%s %s", errFetchDependency, d)
+}
+
+// checkCycle fails if self is among the transitive dependencies of d. Traits
+// that do not exist yet end the walk, they are reported as pending.
+func checkCycle(ctx context.Context, c client.Reader, namespace string, self, d dependency,
+	seen map[dependency]bool) error {
+	if d == self {
+		return errors.New(errDependencyCycle)
+	}
+	if seen[d] {
+		return nil
+	}
+	seen[d] = true
+	u, err := fetchDependency(ctx, c, namespace, d)
+	if apierrors.IsNotFound(errors.Cause(err)) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	deps, err := parseDependsOn(u)
+	if err != nil {
+		return errors.Wrapf(err, "%s", d)
+	}
+	for _, next := range deps {
+		if err := checkCycle(ctx, c, namespace, self, next, seen); err != nil {
+			return errors.Wrapf(err, "%s", d)
+		}
+	}
+	return nil
+}
+
+// synced returns true if the Synced condition of the trait is true
+func synced(u *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+	for _, c := range conditions {
+		m, ok := c.(map[string]interface{})
+		if ok && m["type"] == string(cpv1alpha1.TypeSynced) && m["status"] == string(corev1.ConditionTrue) {
+			return true
+		}
+	}
+	return false
+}
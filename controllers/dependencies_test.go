@@ -0,0 +1,75 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestPendingDependencies(t *testing.T) {
+	ref := oamv1alpha2.ResourceReference{Name: "web"}
+	patch := func(workload, dependsOn string, conditions ...cpv1alpha1.Condition) *oamv1alpha2.PatchTrait {
+		p := &oamv1alpha2.PatchTrait{ObjectMeta: metav1.ObjectMeta{Name: "labels", Namespace: "default"}}
+		p.Spec.WorkloadReference = oamv1alpha2.ResourceReference{Name: workload}
+		if dependsOn != "" {
+			p.Annotations = map[string]string{oamv1alpha2.AnnotationDependsOn: dependsOn}
+		}
+		p.Status.SetConditions(conditions...)
+		return p
+	}
+	testCases := map[string]struct {
+		dependsOn string
+		objs      []runtime.Object
+		want      string
+		wantErr   bool
+	}{
+		"NoDependencies": {},
+		"Synced": {
+			dependsOn: "PatchTrait/labels",
+			objs:      []runtime.Object{patch("web", "", cpv1alpha1.ReconcileSuccess())},
+		},
+		"NotSynced": {
+			dependsOn: "PatchTrait/labels",
+			objs:      []runtime.Object{patch("web", "")},
+			want:      "PatchTrait/labels is not synced",
+		},
+		"Missing": {
+			dependsOn: "PatchTrait/labels",
+			want:      "PatchTrait/labels does not exist",
+		},
+		"OtherWorkload": {
+			dependsOn: "PatchTrait/labels",
+			objs:      []runtime.Object{patch("api", "", cpv1alpha1.ReconcileSuccess())},
+			wantErr:   true,
+		},
+		"Cycle": {
+			dependsOn: "PatchTrait/labels",
+			objs:      []runtime.Object{patch("web", "SpreadTrait/zones", cpv1alpha1.ReconcileSuccess())},
+			wantErr:   true,
+		},
+		"Malformed": {
+			dependsOn: "labels",
+			wantErr:   true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c := fake.NewFakeClientWithScheme(testScheme, tc.objs...)
+			trait := &oamv1alpha2.SpreadTrait{ObjectMeta: metav1.ObjectMeta{Name: "zones", Namespace: "default",
+				Annotations: map[string]string{oamv1alpha2.AnnotationDependsOn: tc.dependsOn}}}
+			got, err := pendingDependencies(context.Background(), c, kindSpreadTrait, trait, ref)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("pendingDependencies() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("pendingDependencies() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package controllers
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// errCacheDeployments is returned when the workload informers cannot be
+// watched.
+const errCacheDeployments = "cannot watch the workloads to cache their deployments"
+
+// A WorkloadResolver resolves the workload and the deployment the workload
+// reference of a trait points at, for the trait reconcilers sharing it. A nil
+// WorkloadResolver caches nothing and lets traits apply to the workloads of
+// every kubeconfig context.
+type WorkloadResolver struct {
+	// RoutedContexts rejects the workloads whose children are applied to the
+	// cluster of their kubeconfig context, see DevClusters.
+	RoutedContexts bool
+
+	deployments *deploymentCache
+}
+
+// NewWorkloadResolver returns a WorkloadResolver caching the deployment of
+// the ContainerizedWorkloads and TemplatedWorkloads traits refer to. It
+// watches the workloads through the manager's cache to forget a deployment
+// once the resources of its workload change.
+func NewWorkloadResolver(mgr ctrl.Manager, routedContexts bool) (*WorkloadResolver, error) {
+	c, err := cacheWorkloadDeployments(mgr)
+	if err != nil {
+		return nil, err
+	}
+	return &WorkloadResolver{RoutedContexts: routedContexts, deployments: c}, nil
+}
+
+func (w *WorkloadResolver) routesContexts() bool {
+	return w != nil && w.RoutedContexts
+}
+
+func (w *WorkloadResolver) cache() *deploymentCache {
+	if w == nil {
+		return nil
+	}
+	return w.deployments
+}
+
+// a workloadKey identifies the workload a trait refers to
+type workloadKey struct {
+	schema.GroupVersionKind
+	client.ObjectKey
+}
+
+// the deployment a workload reference resolved to
+type resolvedDeployment struct {
+	// the name, UID and kubeconfig context of the workload, which traits
+	// check the way they check a workload they fetched
+	workload metav1.Object
+	name     string
+}
+
+// resolvedWorkload returns the metadata of the workload a resolvedDeployment
+// keeps.
+func resolvedWorkload(workload metav1.Object) metav1.Object {
+	m := &metav1.ObjectMeta{Name: workload.GetName(), UID: workload.GetUID()}
+	if name := workload.GetAnnotations()[oamv1alpha2.AnnotationKubeconfigContext]; name != "" {
+		m.Annotations = map[string]string{oamv1alpha2.AnnotationKubeconfigContext: name}
+	}
+	return m
+}
+
+// A deploymentCache remembers the deployment each workload resolved to, so
+// that traits do not fetch the workload and look through its status.resources
+// on every reconcile. The entry of a workload is forgotten when its resources
+// change or it is deleted.
+type deploymentCache struct {
+	mu    sync.RWMutex
+	kinds map[schema.GroupVersionKind]bool
+	// incremented by every forget, so that a resolution that raced with a
+	// change of a workload is not cached
+	generation uint64
+	entries    map[workloadKey]resolvedDeployment
+}
+
+// cacheWorkloadDeployments returns a deploymentCache of the
+// ContainerizedWorkloads and TemplatedWorkloads.
+func cacheWorkloadDeployments(mgr ctrl.Manager) (*deploymentCache, error) {
+	c := &deploymentCache{kinds: map[schema.GroupVersionKind]bool{}, entries: map[workloadKey]resolvedDeployment{}}
+	for _, obj := range []runtime.Object{&oamv1alpha2.ContainerizedWorkload{}, &oamv1alpha2.TemplatedWorkload{}} {
+		gvk, err := apiutil.GVKForObject(obj, mgr.GetScheme())
+		if err != nil {
+			return nil, errors.Wrap(err, errCacheDeployments)
+		}
+		informer, err := mgr.GetCache().GetInformer(obj)
+		if err != nil {
+			return nil, errors.Wrap(err, errCacheDeployments)
+		}
+		informer.AddEventHandler(c.handler(gvk))
+		c.kinds[gvk] = true
+	}
+	return c, nil
+}
+
+// handler forgets the deployment of the workloads of the kind whose resources
+// or kubeconfig context change or that are deleted.
+func (c *deploymentCache) handler(gvk schema.GroupVersionKind) toolscache.ResourceEventHandler {
+	return toolscache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, obj interface{}) {
+			if !reflect.DeepEqual(statusResources(old), statusResources(obj)) ||
+				kubeconfigContextOf(old) != kubeconfigContextOf(obj) {
+				c.forgetObject(gvk, obj)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			c.forgetObject(gvk, obj)
+		},
+	}
+}
+
+func statusResources(obj interface{}) []oamv1alpha2.ResourceReference {
+	switch w := obj.(type) {
+	case *oamv1alpha2.ContainerizedWorkload:
+		return w.Status.Resources
+	case *oamv1alpha2.TemplatedWorkload:
+		return w.Status.Resources
+	}
+	return nil
+}
+
+func kubeconfigContextOf(obj interface{}) string {
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+	return m.GetAnnotations()[oamv1alpha2.AnnotationKubeconfigContext]
+}
+
+func (c *deploymentCache) forgetObject(gvk schema.GroupVersionKind, obj interface{}) {
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+	c.forget(workloadKey{GroupVersionKind: gvk, ObjectKey: client.ObjectKey{Namespace: m.GetNamespace(),
+		Name: m.GetName()}})
+}
+
+// get returns the deployment the workload resolved to. A nil cache has no
+// entries.
+func (c *deploymentCache) get(key workloadKey) (resolvedDeployment, bool) {
+	if c == nil {
+		return resolvedDeployment{}, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	e, ok := c.entries[key]
+	return e, ok
+}
+
+// epoch returns the generation to pass to put for a resolution starting now.
+func (c *deploymentCache) epoch() uint64 {
+	if c == nil {
+		return 0
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.generation
+}
+
+// put caches the deployment a workload resolved to, unless an entry was
+// forgotten since the resolution started at generation.
+func (c *deploymentCache) put(key workloadKey, generation uint64, d resolvedDeployment) {
+	if c == nil || !c.kinds[key.GroupVersionKind] {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generation == generation {
+		c.entries[key] = d
+	}
+}
+
+func (c *deploymentCache) forget(key workloadKey) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+	c.generation++
+}
@@ -0,0 +1,100 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/simtest"
+)
+
+func TestDeploymentCache(t *testing.T) {
+	ctx := context.Background()
+	workload := &oamv1alpha2.ContainerizedWorkload{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	v1 := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web-v1", Namespace: "default"}}
+	v2 := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web-v2", Namespace: "default"}}
+	h, err := simtest.New(workload, []runtime.Object{v1}, v2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gvk := oamv1alpha2.GroupVersion.WithKind(kindContainerizedWorkload)
+	c := &deploymentCache{kinds: map[schema.GroupVersionKind]bool{gvk: true},
+		entries: map[workloadKey]resolvedDeployment{}}
+	w := &WorkloadResolver{deployments: c}
+
+	fetch := func(ref oamv1alpha2.ResourceReference) string {
+		t.Helper()
+		deploy, err := w.fetchWorkloadDeployment(ctx, h, ctrl.Log, "default", ref)
+		if err != nil {
+			return ""
+		}
+		return deploy.Name
+	}
+	ref := h.WorkloadReference()
+	if got := fetch(ref); got != "web-v1" {
+		t.Fatalf("fetchWorkloadDeployment() = %q, want web-v1", got)
+	}
+
+	// the workload now lists another deployment, which goes unnoticed until
+	// the informer reports the change
+	old := h.Workload.DeepCopy()
+	var current oamv1alpha2.ContainerizedWorkload
+	if err := h.Get(ctx, types.NamespacedName{Namespace: "default", Name: "web"}, &current); err != nil {
+		t.Fatal(err)
+	}
+	uid := v2.UID
+	current.Status.Resources = []oamv1alpha2.ResourceReference{{APIVersion: "apps/v1", Kind: KindDeployment,
+		Name: v2.Name, UID: &uid}}
+	if err := h.Status().Update(ctx, &current); err != nil {
+		t.Fatal(err)
+	}
+	if got := fetch(ref); got != "web-v1" {
+		t.Errorf("fetchWorkloadDeployment() = %q, want the cached web-v1", got)
+	}
+	c.handler(gvk).OnUpdate(old, &current)
+	if got := fetch(ref); got != "web-v2" {
+		t.Errorf("fetchWorkloadDeployment() = %q after the resources changed, want web-v2", got)
+	}
+
+	// a strict reference to another UID is not served from the cache
+	stale := types.UID("recreated-uid")
+	strict := ref
+	strict.UID, strict.StrictUID = &stale, true
+	if got := fetch(strict); got != "" {
+		t.Errorf("fetchWorkloadDeployment() = %q for a strict reference to another UID", got)
+	}
+
+	// nor is a workload whose children moved to another cluster
+	w.RoutedContexts = true
+	moved := current.DeepCopy()
+	moved.SetAnnotations(map[string]string{oamv1alpha2.AnnotationKubeconfigContext: "kind-staging"})
+	if err := h.Update(ctx, moved); err != nil {
+		t.Fatal(err)
+	}
+	c.handler(gvk).OnUpdate(&current, moved)
+	if got := fetch(ref); got != "" {
+		t.Errorf("fetchWorkloadDeployment() = %q for a workload of another cluster", got)
+	}
+	// the cached resolutions are checked the same way
+	cached := workloadKey{GroupVersionKind: gvk, ObjectKey: types.NamespacedName{Namespace: "default", Name: "web"}}
+	c.put(cached, c.epoch(), resolvedDeployment{workload: resolvedWorkload(moved), name: v2.Name})
+	if got := fetch(ref); got != "" {
+		t.Errorf("fetchWorkloadDeployment() = %q from the cache for a workload of another cluster", got)
+	}
+
+	// a resolution that raced with a change is not cached
+	key := workloadKey{GroupVersionKind: gvk, ObjectKey: types.NamespacedName{Namespace: "default", Name: "other"}}
+	epoch := c.epoch()
+	c.handler(gvk).OnDelete(&current)
+	c.put(key, epoch, resolvedDeployment{name: "other"})
+	if _, ok := c.get(key); ok {
+		t.Errorf("get() found a resolution that raced with a change")
+	}
+}
@@ -0,0 +1,169 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/debug"
+)
+
+// Reconcile error strings.
+const (
+	errStrategyDeployment = "cannot set the strategy of the deployment"
+)
+
+// DeploymentStrategyTraitReconciler reconciles a DeploymentStrategyTrait object
+type DeploymentStrategyTraitReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// Limiter, if set, rate limits the writes of child resources per namespace.
+	Limiter *NamespaceWriteLimiter
+	// Debug, if set, records the outcome of each reconcile.
+	Debug *debug.Recorder
+	// Workloads, if set, resolves the workload the trait refers to, see
+	// WorkloadResolver.
+	Workloads *WorkloadResolver
+}
+
+// +kubebuilder:rbac:groups=core.oam.dev,resources=deploymentstrategytraits,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=core.oam.dev,resources=deploymentstrategytraits/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
+
+func (r *DeploymentStrategyTraitReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("deployment strategy trait", req.NamespacedName)
+	log.Info("Reconcile deployment strategy trait")
+
+	var trait oamv1alpha2.DeploymentStrategyTrait
+	if err := r.Get(ctx, req.NamespacedName, &trait); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	deleted, err := r.Workloads.finalizeManagedFields(ctx, r, log,
+		&trait, kindDeploymentStrategyTrait, trait.Spec.WorkloadReference)
+	if err != nil {
+		log.Error(err, "Failed to revert the fields set by the trait")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, err
+	}
+	if deleted {
+		return ctrl.Result{}, nil
+	}
+
+	deploy, err := r.Workloads.fetchWorkloadDeployment(ctx, r, log, req.Namespace, trait.Spec.WorkloadReference)
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+
+	if result, ok, err := gateReconcile(ctx, r, log, r.Limiter, kindDeploymentStrategyTrait, &trait, &trait.Status.ConditionedStatus,
+		&trait.Spec.WorkloadReference); !ok {
+		return result, err
+	}
+
+	// set the strategy, refetching the deployment if it changed under us
+	err = retryTransient(applyBackoff, func() error {
+		return r.Get(ctx, client.ObjectKey{Name: deploy.Name, Namespace: deploy.Namespace}, deploy)
+	}, func() error {
+		sd, err := strategyDeployment(&trait, deploy)
+		if err != nil {
+			return err
+		}
+		if err := recordManagedFields(deploy, sd, managedFieldsKey(kindDeploymentStrategyTrait, trait.Name)); err != nil {
+			return err
+		}
+		return r.Patch(ctx, sd, client.MergeFrom(deploy))
+	})
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(errors.Wrap(err, errStrategyDeployment))...)
+		log.Error(err, "Failed to set the strategy of a deployment")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	log.Info("Successfully set the strategy of a deployment", "UID", deploy.UID)
+
+	r.Limiter.MarkForceSynced(&trait)
+	trait.Status.SetConditions(oamv1alpha2.PermissionGranted(), cpv1alpha1.ReconcileSuccess())
+	return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &trait), errUpdateStatus)
+}
+
+// strategyDeployment returns a copy of the deployment with the trait's
+// strategy, fields the trait leaves unset keep their value. Rolling update
+// parameters are dropped from a deployment that is recreated.
+func strategyDeployment(trait *oamv1alpha2.DeploymentStrategyTrait,
+	deploy *appsv1.Deployment) (*appsv1.Deployment, error) {
+	sd := deploy.DeepCopy()
+	spec := &sd.Spec
+	if trait.Spec.Type != "" {
+		spec.Strategy.Type = trait.Spec.Type
+	}
+	rolling := trait.Spec.MaxSurge != nil || trait.Spec.MaxUnavailable != nil
+	if spec.Strategy.Type == appsv1.RecreateDeploymentStrategyType {
+		if rolling {
+			return nil, errors.Errorf("maxSurge and maxUnavailable require the %s type",
+				appsv1.RollingUpdateDeploymentStrategyType)
+		}
+		spec.Strategy.RollingUpdate = nil
+	}
+	if rolling {
+		if spec.Strategy.RollingUpdate == nil {
+			spec.Strategy.RollingUpdate = &appsv1.RollingUpdateDeployment{}
+		}
+		if trait.Spec.MaxSurge != nil {
+			v := *trait.Spec.MaxSurge
+			spec.Strategy.RollingUpdate.MaxSurge = &v
+		}
+		if trait.Spec.MaxUnavailable != nil {
+			v := *trait.Spec.MaxUnavailable
+			spec.Strategy.RollingUpdate.MaxUnavailable = &v
+		}
+	}
+	if trait.Spec.MinReadySeconds != nil {
+		spec.MinReadySeconds = *trait.Spec.MinReadySeconds
+	}
+	if trait.Spec.ProgressDeadlineSeconds != nil {
+		v := *trait.Spec.ProgressDeadlineSeconds
+		spec.ProgressDeadlineSeconds = &v
+	}
+	// always set the owner reference so that we can watch this deployment
+	setTraitOwnerReference(sd, trait.APIVersion, trait.Kind, trait)
+	return sd, nil
+}
+
+func (r *DeploymentStrategyTraitReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&oamv1alpha2.DeploymentStrategyTrait{}).
+		Watches(&source.Kind{
+			Type: &appsv1.Deployment{},
+		}, &handler.EnqueueRequestForOwner{
+			OwnerType:    &oamv1alpha2.DeploymentStrategyTrait{},
+			IsController: false, // other traits may own the deployment too
+		}).
+		Complete(r.Debug.Wrap("DeploymentStrategyTrait", r))
+}
@@ -0,0 +1,83 @@
+package controllers
+
+import (
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestStrategyDeployment(t *testing.T) {
+	surge, unavailable := intstr.FromString("50%"), intstr.FromInt(0)
+	minReady, deadline := int32(10), int32(300)
+	oldSurge := intstr.FromInt(1)
+	rolling := &appsv1.Deployment{Spec: appsv1.DeploymentSpec{Strategy: appsv1.DeploymentStrategy{
+		Type:          appsv1.RollingUpdateDeploymentStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateDeployment{MaxSurge: &oldSurge, MaxUnavailable: &oldSurge},
+	}}}
+
+	testCases := map[string]struct {
+		spec    oamv1alpha2.DeploymentStrategyTraitSpec
+		want    appsv1.DeploymentSpec
+		wantErr bool
+	}{
+		"RollingUpdate": {
+			spec: oamv1alpha2.DeploymentStrategyTraitSpec{
+				MaxSurge: &surge, MaxUnavailable: &unavailable, MinReadySeconds: &minReady,
+				ProgressDeadlineSeconds: &deadline,
+			},
+			want: appsv1.DeploymentSpec{
+				Strategy: appsv1.DeploymentStrategy{
+					Type:          appsv1.RollingUpdateDeploymentStrategyType,
+					RollingUpdate: &appsv1.RollingUpdateDeployment{MaxSurge: &surge, MaxUnavailable: &unavailable},
+				},
+				MinReadySeconds:         minReady,
+				ProgressDeadlineSeconds: &deadline,
+			},
+		},
+		"PartialRollingUpdate": {
+			spec: oamv1alpha2.DeploymentStrategyTraitSpec{MaxSurge: &surge},
+			want: appsv1.DeploymentSpec{Strategy: appsv1.DeploymentStrategy{
+				Type:          appsv1.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDeployment{MaxSurge: &surge, MaxUnavailable: &oldSurge},
+			}},
+		},
+		"Recreate": {
+			spec: oamv1alpha2.DeploymentStrategyTraitSpec{Type: appsv1.RecreateDeploymentStrategyType},
+			want: appsv1.DeploymentSpec{Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType}},
+		},
+		"RecreateWithSurge": {
+			spec:    oamv1alpha2.DeploymentStrategyTraitSpec{Type: appsv1.RecreateDeploymentStrategyType, MaxSurge: &surge},
+			wantErr: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			trait := &oamv1alpha2.DeploymentStrategyTrait{
+				TypeMeta:   metav1.TypeMeta{APIVersion: oamv1alpha2.GroupVersion.String(), Kind: kindDeploymentStrategyTrait},
+				ObjectMeta: metav1.ObjectMeta{Name: "strategy", UID: "trait-uid"},
+				Spec:       tc.spec,
+			}
+			got, err := strategyDeployment(trait, rolling)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("strategyDeployment() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got.Spec, tc.want) {
+				t.Errorf("strategyDeployment() spec = %+v, want %+v", got.Spec, tc.want)
+			}
+			if refs := got.GetOwnerReferences(); len(refs) != 1 || refs[0].UID != trait.UID {
+				t.Errorf("strategyDeployment() owner references = %v", refs)
+			}
+		})
+	}
+	if *rolling.Spec.Strategy.RollingUpdate.MaxSurge != oldSurge {
+		t.Errorf("strategyDeployment() modified the original deployment")
+	}
+}
@@ -0,0 +1,184 @@
+package controllers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// errKubeconfigContext is returned when a client for a context of the dev
+// kubeconfig cannot be created.
+const errKubeconfigContext = "cannot connect to the kubeconfig context"
+
+// errRemoteWorkload is returned to the traits of a workload whose children
+// are applied to the cluster of a kubeconfig context. Only the workload's own
+// reconcile is routed there, so traits cannot find or change its children.
+const errRemoteWorkload = "traits cannot apply to a workload whose children are in the kubeconfig context"
+
+// the context key of the kubeconfig context a reconcile targets
+type kubeconfigContextKey struct{}
+
+// withKubeconfigContext returns ctx targeting the kubeconfig context named by
+// the object's AnnotationKubeconfigContext, if any.
+func withKubeconfigContext(ctx context.Context, obj metav1.Object) context.Context {
+	name := obj.GetAnnotations()[oamv1alpha2.AnnotationKubeconfigContext]
+	if name == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, kubeconfigContextKey{}, name)
+}
+
+func kubeconfigContext(ctx context.Context) string {
+	name, _ := ctx.Value(kubeconfigContextKey{}).(string)
+	return name
+}
+
+// DevClusters connect to the clusters of the contexts of a local kubeconfig,
+// e.g. a kind and a minikube cluster, so that multi-cluster flows can be
+// tried out on a laptop. They are meant for development only.
+type DevClusters struct {
+	kubeconfig string
+	scheme     *runtime.Scheme
+
+	mu      sync.Mutex
+	clients map[string]client.Client
+}
+
+// NewDevClusters returns DevClusters for the contexts of the kubeconfig file.
+func NewDevClusters(kubeconfig string, scheme *runtime.Scheme) *DevClusters {
+	return &DevClusters{kubeconfig: kubeconfig, scheme: scheme, clients: map[string]client.Client{}}
+}
+
+// clientFor returns a client of the cluster of the named context, creating
+// it on first use.
+func (d *DevClusters) clientFor(name string) (client.Client, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if c, ok := d.clients[name]; ok {
+		return c, nil
+	}
+	cfg, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		&clientcmd.ClientConfigLoadingRules{ExplicitPath: d.kubeconfig},
+		&clientcmd.ConfigOverrides{CurrentContext: name}).ClientConfig()
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s %s", errKubeconfigContext, name)
+	}
+	c, err := client.New(cfg, client.Options{Scheme: d.scheme})
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s %s", errKubeconfigContext, name)
+	}
+	d.clients[name] = c
+	return c, nil
+}
+
+// Client returns a client that sends the requests of reconciles targeting a
+// kubeconfig context, see withKubeconfigContext, to the cluster of that
+// context, except those for OAM objects and namespaces, which are still sent
+// to local. Owner references are dropped from the objects it writes to
+// another cluster, where their owners do not exist. Traits must then refuse
+// to apply to the workloads of such reconciles, see
+// WorkloadResolver.RoutedContexts. A nil DevClusters returns local.
+func (d *DevClusters) Client(local client.Client) client.Client {
+	if d == nil {
+		return local
+	}
+	return &devClient{Client: local, clusters: d}
+}
+
+type devClient struct {
+	client.Client
+	clusters *DevClusters
+}
+
+// route returns the client of the cluster the request for obj is sent to.
+func (c *devClient) route(ctx context.Context, obj runtime.Object) (client.Client, bool, error) {
+	name := kubeconfigContext(ctx)
+	if name == "" {
+		return c.Client, false, nil
+	}
+	gvk, err := apiutil.GVKForObject(obj, c.clusters.scheme)
+	if err != nil {
+		return nil, false, err
+	}
+	if gvk.Group == oamv1alpha2.GroupVersion.Group || (gvk.Group == "" && (gvk.Kind == "Namespace" || gvk.Kind == "NamespaceList")) {
+		return c.Client, false, nil
+	}
+	remote, err := c.clusters.clientFor(name)
+	return remote, true, err
+}
+
+// routeWrite is route for objects about to be written.
+func (c *devClient) routeWrite(ctx context.Context, obj runtime.Object) (client.Client, error) {
+	target, remote, err := c.route(ctx, obj)
+	if err != nil || !remote {
+		return target, err
+	}
+	if m, ok := obj.(metav1.Object); ok {
+		m.SetOwnerReferences(nil)
+	}
+	return target, nil
+}
+
+func (c *devClient) Get(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+	target, _, err := c.route(ctx, obj)
+	if err != nil {
+		return err
+	}
+	return target.Get(ctx, key, obj)
+}
+
+func (c *devClient) List(ctx context.Context, list runtime.Object, opts ...client.ListOption) error {
+	target, _, err := c.route(ctx, list)
+	if err != nil {
+		return err
+	}
+	return target.List(ctx, list, opts...)
+}
+
+func (c *devClient) Create(ctx context.Context, obj runtime.Object, opts ...client.CreateOption) error {
+	target, err := c.routeWrite(ctx, obj)
+	if err != nil {
+		return err
+	}
+	return target.Create(ctx, obj, opts...)
+}
+
+func (c *devClient) Update(ctx context.Context, obj runtime.Object, opts ...client.UpdateOption) error {
+	target, err := c.routeWrite(ctx, obj)
+	if err != nil {
+		return err
+	}
+	return target.Update(ctx, obj, opts...)
+}
+
+func (c *devClient) Patch(ctx context.Context, obj runtime.Object, patch client.Patch, opts ...client.PatchOption) error {
+	target, err := c.routeWrite(ctx, obj)
+	if err != nil {
+		return err
+	}
+	return target.Patch(ctx, obj, patch, opts...)
+}
+
+func (c *devClient) Delete(ctx context.Context, obj runtime.Object, opts ...client.DeleteOption) error {
+	target, _, err := c.route(ctx, obj)
+	if err != nil {
+		return err
+	}
+	return target.Delete(ctx, obj, opts...)
+}
+
+func (c *devClient) DeleteAllOf(ctx context.Context, obj runtime.Object, opts ...client.DeleteAllOfOption) error {
+	target, _, err := c.route(ctx, obj)
+	if err != nil {
+		return err
+	}
+	return target.DeleteAllOf(ctx, obj, opts...)
+}
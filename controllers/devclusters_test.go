@@ -0,0 +1,81 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestDevClient(t *testing.T) {
+	workload := &oamv1alpha2.ContainerizedWorkload{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", Annotations: map[string]string{
+			oamv1alpha2.AnnotationKubeconfigContext: "kind-staging",
+		}},
+	}
+	deploy := func() *appsv1.Deployment {
+		return &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+			Name:            "web",
+			Namespace:       "default",
+			OwnerReferences: []metav1.OwnerReference{{Name: "web", UID: "workload-uid"}},
+		}}
+	}
+
+	testCases := map[string]struct {
+		ctx        context.Context
+		wantRemote bool
+	}{
+		"Local": {
+			ctx: context.Background(),
+		},
+		"KubeconfigContext": {
+			ctx:        withKubeconfigContext(context.Background(), workload),
+			wantRemote: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			local := fake.NewFakeClientWithScheme(testScheme, workload.DeepCopy(),
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}})
+			remote := fake.NewFakeClientWithScheme(testScheme)
+			d := &DevClusters{scheme: testScheme, clients: map[string]client.Client{"kind-staging": remote}}
+			c := d.Client(local)
+
+			if err := c.Create(tc.ctx, deploy()); err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			target, other := local, remote
+			if tc.wantRemote {
+				target, other = remote, local
+			}
+			var got appsv1.Deployment
+			if err := target.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "web"}, &got); err != nil {
+				t.Fatalf("the deployment was not created where expected: %v", err)
+			}
+			if err := other.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "web"}, &got); err == nil {
+				t.Errorf("the deployment was also created in the other cluster")
+			}
+			if tc.wantRemote && len(got.OwnerReferences) != 0 {
+				t.Errorf("the remote deployment has owner references %v", got.OwnerReferences)
+			}
+
+			// OAM objects and namespaces are always local
+			for _, obj := range []runtime.Object{&oamv1alpha2.ContainerizedWorkload{}, &corev1.Namespace{}} {
+				key := client.ObjectKey{Namespace: "default", Name: "web"}
+				if _, ok := obj.(*corev1.Namespace); ok {
+					key = client.ObjectKey{Name: "default"}
+				}
+				if err := c.Get(tc.ctx, key, obj); err != nil {
+					t.Errorf("Get(%T) error = %v", obj, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/envtemplate"
+)
+
+// errResolveExternalRef is returned when a referenced resource cannot be read.
+const errResolveExternalRef = "cannot resolve the external reference"
+
+// prefix of the names of the volumes holding external resources
+const externalVolumePrefix = "external-"
+
+// injectExternalReferences fetches the resources the workload refers to and
+// exposes them to the containers of the deployment. It returns a message
+// naming the resources that do not exist, if any, in which case the
+// deployment is left alone.
+func (r *ContainerizedWorkloadReconciler) injectExternalReferences(ctx context.Context,
+	workload *oamv1alpha2.ContainerizedWorkload, deploy *appsv1.Deployment) (string, error) {
+	refs := workload.Spec.ExternalReferences
+	secretKeys := templatedSecrets(workload)
+	if len(refs) == 0 && len(secretKeys) == 0 {
+		return "", nil
+	}
+
+	services := map[string]*corev1.Service{}
+	var missing []string
+	for _, ref := range refs {
+		var obj runtime.Object
+		switch ref.Kind {
+		case oamv1alpha2.ExternalReferenceService:
+			svc := &corev1.Service{}
+			services[ref.Name] = svc
+			obj = svc
+		case oamv1alpha2.ExternalReferenceSecret:
+			obj = &corev1.Secret{}
+		case oamv1alpha2.ExternalReferencePersistentVolumeClaim:
+			obj = &corev1.PersistentVolumeClaim{}
+		default:
+			return "", errors.Errorf("%s: unsupported kind %q", errResolveExternalRef, ref.Kind)
+		}
+		err := r.Get(ctx, client.ObjectKey{Namespace: workload.Namespace, Name: ref.Name}, obj)
+		if apierrors.IsNotFound(err) {
+			missing = append(missing, fmt.Sprintf("%s %s", ref.Kind, ref.Name))
+			continue
+		}
+		if err != nil {
+			return "", errors.Wrapf(err, "%s to %s %s", errResolveExternalRef, ref.Kind, ref.Name)
+		}
+	}
+	// the secrets of external stores are only created once they are synced
+	for _, sel := range secretKeys {
+		secret := &corev1.Secret{}
+		err := r.Get(ctx, client.ObjectKey{Namespace: workload.Namespace, Name: sel.Name}, secret)
+		if apierrors.IsNotFound(err) {
+			missing = append(missing, fmt.Sprintf("%s %s", oamv1alpha2.ExternalReferenceSecret, sel.Name))
+			continue
+		}
+		if err != nil {
+			return "", errors.Wrapf(err, "%s to %s %s", errResolveExternalRef, oamv1alpha2.ExternalReferenceSecret, sel.Name)
+		}
+		if _, ok := secret.Data[sel.Key]; !ok {
+			missing = append(missing, fmt.Sprintf("key %s of %s %s", sel.Key, oamv1alpha2.ExternalReferenceSecret, sel.Name))
+		}
+	}
+	if len(missing) > 0 {
+		return "missing " + strings.Join(missing, ", "), nil
+	}
+	if len(refs) == 0 {
+		return "", nil
+	}
+
+	spec := &deploy.Spec.Template.Spec
+	var env []corev1.EnvVar
+	var envFrom []corev1.EnvFromSource
+	var mounts []corev1.VolumeMount
+	for _, ref := range refs {
+		switch ref.Kind {
+		case oamv1alpha2.ExternalReferenceService:
+			env = append(env, serviceEnv(services[ref.Name])...)
+		case oamv1alpha2.ExternalReferenceSecret:
+			if ref.MountPath == "" {
+				envFrom = append(envFrom, corev1.EnvFromSource{
+					SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name}},
+				})
+				continue
+			}
+			spec.Volumes = append(spec.Volumes, corev1.Volume{
+				Name:         externalVolumePrefix + "secret-" + ref.Name,
+				VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: ref.Name}},
+			})
+			mounts = append(mounts, corev1.VolumeMount{
+				Name:      externalVolumePrefix + "secret-" + ref.Name,
+				MountPath: ref.MountPath,
+				ReadOnly:  true,
+			})
+		case oamv1alpha2.ExternalReferencePersistentVolumeClaim:
+			spec.Volumes = append(spec.Volumes, corev1.Volume{
+				Name: externalVolumePrefix + "pvc-" + ref.Name,
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: ref.Name},
+				},
+			})
+			mounts = append(mounts, corev1.VolumeMount{Name: externalVolumePrefix + "pvc-" + ref.Name, MountPath: ref.MountPath})
+		}
+	}
+
+	// the containers are shared with the workload's spec, copy them first
+	containers := make([]corev1.Container, len(spec.Containers))
+	for i := range spec.Containers {
+		c := spec.Containers[i].DeepCopy()
+		c.Env = append(c.Env, env...)
+		c.EnvFrom = append(c.EnvFrom, envFrom...)
+		c.VolumeMounts = append(c.VolumeMounts, mounts...)
+		containers[i] = *c
+	}
+	spec.Containers = containers
+	return "", nil
+}
+
+// templatedSecrets returns the secret keys environment variable values of the
+// workload's containers refer to, in order and without duplicates.
+func templatedSecrets(workload *oamv1alpha2.ContainerizedWorkload) []*corev1.SecretKeySelector {
+	var keys []*corev1.SecretKeySelector
+	seen := map[corev1.SecretKeySelector]bool{}
+	containers := append(append([]corev1.Container{}, workload.Spec.InitContainers...), workload.Spec.Containers...)
+	for _, c := range containers {
+		for _, env := range c.Env {
+			sel, ok := envtemplate.SecretReference(env.Value)
+			if !ok || seen[*sel] {
+				continue
+			}
+			seen[*sel] = true
+			keys = append(keys, sel)
+		}
+	}
+	return keys
+}
+
+// serviceEnv returns the environment variables kubernetes would set for a
+// service, pointing at its cluster DNS name rather than its IP.
+func serviceEnv(svc *corev1.Service) []corev1.EnvVar {
+	prefix := strings.ToUpper(strings.Replace(svc.Name, "-", "_", -1)) + "_SERVICE_"
+	env := []corev1.EnvVar{{Name: prefix + "HOST", Value: svc.Name + "." + svc.Namespace + ".svc"}}
+	if len(svc.Spec.Ports) > 0 {
+		env = append(env, corev1.EnvVar{Name: prefix + "PORT", Value: strconv.Itoa(int(svc.Spec.Ports[0].Port))})
+	}
+	return env
+}
@@ -0,0 +1,132 @@
+package controllers
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestInjectExternalReferences(t *testing.T) {
+	db := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "orders-db"},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 5432}}},
+	}
+	creds := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "creds"}}
+	refs := []oamv1alpha2.ExternalReference{
+		{Kind: oamv1alpha2.ExternalReferenceService, Name: "orders-db"},
+		{Kind: oamv1alpha2.ExternalReferenceSecret, Name: "creds"},
+	}
+	testCases := map[string]struct {
+		objs        []runtime.Object
+		wantMissing string
+		wantEnv     []corev1.EnvVar
+		wantEnvFrom int
+	}{
+		"Resolved": {
+			objs: []runtime.Object{db, creds},
+			wantEnv: []corev1.EnvVar{
+				{Name: "ORDERS_DB_SERVICE_HOST", Value: "orders-db.default.svc"},
+				{Name: "ORDERS_DB_SERVICE_PORT", Value: "5432"},
+			},
+			wantEnvFrom: 1,
+		},
+		"Missing": {
+			objs:        []runtime.Object{db},
+			wantMissing: "missing Secret creds",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			_ = corev1.AddToScheme(s)
+			r := &ContainerizedWorkloadReconciler{Client: fake.NewFakeClientWithScheme(s, testCase.objs...)}
+			workload := &oamv1alpha2.ContainerizedWorkload{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "orders"},
+				Spec: oamv1alpha2.ContainerizedWorkloadSpec{
+					Containers:         []corev1.Container{{Name: "orders", Image: "orders"}},
+					ExternalReferences: refs,
+				},
+			}
+			deploy := &appsv1.Deployment{}
+			deploy.Spec.Template.Spec.Containers = workload.Spec.Containers
+
+			missing, err := r.injectExternalReferences(context.Background(), workload, deploy)
+			if err != nil {
+				t.Fatalf("injectExternalReferences() = %v", err)
+			}
+			if missing != testCase.wantMissing {
+				t.Errorf("injectExternalReferences() missing = %q, want %q", missing, testCase.wantMissing)
+			}
+			c := deploy.Spec.Template.Spec.Containers[0]
+			if !reflect.DeepEqual(c.Env, testCase.wantEnv) || len(c.EnvFrom) != testCase.wantEnvFrom {
+				t.Errorf("injectExternalReferences() env = %v, envFrom = %v", c.Env, c.EnvFrom)
+			}
+			if len(workload.Spec.Containers[0].Env) != 0 {
+				t.Errorf("injectExternalReferences() changed the workload's containers")
+			}
+		})
+	}
+}
+
+func TestInjectTemplatedSecrets(t *testing.T) {
+	secret := func(data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "db"}, Data: data}
+	}
+	testCases := map[string]struct {
+		objs        []runtime.Object
+		wantMissing string
+	}{
+		"Synced": {
+			objs: []runtime.Object{secret(map[string][]byte{"password": []byte("s3cret")})},
+		},
+		"NotSynced": {
+			wantMissing: "missing Secret db",
+		},
+		"MissingKey": {
+			objs:        []runtime.Object{secret(map[string][]byte{"user": []byte("app")})},
+			wantMissing: "missing key password of Secret db",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			_ = corev1.AddToScheme(s)
+			r := &ContainerizedWorkloadReconciler{Client: fake.NewFakeClientWithScheme(s, testCase.objs...)}
+			workload := &oamv1alpha2.ContainerizedWorkload{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "orders"},
+				Spec: oamv1alpha2.ContainerizedWorkloadSpec{Containers: []corev1.Container{{
+					Name: "orders", Image: "orders",
+					Env: []corev1.EnvVar{{Name: "DB_PASSWORD", Value: `{{ secret "db" "password" }}`}},
+				}}},
+			}
+			deploy := &appsv1.Deployment{}
+			missing, err := r.injectExternalReferences(context.Background(), workload, deploy)
+			if err != nil {
+				t.Fatalf("injectExternalReferences() error = %v", err)
+			}
+			if missing != testCase.wantMissing {
+				t.Errorf("injectExternalReferences() missing = %q, want %q", missing, testCase.wantMissing)
+			}
+		})
+	}
+
+	containers, err := renderEnvTemplates(&oamv1alpha2.ContainerizedWorkload{}, []corev1.Container{{
+		Name: "orders", Env: []corev1.EnvVar{{Name: "DB_PASSWORD", Value: `{{ secret "db" "password" }}`}},
+	}})
+	if err != nil {
+		t.Fatalf("renderEnvTemplates() error = %v", err)
+	}
+	want := corev1.EnvVar{Name: "DB_PASSWORD", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "db"}, Key: "password"}}}
+	if got := containers[0].Env[0]; !reflect.DeepEqual(got, want) {
+		t.Errorf("renderEnvTemplates() env = %+v, want %+v", got, want)
+	}
+}
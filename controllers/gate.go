@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// gateReconcile holds back the changes of a workload or trait while its
+// namespace is in a maintenance window, while the traits it depends on are
+// not synced and while the writes of its namespace are throttled. It records
+// the first two on the status and returns false with the result to return if
+// the reconcile must stop. Workloads have no dependencies and pass a nil ref.
+func gateReconcile(ctx context.Context, c client.Client, log logr.Logger, limiter *NamespaceWriteLimiter,
+	kind string, obj trackedOwner, status *cpv1alpha1.ConditionedStatus,
+	ref *oamv1alpha2.ResourceReference) (ctrl.Result, bool, error) {
+	// changes wait for the maintenance windows of the namespace to end
+	end, err := maintenanceWindowEnd(ctx, c, obj.GetNamespace(), time.Now())
+	if err != nil {
+		status.SetConditions(reconcileError(err)...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, false, errors.Wrap(c.Status().Update(ctx, obj),
+			errUpdateStatus)
+	}
+	if !end.IsZero() {
+		log.Info("Deferring changes during a maintenance window", "until", end)
+		status.SetConditions(oamv1alpha2.Deferred(end))
+		return ctrl.Result{RequeueAfter: time.Until(end)}, false, errors.Wrap(c.Status().Update(ctx, obj),
+			errUpdateStatus)
+	}
+	status.SetConditions(oamv1alpha2.NotDeferred())
+
+	// traits are applied after the traits they depend on
+	if ref != nil {
+		pending, err := pendingDependencies(ctx, c, kind, obj, *ref)
+		if err != nil {
+			status.SetConditions(reconcileError(err)...)
+			return ctrl.Result{RequeueAfter: oamReconcileWait}, false, errors.Wrap(c.Status().Update(ctx, obj),
+				errUpdateStatus)
+		}
+		if pending != "" {
+			log.Info("Waiting for the traits the trait depends on", "reason", pending)
+			status.SetConditions(oamv1alpha2.DependenciesPending(pending))
+			return ctrl.Result{RequeueAfter: dependencyWait}, false, errors.Wrap(c.Status().Update(ctx, obj),
+				errUpdateStatus)
+		}
+		status.SetConditions(oamv1alpha2.DependenciesReady())
+	}
+
+	if !limiter.TryAcceptObject(obj) {
+		log.Info("Throttling writes in namespace", "namespace", obj.GetNamespace())
+		return ctrl.Result{RequeueAfter: throttledWait}, false, nil
+	}
+	return ctrl.Result{}, true, nil
+}
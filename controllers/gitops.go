@@ -0,0 +1,95 @@
+package controllers
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Annotations of the GitOps tools children are annotated for.
+const (
+	// argoCDSyncWave orders the sync of the resources of an Argo CD
+	// application. Children are synced in the wave of their workload.
+	argoCDSyncWave = "argocd.argoproj.io/sync-wave"
+	// argoCDCompareOptions of IgnoreExtraneous keep an application in sync
+	// although it does not define the children.
+	argoCDCompareOptions = "argocd.argoproj.io/compare-options"
+	// argoCDSyncOptions of Prune=false keep Argo CD from deleting children
+	// carrying the tracking label of an application.
+	argoCDSyncOptions = "argocd.argoproj.io/sync-options"
+	// fluxPrune of disabled keeps Flux from garbage collecting children
+	// carrying the labels of a kustomization.
+	fluxPrune = "kustomize.toolkit.fluxcd.io/prune"
+)
+
+// GitOps tools children can be annotated for.
+const (
+	GitOpsArgoCD = "argocd"
+	GitOpsFlux   = "flux"
+)
+
+// GitOpsMetadata annotates the children a workload renders so that the GitOps
+// tool managing the workload leaves them to its controller.
+type GitOpsMetadata struct {
+	// ArgoCD children are synced in the wave of their workload, and neither
+	// pruned nor reported as out of sync.
+	ArgoCD bool
+	// Flux children are not pruned.
+	Flux bool
+}
+
+// NewGitOpsMetadata returns the metadata of the comma separated GitOps tools,
+// e.g. argocd,flux, or nil if there are none.
+func NewGitOpsMetadata(tools string) (*GitOpsMetadata, error) {
+	if strings.TrimSpace(tools) == "" {
+		return nil, nil
+	}
+	g := &GitOpsMetadata{}
+	for _, tool := range strings.Split(tools, ",") {
+		switch strings.TrimSpace(tool) {
+		case GitOpsArgoCD:
+			g.ArgoCD = true
+		case GitOpsFlux:
+			g.Flux = true
+		default:
+			return nil, fmt.Errorf("unknown GitOps tool %q", tool)
+		}
+	}
+	return g, nil
+}
+
+// annotate annotates the children of the workload for the GitOps tools. A nil
+// GitOpsMetadata leaves them alone.
+func (g *GitOpsMetadata) annotate(workload metav1.Object, children ...runtime.Object) {
+	if g == nil {
+		return
+	}
+	annotations := map[string]string{}
+	if g.ArgoCD {
+		annotations[argoCDCompareOptions] = "IgnoreExtraneous"
+		annotations[argoCDSyncOptions] = "Prune=false"
+		if wave, ok := workload.GetAnnotations()[argoCDSyncWave]; ok {
+			annotations[argoCDSyncWave] = wave
+		}
+	}
+	if g.Flux {
+		annotations[fluxPrune] = "disabled"
+	}
+	for _, child := range children {
+		m, err := meta.Accessor(child)
+		if err != nil {
+			continue
+		}
+		a := m.GetAnnotations()
+		if a == nil {
+			a = make(map[string]string, len(annotations))
+		}
+		for k, v := range annotations {
+			a[k] = v
+		}
+		m.SetAnnotations(a)
+	}
+}
@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestGitOpsMetadataAnnotate(t *testing.T) {
+	workload := &oamv1alpha2.ContainerizedWorkload{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Annotations: map[string]string{argoCDSyncWave: "2"},
+	}}
+	cases := map[string]struct {
+		tools string
+		want  map[string]string
+	}{
+		"None": {
+			want: map[string]string{"existing": "kept"},
+		},
+		"ArgoCD": {
+			tools: "argocd",
+			want: map[string]string{
+				"existing":           "kept",
+				argoCDSyncWave:       "2",
+				argoCDCompareOptions: "IgnoreExtraneous",
+				argoCDSyncOptions:    "Prune=false",
+			},
+		},
+		"Flux": {
+			tools: "flux",
+			want:  map[string]string{"existing": "kept", fluxPrune: "disabled"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g, err := NewGitOpsMetadata(tc.tools)
+			if err != nil {
+				t.Fatalf("NewGitOpsMetadata(%q) = %v", tc.tools, err)
+			}
+			deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+				Name:        "web-deployment",
+				Annotations: map[string]string{"existing": "kept"},
+			}}
+			g.annotate(workload, deploy)
+			if !reflect.DeepEqual(deploy.Annotations, tc.want) {
+				t.Errorf("annotate() annotations = %v, want %v", deploy.Annotations, tc.want)
+			}
+		})
+	}
+
+	if _, err := NewGitOpsMetadata("argocd,fleet"); err == nil {
+		t.Error("NewGitOpsMetadata(argocd,fleet) = nil, want an error")
+	}
+}
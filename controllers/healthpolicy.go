@@ -0,0 +1,81 @@
+package controllers
+
+import (
+	"fmt"
+	"time"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// defaults of a health policy
+const (
+	defaultRequiredAvailablePercent = 100
+	defaultFailureThreshold         = 1
+)
+
+// checkHealthPolicy checks whether enough of the desired replicas of the
+// deployments are ready, as the api server returned them, and returns the
+// Available condition of the workload along with when to check it again, or 0
+// if the check passed. The rollout and the failures it tracks are recorded in
+// the status of the workload.
+func checkHealthPolicy(workload *oamv1alpha2.ContainerizedWorkload, deploys []*appsv1.Deployment,
+	now time.Time) (cpv1alpha1.Condition, time.Duration) {
+	policy := workload.Spec.HealthPolicy
+	status := &workload.Status
+	if status.RolloutStartedAt == nil || status.RolloutGeneration != workload.Generation {
+		started := metav1.NewTime(now)
+		status.RolloutGeneration, status.RolloutStartedAt = workload.Generation, &started
+	}
+
+	var desired, ready int32
+	for _, deploy := range deploys {
+		replicas := int32(1)
+		if deploy.Spec.Replicas != nil {
+			replicas = *deploy.Spec.Replicas
+		}
+		desired += replicas
+		ready += deploy.Status.ReadyReplicas
+	}
+	percent := int32(defaultRequiredAvailablePercent)
+	if policy.RequiredAvailablePercent != nil {
+		percent = *policy.RequiredAvailablePercent
+	}
+	// round up, so that 50% of 3 replicas requires 2
+	required := (desired*percent + 99) / 100
+	msg := fmt.Sprintf("%d of %d replicas are ready, %d required", ready, desired, required)
+	if ready >= required {
+		status.ConsecutiveFailures = 0
+		return oamv1alpha2.Available(msg), 0
+	}
+
+	if policy.StartupGracePeriod != nil {
+		if end := status.RolloutStartedAt.Add(policy.StartupGracePeriod.Duration); now.Before(end) {
+			status.ConsecutiveFailures = 0
+			return oamv1alpha2.Starting(msg), minDuration(end.Sub(now), oamReconcileWait)
+		}
+	}
+	status.ConsecutiveFailures++
+	threshold := int32(defaultFailureThreshold)
+	if policy.FailureThreshold != nil {
+		threshold = *policy.FailureThreshold
+	}
+	if status.ConsecutiveFailures >= threshold {
+		return oamv1alpha2.Unavailable(msg), oamReconcileWait
+	}
+	// the workload stays as available as it was until the threshold is reached
+	if prev := status.GetCondition(oamv1alpha2.TypeAvailable); prev.Reason != "" {
+		return prev, oamReconcileWait
+	}
+	return oamv1alpha2.Starting(msg), oamReconcileWait
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestCheckHealthPolicy(t *testing.T) {
+	started := time.Date(2020, 4, 1, 9, 0, 0, 0, time.UTC)
+	percent := func(p int32) *int32 { return &p }
+	grace := &metav1.Duration{Duration: 5 * time.Minute}
+	cases := map[string]struct {
+		policy     oamv1alpha2.HealthPolicy
+		generation int64
+		ready      int32
+		failures   int32
+		prev       *cpv1alpha1.Condition
+		now        time.Time
+		status     corev1.ConditionStatus
+		reason     cpv1alpha1.ConditionReason
+		after      time.Duration
+		wantFails  int32
+	}{
+		"AllReady": {
+			ready: 4, failures: 2, now: started.Add(time.Hour),
+			status: corev1.ConditionTrue, reason: oamv1alpha2.ReasonAvailable,
+		},
+		"EnoughReady": {
+			policy: oamv1alpha2.HealthPolicy{RequiredAvailablePercent: percent(75)},
+			ready:  3, now: started.Add(time.Hour),
+			status: corev1.ConditionTrue, reason: oamv1alpha2.ReasonAvailable,
+		},
+		"TooFewReady": {
+			policy: oamv1alpha2.HealthPolicy{RequiredAvailablePercent: percent(75)},
+			ready:  2, now: started.Add(time.Hour),
+			status: corev1.ConditionFalse, reason: oamv1alpha2.ReasonUnavailable, after: oamReconcileWait,
+			wantFails: 1,
+		},
+		"WithinGracePeriod": {
+			policy: oamv1alpha2.HealthPolicy{StartupGracePeriod: grace},
+			ready:  1, failures: 1, now: started.Add(4*time.Minute + 45*time.Second),
+			status: corev1.ConditionUnknown, reason: oamv1alpha2.ReasonStarting, after: 15 * time.Second,
+		},
+		"GracePeriodOfNewGeneration": {
+			policy:     oamv1alpha2.HealthPolicy{StartupGracePeriod: grace},
+			generation: 2, ready: 1, now: started.Add(time.Hour),
+			status: corev1.ConditionUnknown, reason: oamv1alpha2.ReasonStarting, after: oamReconcileWait,
+		},
+		"GracePeriodOver": {
+			policy: oamv1alpha2.HealthPolicy{StartupGracePeriod: grace},
+			ready:  1, now: started.Add(5 * time.Minute),
+			status: corev1.ConditionFalse, reason: oamv1alpha2.ReasonUnavailable, after: oamReconcileWait,
+			wantFails: 1,
+		},
+		"BelowFailureThreshold": {
+			policy: oamv1alpha2.HealthPolicy{FailureThreshold: percent(3)},
+			ready:  1, failures: 1, prev: func() *cpv1alpha1.Condition { c := oamv1alpha2.Available(""); return &c }(),
+			now:    started.Add(time.Hour),
+			status: corev1.ConditionTrue, reason: oamv1alpha2.ReasonAvailable, after: oamReconcileWait,
+			wantFails: 2,
+		},
+		"FailureThresholdReached": {
+			policy: oamv1alpha2.HealthPolicy{FailureThreshold: percent(3)},
+			ready:  1, failures: 2, prev: func() *cpv1alpha1.Condition { c := oamv1alpha2.Available(""); return &c }(),
+			now:    started.Add(time.Hour),
+			status: corev1.ConditionFalse, reason: oamv1alpha2.ReasonUnavailable, after: oamReconcileWait,
+			wantFails: 3,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			policy := tc.policy
+			generation := tc.generation
+			if generation == 0 {
+				generation = 1
+			}
+			at := metav1.NewTime(started)
+			workload := &oamv1alpha2.ContainerizedWorkload{
+				ObjectMeta: metav1.ObjectMeta{Generation: generation},
+				Spec:       oamv1alpha2.ContainerizedWorkloadSpec{HealthPolicy: &policy},
+				Status: oamv1alpha2.ContainerizedWorkloadStatus{
+					RolloutGeneration: 1, RolloutStartedAt: &at, ConsecutiveFailures: tc.failures,
+				},
+			}
+			if tc.prev != nil {
+				workload.Status.SetConditions(*tc.prev)
+			}
+			two := int32(2)
+			deploys := []*appsv1.Deployment{
+				{Spec: appsv1.DeploymentSpec{Replicas: &two}, Status: appsv1.DeploymentStatus{ReadyReplicas: tc.ready / 2}},
+				{Spec: appsv1.DeploymentSpec{Replicas: &two}, Status: appsv1.DeploymentStatus{ReadyReplicas: tc.ready - tc.ready/2}},
+			}
+			c, after := checkHealthPolicy(workload, deploys, tc.now)
+			if c.Type != oamv1alpha2.TypeAvailable || c.Status != tc.status || c.Reason != tc.reason {
+				t.Errorf("checkHealthPolicy() = %s %s %q, want %s %q", c.Type, c.Status, c.Reason, tc.status, tc.reason)
+			}
+			if after != tc.after {
+				t.Errorf("checkHealthPolicy() checks again after %v, want %v", after, tc.after)
+			}
+			if workload.Status.ConsecutiveFailures != tc.wantFails {
+				t.Errorf("ConsecutiveFailures = %d, want %d", workload.Status.ConsecutiveFailures, tc.wantFails)
+			}
+			if workload.Status.RolloutGeneration != generation {
+				t.Errorf("RolloutGeneration = %d, want %d", workload.Status.RolloutGeneration, generation)
+			}
+		})
+	}
+}
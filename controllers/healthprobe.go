@@ -0,0 +1,87 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// defaults of a health probe
+const (
+	defaultProbePath    = "/"
+	defaultProbeStatus  = http.StatusOK
+	defaultProbeTimeout = time.Second
+)
+
+// failingEndpoints sends the workload's health probe to each of its services
+// and describes those that do not answer with the expected status in time, or
+// returns "" if they all do.
+func (r *ContainerizedWorkloadReconciler) failingEndpoints(ctx context.Context,
+	probe *oamv1alpha2.HTTPHealthProbe, services []*corev1.Service) string {
+	c := r.HTTPClient
+	if c == nil {
+		c = http.DefaultClient
+	}
+	var failing []string
+	for _, svc := range services {
+		if msg := probeEndpoint(ctx, c, probe, serviceURL(svc, probe)); msg != "" {
+			failing = append(failing, fmt.Sprintf("service %s: %s", svc.Name, msg))
+		}
+	}
+	return strings.Join(failing, "; ")
+}
+
+// the URL of the probe of the service, by its cluster DNS name
+func serviceURL(svc *corev1.Service, probe *oamv1alpha2.HTTPHealthProbe) string {
+	port := defaultServicePort
+	if probe.Port != nil {
+		port = *probe.Port
+	}
+	path := probe.Path
+	if path == "" {
+		path = defaultProbePath
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return fmt.Sprintf("http://%s.%s.svc:%d%s", svc.Name, svc.Namespace, port, path)
+}
+
+// probeEndpoint sends the probe to the URL and describes how it failed, or
+// returns "" if the response has the expected status.
+func probeEndpoint(ctx context.Context, c *http.Client, probe *oamv1alpha2.HTTPHealthProbe, url string) string {
+	timeout := defaultProbeTimeout
+	if probe.TimeoutSeconds != nil {
+		timeout = time.Duration(*probe.TimeoutSeconds) * time.Second
+	}
+	want := defaultProbeStatus
+	if probe.ExpectedStatus != nil {
+		want = int(*probe.ExpectedStatus)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err.Error()
+	}
+	resp, err := c.Do(req.WithContext(ctx))
+	if err != nil {
+		return err.Error()
+	}
+	// drain the body so that the connection is reused
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 4096))
+	_ = resp.Body.Close()
+	if resp.StatusCode != want {
+		return fmt.Sprintf("GET %s returned %d, want %d", url, resp.StatusCode, want)
+	}
+	return ""
+}
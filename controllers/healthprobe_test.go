@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestProbeEndpoint(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/healthz":
+			w.WriteHeader(http.StatusOK)
+		case "/slow":
+			time.Sleep(1500 * time.Millisecond)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	unavailable := int32(http.StatusServiceUnavailable)
+	testCases := map[string]struct {
+		path  string
+		probe oamv1alpha2.HTTPHealthProbe
+		want  string
+	}{
+		"Healthy": {
+			path: "/healthz",
+		},
+		"UnexpectedStatus": {
+			path: "/broken",
+			want: "returned 503, want 200",
+		},
+		"ExpectedStatus": {
+			path:  "/broken",
+			probe: oamv1alpha2.HTTPHealthProbe{ExpectedStatus: &unavailable},
+		},
+		"Timeout": {
+			path: "/slow",
+			want: "deadline exceeded",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := probeEndpoint(context.Background(), srv.Client(), &tc.probe, srv.URL+tc.path)
+			if tc.want == "" && got != "" || !strings.Contains(got, tc.want) {
+				t.Errorf("probeEndpoint() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestServiceURL(t *testing.T) {
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "web-service", Namespace: "apps"}}
+	port := int32(9090)
+	if got, want := serviceURL(svc, &oamv1alpha2.HTTPHealthProbe{}), "http://web-service.apps.svc:8080/"; got != want {
+		t.Errorf("serviceURL() = %q, want %q", got, want)
+	}
+	probe := &oamv1alpha2.HTTPHealthProbe{Path: "healthz", Port: &port}
+	if got, want := serviceURL(svc, probe), "http://web-service.apps.svc:9090/healthz"; got != want {
+		t.Errorf("serviceURL() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,227 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/debug"
+	"github.com/oam-dev/core-resource-controller/pkg/helm"
+)
+
+// Reconcile error strings.
+const (
+	errRenderHelmChart  = "cannot render the helm chart"
+	errApplyHelmChart   = "cannot apply a manifest of the helm chart"
+	errClusterScopedRes = "a manifest of the helm chart is cluster scoped"
+)
+
+// helmRenderTimeout bounds the time helm may take to download and render a
+// chart.
+const helmRenderTimeout = 2 * time.Minute
+
+// HelmChartTraitReconciler reconciles a HelmChartTrait object
+type HelmChartTraitReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// Charts renders the chart of a trait.
+	Charts helm.Renderer
+	// Mapper, if set, tells the cluster scoped kinds a chart must not render
+	// apart.
+	Mapper meta.RESTMapper
+	// Limiter, if set, rate limits the writes of child resources per namespace.
+	Limiter *NamespaceWriteLimiter
+	// Debug, if set, records the outcome of each reconcile.
+	Debug *debug.Recorder
+	// Workloads, if set, resolves the workload the trait refers to, see
+	// WorkloadResolver.
+	Workloads *WorkloadResolver
+}
+
+// +kubebuilder:rbac:groups=core.oam.dev,resources=helmcharttraits,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=core.oam.dev,resources=helmcharttraits/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core.oam.dev,resources=resourcetrackers,verbs=get;list;watch;create;update;delete
+
+func (r *HelmChartTraitReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("helmchart trait", req.NamespacedName)
+	log.Info("Reconcile helm chart trait")
+
+	var trait oamv1alpha2.HelmChartTrait
+	if err := r.Get(ctx, req.NamespacedName, &trait); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// deleting the trait deletes the manifests of its chart
+	deleted, err := finalizeTrackedResources(ctx, r, &trait)
+	if err != nil {
+		log.Error(err, "Failed to finalize the tracked resources")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, err
+	}
+	if deleted {
+		return ctrl.Result{}, nil
+	}
+
+	deploy, err := r.Workloads.fetchWorkloadDeployment(ctx, r, log, req.Namespace, trait.Spec.WorkloadReference)
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+
+	if result, ok, err := gateReconcile(ctx, r, log, r.Limiter, kindHelmChartTrait, &trait, &trait.Status.ConditionedStatus,
+		&trait.Spec.WorkloadReference); !ok {
+		return result, err
+	}
+
+	children, err := r.render(ctx, &trait, deploy)
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(errors.Wrap(err, errRenderHelmChart))...)
+		log.Error(err, "Failed to render a helm chart")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	objs := make([]runtime.Object, 0, len(children))
+	for _, child := range children {
+		objs = append(objs, child)
+	}
+
+	// server side apply, only the fields we set are touched
+	applyOpts := []client.PatchOption{client.ForceOwnership, client.FieldOwner(trait.Name)}
+	resources := make([]oamv1alpha2.ResourceReference, 0, len(children))
+	for _, child := range children {
+		err := retryTransient(applyBackoff, nil, func() error {
+			return applyChild(ctx, r, r.Scheme, child, applyOpts...)
+		})
+		if err != nil {
+			trait.Status.SetConditions(reconcileError(errors.Wrapf(err, "%s: %s %s", errApplyHelmChart,
+				child.GetKind(), child.GetName()))...)
+			log.Error(err, "Failed to apply a manifest of a helm chart", "kind", child.GetKind(),
+				"name", child.GetName())
+			return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+				errUpdateStatus)
+		}
+		uid := child.GetUID()
+		resources = append(resources, oamv1alpha2.ResourceReference{
+			APIVersion: child.GetAPIVersion(),
+			Kind:       child.GetKind(),
+			Name:       child.GetName(),
+			UID:        &uid,
+		})
+	}
+	log.Info("Successfully applied a helm chart", "count", len(children))
+
+	// the manifests the chart no longer renders, e.g. after an upgrade, are
+	// deleted
+	if err := releaseStaleResources(ctx, r, r.Scheme, &trait, objs...); err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Failed to delete the resources no longer rendered")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	if err := trackResources(ctx, r, r.Scheme, &trait, objs...); err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Failed to track resources")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+
+	trait.Status.Resources = resources
+	r.Limiter.MarkForceSynced(&trait)
+	trait.Status.SetConditions(oamv1alpha2.PermissionGranted(), cpv1alpha1.ReconcileSuccess())
+	return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &trait), errUpdateStatus)
+}
+
+// render the manifests of the trait's chart with its values, in the target
+// namespace. They carry no owner reference, as the target namespace may not
+// be the trait's, and are deleted along with the trait through its tracker.
+func (r *HelmChartTraitReconciler) render(ctx context.Context, trait *oamv1alpha2.HelmChartTrait,
+	deploy *appsv1.Deployment) ([]*unstructured.Unstructured, error) {
+	values := map[string]interface{}{}
+	if trait.Spec.Values != nil && len(trait.Spec.Values.Raw) > 0 {
+		if err := json.Unmarshal(trait.Spec.Values.Raw, &values); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := values["oam"]; !ok {
+		values["oam"] = map[string]interface{}{
+			"workload":   trait.Spec.WorkloadReference.Name,
+			"deployment": deploy.Name,
+		}
+	}
+	targetNamespace := trait.Spec.TargetNamespace
+	if targetNamespace == "" {
+		targetNamespace = trait.Namespace
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, helmRenderTimeout)
+	defer cancel()
+	objs, err := r.Charts.Render(ctx, helm.Chart{
+		Release:   trait.Name,
+		Chart:     trait.Spec.Chart,
+		Repo:      trait.Spec.Repo,
+		Version:   trait.Spec.Version,
+		Namespace: targetNamespace,
+		Values:    values,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		if r.clusterScoped(obj) {
+			return nil, errors.Errorf("%s: %s %s", errClusterScopedRes, obj.GetKind(), obj.GetName())
+		}
+		switch obj.GetNamespace() {
+		case "":
+			obj.SetNamespace(targetNamespace)
+		case targetNamespace:
+		default:
+			return nil, errors.Errorf("%s: %s %s", errForeignNamespace, obj.GetKind(), obj.GetName())
+		}
+	}
+	return objs, nil
+}
+
+// a trait's chart may not change the cluster as a whole, e.g. bind cluster
+// roles
+func (r *HelmChartTraitReconciler) clusterScoped(obj *unstructured.Unstructured) bool {
+	if r.Mapper == nil {
+		return false
+	}
+	gvk := obj.GroupVersionKind()
+	mapping, err := r.Mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	return err == nil && mapping.Scope.Name() == meta.RESTScopeNameRoot
+}
+
+func (r *HelmChartTraitReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&oamv1alpha2.HelmChartTrait{}).
+		Complete(r.Debug.Wrap("HelmChartTrait", r))
+}
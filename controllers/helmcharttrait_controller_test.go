@@ -0,0 +1,139 @@
+package controllers
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/helm"
+)
+
+// fakeCharts records the chart it renders and returns copies of its objs
+type fakeCharts struct {
+	chart helm.Chart
+	objs  []*unstructured.Unstructured
+}
+
+func (f *fakeCharts) Render(_ context.Context, c helm.Chart) ([]*unstructured.Unstructured, error) {
+	f.chart = c
+	objs := make([]*unstructured.Unstructured, 0, len(f.objs))
+	for _, obj := range f.objs {
+		objs = append(objs, obj.DeepCopy())
+	}
+	return objs, nil
+}
+
+func TestHelmChartTraitReconciler_render(t *testing.T) {
+	trait := func(values, targetNamespace string) *oamv1alpha2.HelmChartTrait {
+		tr := &oamv1alpha2.HelmChartTrait{
+			ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default", UID: "trait-uid"},
+			Spec: oamv1alpha2.HelmChartTraitSpec{
+				Chart:             "redis",
+				Repo:              "https://charts.bitnami.com/bitnami",
+				Version:           "10.5.7",
+				TargetNamespace:   targetNamespace,
+				WorkloadReference: oamv1alpha2.ResourceReference{Name: "web"},
+			},
+		}
+		if values != "" {
+			tr.Spec.Values = &runtime.RawExtension{Raw: []byte(values)}
+		}
+		return tr
+	}
+	manifest := func(kind, namespace, name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("v1")
+		if kind == "ClusterRole" {
+			obj.SetAPIVersion("rbac.authorization.k8s.io/v1")
+		}
+		obj.SetKind(kind)
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		return obj
+	}
+	deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web-deployment", Namespace: "default"}}
+	oam := map[string]interface{}{"workload": "web", "deployment": "web-deployment"}
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"},
+		meta.RESTScopeRoot)
+
+	testCases := map[string]struct {
+		trait      *oamv1alpha2.HelmChartTrait
+		manifests  []*unstructured.Unstructured
+		wantValues map[string]interface{}
+		wantNS     string
+		wantErr    bool
+	}{
+		"NoValues": {
+			trait:      trait("", ""),
+			manifests:  []*unstructured.Unstructured{manifest("ConfigMap", "", "cache-redis")},
+			wantValues: map[string]interface{}{"oam": oam},
+			wantNS:     "default",
+		},
+		"Values": {
+			trait:     trait(`{"cluster": {"enabled": false}}`, ""),
+			manifests: []*unstructured.Unstructured{manifest("ConfigMap", "default", "cache-redis")},
+			wantValues: map[string]interface{}{"cluster": map[string]interface{}{"enabled": false},
+				"oam": oam},
+			wantNS: "default",
+		},
+		"OAMValues": {
+			trait:      trait(`{"oam": "mine"}`, ""),
+			manifests:  []*unstructured.Unstructured{manifest("ConfigMap", "", "cache-redis")},
+			wantValues: map[string]interface{}{"oam": "mine"},
+			wantNS:     "default",
+		},
+		"TargetNamespace": {
+			trait:      trait("", "cache"),
+			manifests:  []*unstructured.Unstructured{manifest("ConfigMap", "", "cache-redis")},
+			wantValues: map[string]interface{}{"oam": oam},
+			wantNS:     "cache",
+		},
+		"ForeignNamespace": {
+			trait:     trait("", ""),
+			manifests: []*unstructured.Unstructured{manifest("ConfigMap", "kube-system", "cache-redis")},
+			wantErr:   true,
+		},
+		"ClusterScoped": {
+			trait:     trait("", ""),
+			manifests: []*unstructured.Unstructured{manifest("ClusterRole", "", "cache-redis")},
+			wantErr:   true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			charts := &fakeCharts{objs: tc.manifests}
+			r := HelmChartTraitReconciler{Scheme: testScheme, Charts: charts, Mapper: mapper}
+			got, err := r.render(context.Background(), tc.trait, deploy)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("render() = %v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("render() error = %v", err)
+			}
+			want := helm.Chart{Release: "cache", Chart: "redis", Repo: "https://charts.bitnami.com/bitnami",
+				Version: "10.5.7", Namespace: tc.wantNS, Values: tc.wantValues}
+			if !reflect.DeepEqual(charts.chart, want) {
+				t.Errorf("render() rendered %+v, want %+v", charts.chart, want)
+			}
+			if len(got) != 1 || got[0].GetNamespace() != tc.wantNS {
+				t.Errorf("render() = %v, want the manifest in namespace %s", got, tc.wantNS)
+			}
+			if refs := got[0].GetOwnerReferences(); len(refs) != 0 {
+				t.Errorf("render() owner references = %v, want none", refs)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/debug"
+)
+
+// Reconcile error strings.
+const (
+	errGetServiceAccount   = "cannot get the service account"
+	errApplyServiceAccount = "cannot apply the service account"
+	errIdentityDeployment  = "cannot set the identity of the deployment"
+)
+
+// name of the volume holding the tokens of an IdentityTrait
+const identityTokenVolumeName = "oam-identity-tokens"
+
+// IdentityTraitReconciler reconciles an IdentityTrait object
+type IdentityTraitReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// Limiter, if set, rate limits the writes of child resources per namespace.
+	Limiter *NamespaceWriteLimiter
+	// Debug, if set, records the outcome of each reconcile.
+	Debug *debug.Recorder
+	// Workloads, if set, resolves the workload the trait refers to, see
+	// WorkloadResolver.
+	Workloads *WorkloadResolver
+}
+
+// +kubebuilder:rbac:groups=core.oam.dev,resources=identitytraits,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=core.oam.dev,resources=identitytraits/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=core.oam.dev,resources=resourcetrackers,verbs=get;list;watch;create;update;delete
+
+func (r *IdentityTraitReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("identity trait", req.NamespacedName)
+	log.Info("Reconcile identity trait")
+
+	var trait oamv1alpha2.IdentityTrait
+	if err := r.Get(ctx, req.NamespacedName, &trait); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// revert the service account of the deployment before deleting it
+	reverted, err := r.Workloads.finalizeManagedFields(ctx, r, log,
+		&trait, kindIdentityTrait, trait.Spec.WorkloadReference)
+	if err != nil {
+		log.Error(err, "Failed to revert the fields set by the trait")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, err
+	}
+	deleted, err := finalizeTrackedResources(ctx, r, &trait)
+	if err != nil {
+		log.Error(err, "Failed to finalize the tracked resources")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, err
+	}
+	if reverted || deleted {
+		return ctrl.Result{}, nil
+	}
+
+	deploy, err := r.Workloads.fetchWorkloadDeployment(ctx, r, log, req.Namespace, trait.Spec.WorkloadReference)
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+
+	if result, ok, err := gateReconcile(ctx, r, log, r.Limiter, kindIdentityTrait, &trait, &trait.Status.ConditionedStatus,
+		&trait.Spec.WorkloadReference); !ok {
+		return result, err
+	}
+
+	if err := r.applyServiceAccount(ctx, &trait); err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Failed to apply the service account")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+
+	// set the identity, refetching the deployment if it changed under us
+	err = retryTransient(applyBackoff, func() error {
+		return r.Get(ctx, client.ObjectKey{Name: deploy.Name, Namespace: deploy.Namespace}, deploy)
+	}, func() error {
+		id := identityDeployment(&trait, deploy)
+		if err := recordManagedFields(deploy, id, managedFieldsKey(kindIdentityTrait, trait.Name)); err != nil {
+			return err
+		}
+		return r.Patch(ctx, id, client.MergeFrom(deploy))
+	})
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(errors.Wrap(err, errIdentityDeployment))...)
+		log.Error(err, "Failed to set the identity of a deployment")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	log.Info("Successfully set the identity of a deployment", "UID", deploy.UID)
+
+	r.Limiter.MarkForceSynced(&trait)
+	trait.Status.SetConditions(oamv1alpha2.PermissionGranted(), cpv1alpha1.ReconcileSuccess())
+	return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &trait), errUpdateStatus)
+}
+
+// applyServiceAccount creates the service account of the trait unless it
+// exists, or updates it if the trait created it, and records it in the
+// trait's status. Service accounts the trait did not create are left alone.
+func (r *IdentityTraitReconciler) applyServiceAccount(ctx context.Context, trait *oamv1alpha2.IdentityTrait) error {
+	existing := &corev1.ServiceAccount{}
+	err := r.Get(ctx, client.ObjectKey{Namespace: trait.Namespace, Name: trait.Spec.ServiceAccountName}, existing)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrap(err, errGetServiceAccount)
+	}
+	if err == nil && !createdResource(trait.Status.Resources, existing.UID) {
+		trait.Status.Resources = nil
+		return trackResources(ctx, r, r.Scheme, trait)
+	}
+
+	sa := &corev1.ServiceAccount{}
+	sa.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("ServiceAccount"))
+	sa.SetNamespace(trait.Namespace)
+	sa.SetName(trait.Spec.ServiceAccountName)
+	sa.SetAnnotations(trait.Spec.ServiceAccountAnnotations)
+	if err := ctrl.SetControllerReference(trait, sa, r.Scheme); err != nil {
+		return errors.Wrap(err, errApplyServiceAccount)
+	}
+	// server side apply, only the fields we set are touched
+	applyOpts := []client.PatchOption{client.ForceOwnership, client.FieldOwner(trait.Name)}
+	if err := r.Patch(ctx, sa, client.Apply, applyOpts...); err != nil {
+		return errors.Wrap(err, errApplyServiceAccount)
+	}
+	if err := trackResources(ctx, r, r.Scheme, trait, sa); err != nil {
+		return err
+	}
+	uid := sa.UID
+	trait.Status.Resources = []oamv1alpha2.ResourceReference{{
+		APIVersion: corev1.SchemeGroupVersion.String(),
+		Kind:       "ServiceAccount",
+		Name:       sa.Name,
+		UID:        &uid,
+	}}
+	return nil
+}
+
+// createdResource tells whether the resources list the UID
+func createdResource(resources []oamv1alpha2.ResourceReference, uid types.UID) bool {
+	for _, res := range resources {
+		if res.UID != nil && *res.UID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// identityDeployment returns a copy of the deployment whose pods run as the
+// trait's service account, with its tokens mounted in every container
+func identityDeployment(trait *oamv1alpha2.IdentityTrait, deploy *appsv1.Deployment) *appsv1.Deployment {
+	id := deploy.DeepCopy()
+	spec := &id.Spec.Template.Spec
+	spec.ServiceAccountName = trait.Spec.ServiceAccountName
+	if len(trait.Spec.Tokens) > 0 {
+		sources := make([]corev1.VolumeProjection, 0, len(trait.Spec.Tokens))
+		for _, t := range trait.Spec.Tokens {
+			sources = append(sources, corev1.VolumeProjection{ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+				Audience:          t.Audience,
+				ExpirationSeconds: t.ExpirationSeconds,
+				Path:              t.Path,
+			}})
+		}
+		volume := corev1.Volume{
+			Name:         identityTokenVolumeName,
+			VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: sources}},
+		}
+		spec.Volumes = replaceVolume(spec.Volumes, volume)
+		mount := corev1.VolumeMount{
+			Name:      identityTokenVolumeName,
+			MountPath: oamv1alpha2.IdentityTokenMountPath,
+			ReadOnly:  true,
+		}
+		for i := range spec.Containers {
+			spec.Containers[i].VolumeMounts = replaceVolumeMount(spec.Containers[i].VolumeMounts, mount)
+		}
+	}
+	// always set the owner reference so that we can watch this deployment
+	setTraitOwnerReference(id, trait.APIVersion, trait.Kind, trait)
+	return id
+}
+
+func replaceVolume(volumes []corev1.Volume, v corev1.Volume) []corev1.Volume {
+	for i := range volumes {
+		if volumes[i].Name == v.Name {
+			volumes[i] = v
+			return volumes
+		}
+	}
+	return append(volumes, v)
+}
+
+func replaceVolumeMount(mounts []corev1.VolumeMount, m corev1.VolumeMount) []corev1.VolumeMount {
+	for i := range mounts {
+		if mounts[i].Name == m.Name {
+			mounts[i] = m
+			return mounts
+		}
+	}
+	return append(mounts, m)
+}
+
+func (r *IdentityTraitReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&oamv1alpha2.IdentityTrait{}).
+		Owns(&corev1.ServiceAccount{}).
+		Watches(&source.Kind{
+			Type: &appsv1.Deployment{},
+		}, &handler.EnqueueRequestForOwner{
+			OwnerType:    &oamv1alpha2.IdentityTrait{},
+			IsController: false, // other traits may own the deployment too
+		}).
+		Complete(r.Debug.Wrap("IdentityTrait", r))
+}
@@ -0,0 +1,99 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/simtest"
+)
+
+func TestIdentityTraitReconcile(t *testing.T) {
+	ctx := context.Background()
+	const annotation = "iam.gke.io/gcp-service-account"
+	workload := &oamv1alpha2.ContainerizedWorkload{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-deployment", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "web"}},
+		}}},
+	}
+	trait := &oamv1alpha2.IdentityTrait{
+		ObjectMeta: metav1.ObjectMeta{Name: "identity", Namespace: "default"},
+		Spec: oamv1alpha2.IdentityTraitSpec{
+			ServiceAccountName:        "web",
+			ServiceAccountAnnotations: map[string]string{annotation: "web@project.iam.gserviceaccount.com"},
+			Tokens:                    []oamv1alpha2.IdentityToken{{Audience: "vault", Path: "vault-token"}},
+		},
+	}
+	h, err := simtest.New(workload, []runtime.Object{deploy})
+	if err != nil {
+		t.Fatal(err)
+	}
+	trait.Spec.WorkloadReference = h.WorkloadReference()
+	if err := h.Create(ctx, trait); err != nil {
+		t.Fatal(err)
+	}
+
+	r := &IdentityTraitReconciler{Client: h, Log: ctrl.Log, Scheme: h.Scheme}
+	if _, err := h.Reconcile(r, trait); err != nil {
+		t.Fatalf("Reconcile() = %v", err)
+	}
+	h.AssertPatched(t, deploy, "spec.template.spec.serviceAccountName", "web")
+
+	var sa corev1.ServiceAccount
+	if err := h.Get(ctx, client.ObjectKey{Namespace: "default", Name: "web"}, &sa); err != nil {
+		t.Fatalf("Reconcile() did not create the service account: %v", err)
+	}
+	if sa.Annotations[annotation] == "" {
+		t.Errorf("Reconcile() service account annotations = %v", sa.Annotations)
+	}
+	var got appsv1.Deployment
+	if err := h.Get(ctx, client.ObjectKey{Namespace: "default", Name: "web-deployment"}, &got); err != nil {
+		t.Fatal(err)
+	}
+	spec := got.Spec.Template.Spec
+	if len(spec.Volumes) != 1 || spec.Volumes[0].Projected == nil ||
+		spec.Volumes[0].Projected.Sources[0].ServiceAccountToken.Audience != "vault" {
+		t.Errorf("Reconcile() volumes = %+v, want a projected vault token", spec.Volumes)
+	}
+	if m := spec.Containers[0].VolumeMounts; len(m) != 1 || m[0].MountPath != oamv1alpha2.IdentityTokenMountPath {
+		t.Errorf("Reconcile() volume mounts = %+v", m)
+	}
+}
+
+func TestIdentityTraitKeepsExistingServiceAccount(t *testing.T) {
+	ctx := context.Background()
+	workload := &oamv1alpha2.ContainerizedWorkload{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web-deployment", Namespace: "default"}}
+	existing := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: "default", UID: "sa-uid"}}
+	trait := &oamv1alpha2.IdentityTrait{
+		ObjectMeta: metav1.ObjectMeta{Name: "identity", Namespace: "default"},
+		Spec: oamv1alpha2.IdentityTraitSpec{
+			ServiceAccountName:        "shared",
+			ServiceAccountAnnotations: map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::1:role/web"},
+		},
+	}
+	h, err := simtest.New(workload, []runtime.Object{deploy}, existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trait.Spec.WorkloadReference = h.WorkloadReference()
+	if err := h.Create(ctx, trait); err != nil {
+		t.Fatal(err)
+	}
+
+	r := &IdentityTraitReconciler{Client: h, Log: ctrl.Log, Scheme: h.Scheme}
+	if _, err := h.Reconcile(r, trait); err != nil {
+		t.Fatalf("Reconcile() = %v", err)
+	}
+	h.AssertPatched(t, deploy, "spec.template.spec.serviceAccountName", "shared")
+	h.AssertNotPatched(t, existing)
+}
@@ -0,0 +1,166 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strings"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/debug"
+)
+
+// Reconcile error strings.
+const (
+	errAddInitContainers = "cannot add the init containers to the deployment"
+	errWindowsInit       = "windows containers do not support the security context of the init container"
+)
+
+// InitTraitReconciler reconciles an InitTrait object
+type InitTraitReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// Limiter, if set, rate limits the writes of child resources per namespace.
+	Limiter *NamespaceWriteLimiter
+	// Debug, if set, records the outcome of each reconcile.
+	Debug *debug.Recorder
+	// Workloads, if set, resolves the workload the trait refers to, see
+	// WorkloadResolver.
+	Workloads *WorkloadResolver
+}
+
+// +kubebuilder:rbac:groups=core.oam.dev,resources=inittraits,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=core.oam.dev,resources=inittraits/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
+
+func (r *InitTraitReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("init trait", req.NamespacedName)
+	log.Info("Reconcile init trait")
+
+	var trait oamv1alpha2.InitTrait
+	if err := r.Get(ctx, req.NamespacedName, &trait); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	deleted, err := r.Workloads.finalizeManagedFields(ctx, r, log, &trait, kindInitTrait, trait.Spec.WorkloadReference)
+	if err != nil {
+		log.Error(err, "Failed to revert the fields set by the trait")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, err
+	}
+	if deleted {
+		return ctrl.Result{}, nil
+	}
+
+	deploy, err := r.Workloads.fetchWorkloadDeployment(ctx, r, log, req.Namespace, trait.Spec.WorkloadReference)
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	if runsOnWindows(deploy) {
+		if err := windowsInitContainers(&trait); err != nil {
+			trait.Status.SetConditions(reconcileError(err)...)
+			return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+				errUpdateStatus)
+		}
+	}
+
+	if result, ok, err := gateReconcile(ctx, r, log, r.Limiter, kindInitTrait, &trait, &trait.Status.ConditionedStatus,
+		&trait.Spec.WorkloadReference); !ok {
+		return result, err
+	}
+
+	// merge the init containers, refetching the deployment if it changed under us
+	err = retryTransient(applyBackoff, func() error {
+		return r.Get(ctx, client.ObjectKey{Name: deploy.Name, Namespace: deploy.Namespace}, deploy)
+	}, func() error {
+		id := initializedDeployment(&trait, deploy)
+		if err := recordManagedFields(deploy, id, managedFieldsKey(kindInitTrait, trait.Name)); err != nil {
+			return err
+		}
+		return r.Patch(ctx, id, client.MergeFrom(deploy))
+	})
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(errors.Wrap(err, errAddInitContainers))...)
+		log.Error(err, "Failed to add init containers to a deployment")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	log.Info("Successfully added init containers to a deployment", "UID", deploy.UID)
+
+	r.Limiter.MarkForceSynced(&trait)
+	trait.Status.SetConditions(oamv1alpha2.PermissionGranted(), cpv1alpha1.ReconcileSuccess())
+	return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &trait), errUpdateStatus)
+}
+
+// windowsInitContainers returns an error naming the first init container of
+// the trait that sets linux security settings, which windows containers do
+// not support.
+func windowsInitContainers(trait *oamv1alpha2.InitTrait) error {
+	for _, c := range trait.Spec.InitContainers {
+		if fields := oamv1alpha2.LinuxSecurityContextFields(c.SecurityContext); len(fields) > 0 {
+			return errors.Errorf("%s %s: %s", errWindowsInit, c.Name, strings.Join(fields, ", "))
+		}
+	}
+	return nil
+}
+
+// initializedDeployment returns a copy of the deployment with the trait's
+// init containers added to its pod template
+func initializedDeployment(trait *oamv1alpha2.InitTrait, deploy *appsv1.Deployment) *appsv1.Deployment {
+	id := deploy.DeepCopy()
+	spec := &id.Spec.Template.Spec
+	for _, c := range trait.Spec.InitContainers {
+		found := false
+		for i := range spec.InitContainers {
+			if spec.InitContainers[i].Name == c.Name {
+				spec.InitContainers[i] = c
+				found = true
+				break
+			}
+		}
+		if !found {
+			spec.InitContainers = append(spec.InitContainers, c)
+		}
+	}
+	// always set the owner reference so that we can watch this deployment
+	setTraitOwnerReference(id, trait.APIVersion, trait.Kind, trait)
+	return id
+}
+
+func (r *InitTraitReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&oamv1alpha2.InitTrait{}).
+		Watches(&source.Kind{
+			Type: &appsv1.Deployment{},
+		}, &handler.EnqueueRequestForOwner{
+			OwnerType:    &oamv1alpha2.InitTrait{},
+			IsController: false, // other traits may own the deployment too
+		}).
+		Complete(r.Debug.Wrap("InitTrait", r))
+}
@@ -0,0 +1,45 @@
+package controllers
+
+import (
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestInitializedDeployment(t *testing.T) {
+	trait := &oamv1alpha2.InitTrait{
+		TypeMeta:   metav1.TypeMeta{APIVersion: oamv1alpha2.GroupVersion.String(), Kind: "InitTrait"},
+		ObjectMeta: metav1.ObjectMeta{Name: "init", UID: "trait-uid"},
+		Spec: oamv1alpha2.InitTraitSpec{InitContainers: []corev1.Container{
+			{Name: "migrate", Image: "migrate:v2"},
+			{Name: "fetch-config", Image: "curl"},
+		}},
+	}
+	deploy := &appsv1.Deployment{Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{InitContainers: []corev1.Container{
+			{Name: "wait-for-db", Image: "busybox"},
+			{Name: "migrate", Image: "migrate:v1"},
+		}},
+	}}}
+
+	got := initializedDeployment(trait, deploy)
+	want := []corev1.Container{
+		{Name: "wait-for-db", Image: "busybox"},
+		{Name: "migrate", Image: "migrate:v2"},
+		{Name: "fetch-config", Image: "curl"},
+	}
+	if !reflect.DeepEqual(got.Spec.Template.Spec.InitContainers, want) {
+		t.Errorf("initializedDeployment() init containers = %v, want %v", got.Spec.Template.Spec.InitContainers, want)
+	}
+	if refs := got.GetOwnerReferences(); len(refs) != 1 || refs[0].UID != trait.UID {
+		t.Errorf("initializedDeployment() owner references = %v", refs)
+	}
+	if deploy.Spec.Template.Spec.InitContainers[1].Image != "migrate:v1" {
+		t.Errorf("initializedDeployment() modified the original deployment")
+	}
+}
@@ -0,0 +1,196 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/debug"
+)
+
+// Reconcile error strings.
+const (
+	errRenderScaledObject = "cannot render the scaled object"
+	errApplyScaledObject  = "cannot apply the scaled object"
+)
+
+// ScaledObjectGroupVersionKind is the KEDA kind rendered by KEDAScalerTraits.
+var ScaledObjectGroupVersionKind = schema.GroupVersionKind{
+	Group:   "keda.sh",
+	Version: "v1alpha1",
+	Kind:    "ScaledObject",
+}
+
+// KEDAScalerTraitReconciler reconciles a KEDAScalerTrait object
+type KEDAScalerTraitReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// Limiter, if set, rate limits the writes of child resources per namespace.
+	Limiter *NamespaceWriteLimiter
+	// Debug, if set, records the outcome of each reconcile.
+	Debug *debug.Recorder
+	// Workloads, if set, resolves the workload the trait refers to, see
+	// WorkloadResolver.
+	Workloads *WorkloadResolver
+}
+
+// +kubebuilder:rbac:groups=core.oam.dev,resources=kedascalertraits,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=core.oam.dev,resources=kedascalertraits/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=keda.sh,resources=scaledobjects,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core.oam.dev,resources=resourcetrackers,verbs=get;list;watch;create;update;delete
+
+func (r *KEDAScalerTraitReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("kedascaler trait", req.NamespacedName)
+	log.Info("Reconcile KEDA scaler trait")
+
+	var trait oamv1alpha2.KEDAScalerTrait
+	if err := r.Get(ctx, req.NamespacedName, &trait); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	deleted, err := finalizeTrackedResources(ctx, r, &trait)
+	if err != nil {
+		log.Error(err, "Failed to finalize the tracked resources")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, err
+	}
+	if deleted {
+		return ctrl.Result{}, nil
+	}
+
+	deploy, err := r.Workloads.fetchWorkloadDeployment(ctx, r, log, req.Namespace, trait.Spec.WorkloadReference)
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+
+	if result, ok, err := gateReconcile(ctx, r, log, r.Limiter, kindKEDAScalerTrait, &trait, &trait.Status.ConditionedStatus,
+		&trait.Spec.WorkloadReference); !ok {
+		return result, err
+	}
+
+	so, err := r.renderScaledObject(&trait, deploy)
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(errors.Wrap(err, errRenderScaledObject))...)
+		log.Error(err, "Failed to render a scaled object")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+
+	// server side apply, only the fields we set are touched
+	applyOpts := []client.PatchOption{client.ForceOwnership, client.FieldOwner(trait.Name)}
+	err = retryTransient(applyBackoff, nil, func() error {
+		return r.Patch(ctx, so, client.Apply, applyOpts...)
+	})
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(errors.Wrap(err, errApplyScaledObject))...)
+		log.Error(err, "Failed to apply a scaled object")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	log.Info("Successfully applied a scaled object", "UID", so.GetUID())
+
+	if err := trackResources(ctx, r, r.Scheme, &trait, so); err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Failed to track resources")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+
+	uid := so.GetUID()
+	trait.Status.Resources = []oamv1alpha2.ResourceReference{{
+		APIVersion: so.GetAPIVersion(),
+		Kind:       so.GetKind(),
+		Name:       so.GetName(),
+		UID:        &uid,
+	}}
+	r.Limiter.MarkForceSynced(&trait)
+	trait.Status.SetConditions(oamv1alpha2.PermissionGranted(), cpv1alpha1.ReconcileSuccess())
+	return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &trait), errUpdateStatus)
+}
+
+// create a KEDA scaled object targeting the deployment
+func (r *KEDAScalerTraitReconciler) renderScaledObject(trait *oamv1alpha2.KEDAScalerTrait,
+	deploy *appsv1.Deployment) (*unstructured.Unstructured, error) {
+	triggers := make([]interface{}, 0, len(trait.Spec.Triggers))
+	for _, t := range trait.Spec.Triggers {
+		md := make(map[string]interface{}, len(t.Metadata))
+		for k, v := range t.Metadata {
+			md[k] = v
+		}
+		trigger := map[string]interface{}{
+			"type":     t.Type,
+			"metadata": md,
+		}
+		if t.AuthenticationRef != "" {
+			trigger["authenticationRef"] = map[string]interface{}{"name": t.AuthenticationRef}
+		}
+		triggers = append(triggers, trigger)
+	}
+
+	spec := map[string]interface{}{
+		"scaleTargetRef": map[string]interface{}{
+			"apiVersion": appsv1.SchemeGroupVersion.String(),
+			"kind":       KindDeployment,
+			"name":       deploy.Name,
+		},
+		"triggers": triggers,
+	}
+	optional := map[string]*int32{
+		"minReplicaCount": trait.Spec.MinReplicaCount,
+		"maxReplicaCount": trait.Spec.MaxReplicaCount,
+		"pollingInterval": trait.Spec.PollingInterval,
+		"cooldownPeriod":  trait.Spec.CooldownPeriod,
+	}
+	for k, v := range optional {
+		if v != nil {
+			spec[k] = int64(*v)
+		}
+	}
+
+	so := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	so.SetGroupVersionKind(ScaledObjectGroupVersionKind)
+	so.SetName(trait.Name)
+	so.SetNamespace(trait.Namespace)
+
+	// always set the controller reference so that we can watch this scaled object
+	if err := ctrl.SetControllerReference(trait, so, r.Scheme); err != nil {
+		return nil, err
+	}
+	return so, nil
+}
+
+func (r *KEDAScalerTraitReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	so := &unstructured.Unstructured{}
+	so.SetGroupVersionKind(ScaledObjectGroupVersionKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&oamv1alpha2.KEDAScalerTrait{}).
+		Owns(so).
+		Complete(r.Debug.Wrap("KEDAScalerTrait", r))
+}
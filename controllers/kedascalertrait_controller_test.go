@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"reflect"
+	"testing"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestKEDAScalerTraitReconciler_renderScaledObject(t *testing.T) {
+	min := int32(0)
+	trait := &oamv1alpha2.KEDAScalerTrait{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: oamv1alpha2.GroupVersion.String(),
+			Kind:       "KEDAScalerTrait",
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "consumer", Namespace: "default", UID: "trait-uid"},
+		Spec: oamv1alpha2.KEDAScalerTraitSpec{
+			MinReplicaCount: &min,
+			Triggers: []oamv1alpha2.KEDATrigger{{
+				Type:              "kafka",
+				Metadata:          map[string]string{"topic": "orders", "lagThreshold": "50"},
+				AuthenticationRef: "kafka-auth",
+			}},
+		},
+	}
+	deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "test-deployment", Namespace: "default"}}
+
+	r := KEDAScalerTraitReconciler{Scheme: testScheme}
+	got, err := r.renderScaledObject(trait, deploy)
+	if err != nil {
+		t.Fatalf("renderScaledObject() error = %v", err)
+	}
+
+	want := map[string]interface{}{
+		"scaleTargetRef": map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       KindDeployment,
+			"name":       "test-deployment",
+		},
+		"minReplicaCount": int64(0),
+		"triggers": []interface{}{map[string]interface{}{
+			"type":              "kafka",
+			"metadata":          map[string]interface{}{"topic": "orders", "lagThreshold": "50"},
+			"authenticationRef": map[string]interface{}{"name": "kafka-auth"},
+		}},
+	}
+	if !reflect.DeepEqual(got.Object["spec"], want) {
+		t.Errorf("renderScaledObject() spec = %v, want %v", got.Object["spec"], want)
+	}
+	if got.GroupVersionKind() != ScaledObjectGroupVersionKind || got.GetName() != "consumer" {
+		t.Errorf("renderScaledObject() rendered %v %s", got.GroupVersionKind(), got.GetName())
+	}
+	if refs := got.GetOwnerReferences(); len(refs) != 1 || refs[0].UID != trait.UID {
+		t.Errorf("renderScaledObject() owner references = %v", refs)
+	}
+}
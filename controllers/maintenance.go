@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// Maintenance window error strings.
+const (
+	errGetNamespace            = "cannot get the namespace"
+	errParseMaintenanceWindows = "cannot parse the maintenance windows of the namespace"
+)
+
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+
+// maintenanceWindowEnd returns when the maintenance window the namespace is
+// in at the given time ends, or the zero time if it is in none. Controllers
+// defer their changes until then.
+func maintenanceWindowEnd(ctx context.Context, c client.Reader, namespace string, now time.Time) (time.Time, error) {
+	var ns corev1.Namespace
+	if err := c.Get(ctx, client.ObjectKey{Name: namespace}, &ns); err != nil {
+		return time.Time{}, errors.Wrap(err, errGetNamespace)
+	}
+	windows := ns.GetAnnotations()[oamv1alpha2.AnnotationMaintenanceWindows]
+	if windows == "" {
+		return time.Time{}, nil
+	}
+	var end time.Time
+	for _, w := range strings.Split(windows, ",") {
+		bounds := strings.Split(strings.TrimSpace(w), "/")
+		if len(bounds) != 2 {
+			return time.Time{}, errors.Errorf("%s: %q is not a <start>/<end> interval", errParseMaintenanceWindows, w)
+		}
+		start, err := time.Parse(time.RFC3339, bounds[0])
+		if err != nil {
+			return time.Time{}, errors.Wrap(err, errParseMaintenanceWindows)
+		}
+		stop, err := time.Parse(time.RFC3339, bounds[1])
+		if err != nil {
+			return time.Time{}, errors.Wrap(err, errParseMaintenanceWindows)
+		}
+		// overlapping windows defer changes until the last of them ends
+		if !now.Before(start) && now.Before(stop) && stop.After(end) {
+			end = stop
+		}
+	}
+	return end, nil
+}
@@ -0,0 +1,57 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestMaintenanceWindowEnd(t *testing.T) {
+	now := time.Date(2020, 12, 25, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		windows string
+		want    time.Time
+		wantErr bool
+	}{
+		"NoWindows": {},
+		"InWindow": {
+			windows: "2020-12-24T00:00:00Z/2020-12-27T00:00:00Z",
+			want:    time.Date(2020, 12, 27, 0, 0, 0, 0, time.UTC),
+		},
+		"OverlappingWindows": {
+			windows: "2020-12-25T00:00:00Z/2020-12-26T00:00:00Z, 2020-12-24T00:00:00Z/2020-12-27T00:00:00Z",
+			want:    time.Date(2020, 12, 27, 0, 0, 0, 0, time.UTC),
+		},
+		"WindowOver": {
+			windows: "2020-12-01T00:00:00Z/2020-12-02T00:00:00Z",
+		},
+		"Malformed": {
+			windows: "2020-12-24T00:00:00Z",
+			wantErr: true,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+			if testCase.windows != "" {
+				ns.Annotations = map[string]string{oamv1alpha2.AnnotationMaintenanceWindows: testCase.windows}
+			}
+			s := runtime.NewScheme()
+			_ = corev1.AddToScheme(s)
+			got, err := maintenanceWindowEnd(context.Background(), fake.NewFakeClientWithScheme(s, ns), "default", now)
+			if (err != nil) != testCase.wantErr {
+				t.Fatalf("maintenanceWindowEnd() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+			if !got.Equal(testCase.want) {
+				t.Errorf("maintenanceWindowEnd() = %s, want %s", got, testCase.want)
+			}
+		})
+	}
+}
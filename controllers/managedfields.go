@@ -0,0 +1,456 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// managedFieldsFinalizer holds a trait until the fields it set on the
+// deployment of its workload have been reverted.
+const managedFieldsFinalizer = "managedfields.core.oam.dev/finalizer"
+
+// Kinds of the traits recording the fields they set.
+const (
+	kindPatchTrait  = "PatchTrait"
+	kindInitTrait   = "InitTrait"
+	kindSpreadTrait = "SpreadTrait"
+
+	kindVerticalScalerTrait     = "VerticalScalerTrait"
+	kindRuntimeClassTrait       = "RuntimeClassTrait"
+	kindPriorityClassTrait      = "PriorityClassTrait"
+	kindIdentityTrait           = "IdentityTrait"
+	kindDeploymentStrategyTrait = "DeploymentStrategyTrait"
+	kindCostTrait               = "CostTrait"
+	kindScratchStorageTrait     = "ScratchStorageTrait"
+)
+
+// Managed fields error strings.
+const (
+	errRecordManagedFields = "cannot record the fields set by the trait"
+	errRevertManagedFields = "cannot revert the fields set by the trait"
+)
+
+// a managedField is a field a trait set, recorded in the
+// AnnotationTraitManagedFields annotation of the child
+type managedField struct {
+	// Path is the JSON pointer of the field, e.g. /spec/template/metadata/labels/app,
+	// whose key=value segments address the element of a list with that merge
+	// key, e.g. /spec/template/spec/containers/name=web/image.
+	Path string `json:"path"`
+	// Previous value of the field before the trait first set it, absent if
+	// the field was not set.
+	Previous json.RawMessage `json:"previous,omitempty"`
+	// Value the trait last set the field to.
+	Value json.RawMessage `json:"value"`
+}
+
+// the fields set by each trait, keyed by the trait's kind and name
+type managedFields map[string][]managedField
+
+func managedFieldsKey(kind, name string) string {
+	return kind + "/" + name
+}
+
+func getManagedFields(deploy *appsv1.Deployment) (managedFields, error) {
+	mf := managedFields{}
+	if v := deploy.GetAnnotations()[oamv1alpha2.AnnotationTraitManagedFields]; v != "" {
+		if err := json.Unmarshal([]byte(v), &mf); err != nil {
+			return nil, err
+		}
+	}
+	return mf, nil
+}
+
+func setManagedFields(deploy *appsv1.Deployment, mf managedFields) error {
+	annotations := deploy.GetAnnotations()
+	if len(mf) == 0 {
+		delete(annotations, oamv1alpha2.AnnotationTraitManagedFields)
+		deploy.SetAnnotations(annotations)
+		return nil
+	}
+	v, err := json.Marshal(mf)
+	if err != nil {
+		return err
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[oamv1alpha2.AnnotationTraitManagedFields] = string(v)
+	deploy.SetAnnotations(annotations)
+	return nil
+}
+
+// recordManagedFields records on changed, a copy of deploy modified by the
+// trait, the spec fields it differs in. A field the trait set before keeps
+// the value it had before the trait first set it.
+func recordManagedFields(deploy, changed *appsv1.Deployment, key string) error {
+	before, err := toJSONMap(deploy.Spec)
+	if err != nil {
+		return errors.Wrap(err, errRecordManagedFields)
+	}
+	after, err := toJSONMap(changed.Spec)
+	if err != nil {
+		return errors.Wrap(err, errRecordManagedFields)
+	}
+	mf, err := getManagedFields(deploy)
+	if err != nil {
+		return errors.Wrap(err, errRecordManagedFields)
+	}
+
+	fields := map[string]managedField{}
+	for _, f := range mf[key] {
+		fields[f.Path] = f
+	}
+	for _, path := range changedPaths(before, after, []string{"spec"}) {
+		ptr := jsonPointer(path)
+		f, recorded := fields[ptr]
+		if !recorded {
+			f = managedField{Path: ptr}
+			if v, ok := getPath(before, path[1:]); ok {
+				if f.Previous, err = json.Marshal(v); err != nil {
+					return errors.Wrap(err, errRecordManagedFields)
+				}
+			}
+		}
+		v, _ := getPath(after, path[1:])
+		if f.Value, err = json.Marshal(v); err != nil {
+			return errors.Wrap(err, errRecordManagedFields)
+		}
+		fields[ptr] = f
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	recorded := make([]managedField, 0, len(fields))
+	for _, f := range fields {
+		recorded = append(recorded, f)
+	}
+	sort.Slice(recorded, func(i, j int) bool { return recorded[i].Path < recorded[j].Path })
+	mf[key] = recorded
+	return errors.Wrap(setManagedFields(changed, mf), errRecordManagedFields)
+}
+
+// revertManagedFields returns a copy of the deployment with the fields the
+// trait set reverted to their previous values. Fields changed since the trait
+// last set them are left alone.
+func revertManagedFields(deploy *appsv1.Deployment, key string) (*appsv1.Deployment, error) {
+	mf, err := getManagedFields(deploy)
+	if err != nil {
+		return nil, errors.Wrap(err, errRevertManagedFields)
+	}
+	reverted := deploy.DeepCopy()
+	if len(mf[key]) == 0 {
+		return reverted, nil
+	}
+	spec, err := toJSONMap(deploy.Spec)
+	if err != nil {
+		return nil, errors.Wrap(err, errRevertManagedFields)
+	}
+	for _, f := range mf[key] {
+		path := splitJSONPointer(f.Path)
+		if len(path) < 2 || path[0] != "spec" {
+			continue
+		}
+		current, _ := getPath(spec, path[1:])
+		if c, err := json.Marshal(current); err != nil || !bytes.Equal(c, f.Value) {
+			continue
+		}
+		if f.Previous == nil {
+			deletePath(spec, path[1:])
+			continue
+		}
+		var previous interface{}
+		if err := json.Unmarshal(f.Previous, &previous); err != nil {
+			return nil, errors.Wrap(err, errRevertManagedFields)
+		}
+		setPath(spec, path[1:], previous)
+	}
+
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, errors.Wrap(err, errRevertManagedFields)
+	}
+	reverted.Spec = appsv1.DeploymentSpec{}
+	if err := json.Unmarshal(data, &reverted.Spec); err != nil {
+		return nil, errors.Wrap(err, errRevertManagedFields)
+	}
+	delete(mf, key)
+	return reverted, errors.Wrap(setManagedFields(reverted, mf), errRevertManagedFields)
+}
+
+// finalizeManagedFields makes sure the trait carries the managed fields
+// finalizer while it exists and, once it is being deleted, reverts the fields
+// it set on the deployment of its workload. It returns true when the trait is
+// being deleted and needs no further work.
+func (w *WorkloadResolver) finalizeManagedFields(ctx context.Context, c client.Client, log logr.Logger,
+	trait trackedOwner, kind string, ref oamv1alpha2.ResourceReference) (bool, error) {
+	return finalize(ctx, c, trait, managedFieldsFinalizer, func() error {
+		deploy, err := w.fetchWorkloadDeployment(ctx, c, log, trait.GetNamespace(), ref)
+		if err != nil {
+			if apierrors.IsForbidden(errors.Cause(err)) {
+				return err
+			}
+			// the workload or its deployment is gone, there is nothing to revert
+			log.Info("Cannot find the deployment to revert", "reason", err.Error())
+			return nil
+		}
+		reverted, err := revertManagedFields(deploy, managedFieldsKey(kind, trait.GetName()))
+		if err != nil {
+			return err
+		}
+		return errors.Wrap(c.Patch(ctx, reverted, client.MergeFrom(deploy)), errRevertManagedFields)
+	})
+}
+
+func toJSONMap(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	m := map[string]interface{}{}
+	return m, json.Unmarshal(data, &m)
+}
+
+// the keys lists of objects are merged on, e.g. containers on their name, see
+// the patchMergeKey of their fields
+var listMergeKeys = []string{"name", "mountPath", "containerPort", "topologyKey", "ip"}
+
+// changedPaths returns the paths below prefix at which after differs from
+// before. The lists of objects with a merge key are compared element by
+// element, other lists as a whole.
+func changedPaths(before, after map[string]interface{}, prefix []string) [][]string {
+	keys := map[string]bool{}
+	for k := range before {
+		keys[k] = true
+	}
+	for k := range after {
+		keys[k] = true
+	}
+	var paths [][]string
+	for k := range keys {
+		path := append(append([]string(nil), prefix...), k)
+		paths = append(paths, changedValuePaths(before[k], after[k], path)...)
+	}
+	return paths
+}
+
+// changedValuePaths returns the paths below path at which the value after
+// differs from the value before, nil meaning absent.
+func changedValuePaths(before, after interface{}, path []string) [][]string {
+	bm, bIsMap := before.(map[string]interface{})
+	am, aIsMap := after.(map[string]interface{})
+	if bIsMap && aIsMap {
+		return changedPaths(bm, am, path)
+	}
+	bl, bIsList := before.([]interface{})
+	al, aIsList := after.([]interface{})
+	if key := mergeKey(bl, al); bIsList && aIsList && key != "" {
+		return changedElements(bl, al, key, path)
+	}
+	if !reflect.DeepEqual(before, after) {
+		return [][]string{path}
+	}
+	return nil
+}
+
+// changedElements returns the paths below path at which the elements of the
+// lists, matched on their merge key, differ.
+func changedElements(before, after []interface{}, key string, path []string) [][]string {
+	segments := map[string]bool{}
+	by := func(list []interface{}) map[string]interface{} {
+		m := make(map[string]interface{}, len(list))
+		for _, e := range list {
+			seg := elementSegment(key, e.(map[string]interface{}))
+			m[seg] = e
+			segments[seg] = true
+		}
+		return m
+	}
+	b, a := by(before), by(after)
+	var paths [][]string
+	for seg := range segments {
+		elem := append(append([]string(nil), path...), seg)
+		paths = append(paths, changedValuePaths(b[seg], a[seg], elem)...)
+	}
+	return paths
+}
+
+// mergeKey returns the merge key every element of the lists is an object
+// with a distinct value of, or "" if there is none.
+func mergeKey(lists ...[]interface{}) string {
+	for _, key := range listMergeKeys {
+		if hasMergeKey(key, lists...) {
+			return key
+		}
+	}
+	return ""
+}
+
+func hasMergeKey(key string, lists ...[]interface{}) bool {
+	for _, list := range lists {
+		seen := map[string]bool{}
+		for _, e := range list {
+			m, ok := e.(map[string]interface{})
+			if !ok {
+				return false
+			}
+			switch m[key].(type) {
+			case string, float64:
+			default:
+				return false
+			}
+			seg := elementSegment(key, m)
+			if seen[seg] {
+				return false
+			}
+			seen[seg] = true
+		}
+	}
+	return true
+}
+
+// elementSegment returns the path segment of the element of a list, e.g.
+// name=web.
+func elementSegment(key string, elem map[string]interface{}) string {
+	return key + "=" + fmt.Sprint(elem[key])
+}
+
+// findElement returns the index of the element of the list the path segment
+// addresses.
+func findElement(list []interface{}, seg string) (int, bool) {
+	i := strings.Index(seg, "=")
+	if i < 0 {
+		return 0, false
+	}
+	for j, e := range list {
+		if m, ok := e.(map[string]interface{}); ok && elementSegment(seg[:i], m) == seg {
+			return j, true
+		}
+	}
+	return 0, false
+}
+
+func getPath(m map[string]interface{}, path []string) (interface{}, bool) {
+	var v interface{} = m
+	for _, k := range path {
+		switch node := v.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if v, ok = node[k]; !ok {
+				return nil, false
+			}
+		case []interface{}:
+			i, ok := findElement(node, k)
+			if !ok {
+				return nil, false
+			}
+			v = node[i]
+		default:
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+func setPath(m map[string]interface{}, path []string, value interface{}) {
+	setIn(m, path, value)
+}
+
+// setIn sets the value at path below node, creating the objects on the way,
+// and returns node, which is a new value if it was a list that grew or was
+// not an object.
+func setIn(node interface{}, path []string, value interface{}) interface{} {
+	if len(path) == 0 {
+		return value
+	}
+	switch n := node.(type) {
+	case map[string]interface{}:
+		n[path[0]] = setIn(n[path[0]], path[1:], value)
+		return n
+	case []interface{}:
+		if i, ok := findElement(n, path[0]); ok {
+			n[i] = setIn(n[i], path[1:], value)
+			return n
+		}
+		// an element that is gone is only added back as a whole
+		if len(path) == 1 {
+			return append(n, value)
+		}
+		return n
+	}
+	// the elements of a list that is gone are not added back
+	if strings.Contains(path[0], "=") {
+		return node
+	}
+	return setIn(map[string]interface{}{}, path, value)
+}
+
+func deletePath(m map[string]interface{}, path []string) {
+	deleteIn(m, path)
+}
+
+// deleteIn deletes the value at path below node and returns node, which is a
+// new value if it was a list that shrank.
+func deleteIn(node interface{}, path []string) interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		v, ok := n[path[0]]
+		if !ok {
+			return n
+		}
+		if len(path) == 1 {
+			delete(n, path[0])
+		} else {
+			n[path[0]] = deleteIn(v, path[1:])
+		}
+		return n
+	case []interface{}:
+		i, ok := findElement(n, path[0])
+		if !ok {
+			return n
+		}
+		if len(path) == 1 {
+			return append(n[:i:i], n[i+1:]...)
+		}
+		n[i] = deleteIn(n[i], path[1:])
+		return n
+	}
+	return node
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+var pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+func jsonPointer(path []string) string {
+	var b strings.Builder
+	for _, k := range path {
+		b.WriteString("/")
+		b.WriteString(pointerEscaper.Replace(k))
+	}
+	return b.String()
+}
+
+func splitJSONPointer(ptr string) []string {
+	if ptr == "" {
+		return nil
+	}
+	path := strings.Split(strings.TrimPrefix(ptr, "/"), "/")
+	for i, k := range path {
+		path[i] = pointerUnescaper.Replace(k)
+	}
+	return path
+}
@@ -0,0 +1,103 @@
+package controllers
+
+import (
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRevertManagedFields(t *testing.T) {
+	labelled := func(labels map[string]string) *appsv1.Deployment {
+		d := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web-deployment"}}
+		d.Spec.Template.Labels = labels
+		return d
+	}
+	testCases := map[string]struct {
+		// changes made after the trait set its fields
+		changedSince map[string]string
+		want         map[string]string
+	}{
+		"Reverted": {
+			want: map[string]string{"app": "web"},
+		},
+		"ChangedSinceKept": {
+			changedSince: map[string]string{"team": "payments"},
+			want:         map[string]string{"app": "web", "team": "payments"},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			deploy := labelled(map[string]string{"app": "web"})
+			patched := labelled(map[string]string{"app": "web", "team": "search", "app.oam.dev/tier": "frontend"})
+			key := managedFieldsKey(kindPatchTrait, "labels")
+			if err := recordManagedFields(deploy, patched, key); err != nil {
+				t.Fatalf("recordManagedFields() = %v", err)
+			}
+			// setting the fields again keeps what they were before the trait
+			again := patched.DeepCopy()
+			if err := recordManagedFields(patched, again, key); err != nil {
+				t.Fatalf("recordManagedFields() = %v", err)
+			}
+			for k, v := range testCase.changedSince {
+				again.Spec.Template.Labels[k] = v
+			}
+
+			got, err := revertManagedFields(again, key)
+			if err != nil {
+				t.Fatalf("revertManagedFields() = %v", err)
+			}
+			if !reflect.DeepEqual(got.Spec.Template.Labels, testCase.want) {
+				t.Errorf("revertManagedFields() labels = %v, want %v", got.Spec.Template.Labels, testCase.want)
+			}
+			if len(got.Annotations) != 0 {
+				t.Errorf("revertManagedFields() annotations = %v, want none", got.Annotations)
+			}
+		})
+	}
+}
+
+func TestRevertManagedFieldsOfListElements(t *testing.T) {
+	deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web-deployment"}}
+	deploy.Spec.Template.Spec.Containers = []corev1.Container{{Name: "web", Image: "web:v1"}}
+
+	// a trait adds an env var to the container and a sidecar
+	first := deploy.DeepCopy()
+	first.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "MODE", Value: "debug"}}
+	first.Spec.Template.Spec.Containers = append(first.Spec.Template.Spec.Containers,
+		corev1.Container{Name: "proxy", Image: "proxy:v1"})
+	firstKey := managedFieldsKey(kindPatchTrait, "debug")
+	if err := recordManagedFields(deploy, first, firstKey); err != nil {
+		t.Fatalf("recordManagedFields() = %v", err)
+	}
+	// another changes the image of the same container
+	second := first.DeepCopy()
+	second.Spec.Template.Spec.Containers[0].Image = "web:v2"
+	secondKey := managedFieldsKey(kindPatchTrait, "upgrade")
+	if err := recordManagedFields(first, second, secondKey); err != nil {
+		t.Fatalf("recordManagedFields() = %v", err)
+	}
+
+	got, err := revertManagedFields(second, firstKey)
+	if err != nil {
+		t.Fatalf("revertManagedFields() = %v", err)
+	}
+	want := []corev1.Container{{Name: "web", Image: "web:v2"}}
+	if !reflect.DeepEqual(got.Spec.Template.Spec.Containers, want) {
+		t.Errorf("revertManagedFields() containers = %+v, want %+v", got.Spec.Template.Spec.Containers, want)
+	}
+
+	got, err = revertManagedFields(got, secondKey)
+	if err != nil {
+		t.Fatalf("revertManagedFields() = %v", err)
+	}
+	if !reflect.DeepEqual(got.Spec.Template.Spec.Containers, deploy.Spec.Template.Spec.Containers) {
+		t.Errorf("revertManagedFields() containers = %+v, want %+v", got.Spec.Template.Spec.Containers,
+			deploy.Spec.Template.Spec.Containers)
+	}
+	if len(got.Annotations) != 0 {
+		t.Errorf("revertManagedFields() annotations = %v, want none", got.Annotations)
+	}
+}
@@ -18,40 +18,81 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"time"
+
 	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	"github.com/crossplaneio/crossplane-runtime/pkg/meta"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	appsv1client "k8s.io/client-go/kubernetes/typed/apps/v1"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/debug"
+	"github.com/oam-dev/core-resource-controller/pkg/policy"
 )
 
 // Reconcile error strings.
 const (
 	errLocateWorkload   = "cannot find workload"
 	errLocateDeployment = "cannot find deployment"
+	errCheckPolicy      = "cannot check the scaling policy"
+	errListTraits       = "cannot list the traits of the workload"
+
+	errApplyZoneDeployments = "cannot apply the zone deployments"
 )
 
+// AnnotationScaleReason records on a deployment why a trait last changed its
+// replicas.
+const AnnotationScaleReason = "core.oam.dev/scale-reason"
+
+// eventScaled is the reason of the event recorded when a trait changes the
+// replicas of a deployment.
+const eventScaled = "Scaled"
+
 // ManualScalerTraitReconciler reconciles a ManualScalerTrait object
 type ManualScalerTraitReconciler struct {
 	client.Client
 	Log    logr.Logger
 	Scheme *runtime.Scheme
+	// Limiter, if set, rate limits the writes of child resources per namespace.
+	Limiter *NamespaceWriteLimiter
+	// Debug, if set, records the outcome of each reconcile.
+	Debug *debug.Recorder
+	// Workloads, if set, resolves the workload the trait refers to, see
+	// WorkloadResolver.
+	Workloads *WorkloadResolver
+	// Policy, if set, must approve a scaling change before it is applied.
+	Policy policy.ScaleChecker
+	// Scales, if set, is used to change replicas through the scale
+	// subresource of the deployment, the same way an autoscaler does.
+	Scales appsv1client.DeploymentsGetter
+	// HTTPClient, if set, is used to read the URLs of replica sources.
+	HTTPClient *http.Client
+	// Events, if set, records an event on the trait for each change of the
+	// replicas of its deployment.
+	Events record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=core.oam.dev,resources=manualscalertraits,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core.oam.dev,resources=manualscalertraits/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=core.oam.dev,resources=containerizedworkloads,verbs=get;list;
 // +kubebuilder:rbac:groups=core.oam.dev,resources=containerizedworkloads/status,verbs=get;
-// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments/scale,verbs=get;update
+// +kubebuilder:rbac:groups=core.oam.dev,resources=costtraits,verbs=get;list;watch
 
 func (r *ManualScalerTraitReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	ctx := context.Background()
@@ -65,89 +106,372 @@ func (r *ManualScalerTraitReconciler) Reconcile(req ctrl.Request) (ctrl.Result,
 	log.Info("Get the manualscaler trait", "ReplicaCount", manualScaler.Spec.ReplicaCount,
 		"WorkloadReference", manualScaler.Spec.WorkloadReference)
 
-	// Fetch the workload this trait is referring to
-	var workload oamv1alpha2.ContainerizedWorkload
-	wn := client.ObjectKey{Name: manualScaler.Spec.WorkloadReference.Name, Namespace: req.Namespace}
-	if err := r.Get(ctx, wn, &workload); err != nil {
-		manualScaler.Status.SetConditions(cpv1alpha1.ReconcileError(errors.Wrap(err, errLocateWorkload)))
+	// only the trait with the highest priority scales the workload
+	var traits oamv1alpha2.ManualScalerTraitList
+	if err := r.List(ctx, &traits, client.InNamespace(req.Namespace)); err != nil {
+		manualScaler.Status.SetConditions(reconcileError(errors.Wrap(err, errListTraits))...)
 		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &manualScaler),
 			errUpdateStatus)
 	}
-	log.Info("Get the workload the trait is pointing to", "workload name", manualScaler.Spec.WorkloadReference.Name,
-		"UID", workload.UID)
+	if winner := overridingTrait(&manualScaler, traits.Items); winner != nil {
+		log.Info("Trait is overridden", "by", winner.Name, "priority", winner.Spec.Priority)
+		manualScaler.Status.SetConditions(oamv1alpha2.Overridden(winner.Name), oamv1alpha2.PermissionGranted(),
+			cpv1alpha1.ReconcileSuccess())
+		return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &manualScaler), errUpdateStatus)
+	}
+	manualScaler.Status.SetConditions(oamv1alpha2.NotOverridden())
 
-	if manualScaler.Spec.WorkloadReference.UID == nil || workload.UID != *manualScaler.Spec.WorkloadReference.UID {
-		log.Info("Wrong workload", "trait references to ", manualScaler.Spec.WorkloadReference.UID)
-		manualScaler.Status.SetConditions(cpv1alpha1.ReconcileError(fmt.Errorf(errLocateWorkload)))
+	scaleDeploy, err := r.Workloads.fetchWorkloadDeployment(ctx, r, log,
+		req.Namespace, manualScaler.Spec.WorkloadReference)
+	if err != nil {
+		manualScaler.Status.SetConditions(reconcileError(err)...)
 		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &manualScaler),
 			errUpdateStatus)
 	}
 
-	// TODO(rz): only apply if there is only one deployment
-	// Fetch the deployment we are going to modify
-	var scaleDeploy appsv1.Deployment
-	found := false
-	for _, res := range workload.Status.Resources {
-		if res.Kind == KindDeployment {
-			dn := client.ObjectKey{Name: res.Name, Namespace: req.Namespace}
-			if err := r.Get(ctx, dn, &scaleDeploy); err != nil {
-				log.Error(err, "Failed to get an associated deployment", "name ", res.Name)
-				manualScaler.Status.SetConditions(cpv1alpha1.ReconcileError(errors.Wrap(err, errLocateDeployment)))
-				continue
-			}
-			found = true
-			break
+	manualScaler.Status.ObservedReplicas = scaleDeploy.Spec.Replicas
+	if sel, err := metav1.LabelSelectorAsSelector(scaleDeploy.Spec.Selector); err == nil {
+		manualScaler.Status.Selector = sel.String()
+	}
+	if manualScaler.Spec.Suspend {
+		synced := cpv1alpha1.ReconcileSuccess()
+		if msg := replicaDrift(&manualScaler, scaleDeploy); msg != "" {
+			log.Info("Scaling is suspended", "drift", msg)
+			synced = synced.WithMessage(msg)
 		}
+		manualScaler.Status.SetConditions(oamv1alpha2.PermissionGranted(), synced)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &manualScaler),
+			errUpdateStatus)
 	}
-	if !found {
-		log.Info("Cannot locate a deployment", "total resources", len(workload.Status.Resources))
-		manualScaler.Status.SetConditions(cpv1alpha1.ReconcileError(fmt.Errorf(errLocateDeployment)))
+
+	// external capacity systems may feed the replicas
+	nextSource, err := r.sourceReplicas(ctx, &manualScaler, time.Now())
+	if err != nil {
+		manualScaler.Status.SetConditions(reconcileError(err)...)
+		log.Info("Cannot read the replica source", "reason", err.Error())
 		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &manualScaler),
 			errUpdateStatus)
 	}
-	log.Info("Get the deployment the trait is going to modify", "deploy name", scaleDeploy.Name, "UID", scaleDeploy.UID)
 
-	sd := scaleDeploy.DeepCopy()
-	// always set the owner reference so that we can watch this deployment
-	isController := false
-	bod := true
-	// Update owner references
-	ref := metav1.OwnerReference{
-		APIVersion:         manualScaler.APIVersion,
-		Kind:               manualScaler.Kind,
-		Name:               manualScaler.Name,
-		UID:                manualScaler.UID,
-		Controller:         &isController,
-		BlockOwnerDeletion: &bod,
-	}
-
-	existingRefs := scaleDeploy.GetOwnerReferences()
-	fi := -1
-	for i, r := range existingRefs {
-		if r.UID == manualScaler.UID {
-			fi = i
-			break
+	scaleReq := policy.ScaleRequest{
+		Namespace: req.Namespace,
+		Kind:      manualScaler.Kind,
+		Name:      manualScaler.Name,
+		Workload:  manualScaler.Spec.WorkloadReference.Name,
+		Replicas:  totalReplicas(&manualScaler),
+	}
+	if err := policy.Check(ctx, r.Policy, scaleReq); err != nil {
+		if _, denied := err.(*policy.DeniedError); !denied {
+			err = errors.Wrap(err, errCheckPolicy)
 		}
+		manualScaler.Status.SetConditions(reconcileError(err)...)
+		log.Info("Scaling is not allowed", "reason", err.Error())
+		return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &manualScaler),
+			errUpdateStatus)
 	}
-	if fi == -1 {
-		existingRefs = append(existingRefs, ref)
-	} else {
-		existingRefs[fi] = ref
+
+	// scaling must keep the requests of the workload within its budget
+	exceeded, err := budgetExceeded(ctx, r, req.Namespace, manualScaler.Spec.WorkloadReference, scaleDeploy,
+		scaleReq.Replicas)
+	if err != nil {
+		manualScaler.Status.SetConditions(reconcileError(err)...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &manualScaler), errUpdateStatus)
 	}
-	sd.SetOwnerReferences(existingRefs)
-	// scale replica
-	sd.Spec.Replicas = &manualScaler.Spec.ReplicaCount
-	// merge to scale the deployment
-	if err := r.Patch(ctx, sd, client.MergeFrom(&scaleDeploy)); err != nil {
-		manualScaler.Status.SetConditions(cpv1alpha1.ReconcileError(errors.Wrap(err, errScaleDeployment)))
+	if exceeded != "" {
+		log.Info("Scaling would exceed the budget", "reason", exceeded)
+		manualScaler.Status.SetConditions(append(reconcileError(errors.New(exceeded)),
+			oamv1alpha2.BudgetExceeded(exceeded))...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &manualScaler),
+			errUpdateStatus)
+	}
+	manualScaler.Status.SetConditions(oamv1alpha2.WithinBudget())
+
+	if result, ok, err := gateReconcile(ctx, r, log, r.Limiter, kindManualScalerTrait, &manualScaler, &manualScaler.Status.ConditionedStatus,
+		&manualScaler.Spec.WorkloadReference); !ok {
+		return result, err
+	}
+
+	// the trait and an autoscaler of the deployment would fight over its
+	// replicas
+	hpas, err := r.targetingHPAs(ctx, scaleDeploy)
+	if err != nil {
+		manualScaler.Status.SetConditions(reconcileError(err)...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &manualScaler), errUpdateStatus)
+	}
+	if yieldsToHPA(&manualScaler, hpas) {
+		log.Info("Leaving scaling to a horizontal pod autoscaler", "hpa", hpas[0].Name)
+		r.Limiter.MarkForceSynced(&manualScaler)
+		manualScaler.Status.SetConditions(oamv1alpha2.IgnoredDueToHPA(hpas[0].Name), oamv1alpha2.PermissionGranted(),
+			cpv1alpha1.ReconcileSuccess())
+		// autoscalers are not watched, check whether it is still there
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &manualScaler),
+			errUpdateStatus)
+	}
+
+	// large changes are made in steps, protecting what the workload depends on
+	now := metav1.Now()
+	replicas, nextStep := stepReplicas(&manualScaler, scaleDeploy, now.Time)
+	if replicas != deploymentReplicas(scaleDeploy) && nextStep > 0 {
+		manualScaler.Status.LastStepTime = &now
+	}
+
+	// autoscalers follow the steps, rather than scaling to the target at once
+	adjusted, err := r.adjustHPAs(ctx, hpas, replicas)
+	if err != nil {
+		manualScaler.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Failed to adjust a horizontal pod autoscaler")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &manualScaler),
+			errUpdateStatus)
+	}
+	manualScaler.Status.SetConditions(oamv1alpha2.NotIgnoredDueToHPA(adjusted))
+
+	change := replicaChange(&manualScaler, scaleDeploy, replicas)
+	// merge to scale the deployment, refetching it if it changed under us
+	apply := func() error {
+		return r.Patch(ctx, scaledDeployment(&manualScaler, scaleDeploy, replicas), client.MergeFrom(scaleDeploy))
+	}
+	if r.Scales != nil {
+		apply = func() error {
+			return r.scaleSubresource(ctx, &manualScaler, scaleDeploy, replicas)
+		}
+	}
+	err = retryTransient(applyBackoff, func() error {
+		return r.Get(ctx, client.ObjectKey{Name: scaleDeploy.Name, Namespace: scaleDeploy.Namespace}, scaleDeploy)
+	}, apply)
+	if err != nil {
+		manualScaler.Status.SetConditions(reconcileError(errors.Wrap(err, errScaleDeployment))...)
 		log.Error(err, "Failed to scale a deployment")
 		return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &manualScaler),
 			errUpdateStatus)
 	}
 	log.Info("Successfully scaled a deployment", "UID", scaleDeploy.UID, "target replica",
-		manualScaler.Spec.ReplicaCount)
-	manualScaler.Status.SetConditions(cpv1alpha1.ReconcileSuccess())
-	return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &manualScaler), errUpdateStatus)
+		workloadReplicas(&manualScaler), "replicas", replicas)
+	if change != "" && r.Events != nil {
+		r.Events.Event(&manualScaler, corev1.EventTypeNormal, eventScaled, change)
+	}
+
+	if err := r.applyZoneDeployments(ctx, &manualScaler, scaleDeploy); err != nil {
+		manualScaler.Status.SetConditions(reconcileError(errors.Wrap(err, errApplyZoneDeployments))...)
+		log.Error(err, "Failed to apply the zone deployments")
+		return reconcile.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &manualScaler),
+			errUpdateStatus)
+	}
+	manualScaler.Status.ObservedReplicas = &replicas
+
+	// the trait is synced once the replicas are ready, rather than once the
+	// deployment accepted them
+	if manualScaler.Spec.VerifyTimeout != nil && len(manualScaler.Spec.ZoneReplicas) == 0 {
+		verified, err := r.verifyScale(ctx, &manualScaler, scaleDeploy, replicas)
+		if err != nil {
+			manualScaler.Status.SetConditions(reconcileError(err)...)
+			return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &manualScaler),
+				errUpdateStatus)
+		}
+		manualScaler.Status.SetConditions(verified)
+		if verified.Status != corev1.ConditionTrue {
+			log.Info("The replicas are not ready", "reason", verified.Message)
+			manualScaler.Status.SetConditions(reconcileError(errors.New(verified.Message))...)
+			return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &manualScaler),
+				errUpdateStatus)
+		}
+	}
+	if nextStep > 0 {
+		target := workloadReplicas(&manualScaler)
+		manualScaler.Status.TargetReplicas = &target
+		msg := fmt.Sprintf("scaling in steps from %d to %d replicas", replicas, target)
+		log.Info("Scaling in steps", "replicas", replicas, "target", target, "next step", nextStep)
+		r.Limiter.MarkForceSynced(&manualScaler)
+		manualScaler.Status.SetConditions(oamv1alpha2.PermissionGranted(), cpv1alpha1.ReconcileSuccess().WithMessage(msg))
+		if nextSource > 0 && nextSource < nextStep {
+			nextStep = nextSource
+		}
+		return ctrl.Result{RequeueAfter: nextStep}, errors.Wrap(r.Status().Update(ctx, &manualScaler),
+			errUpdateStatus)
+	}
+	manualScaler.Status.TargetReplicas = nil
+	r.Limiter.MarkForceSynced(&manualScaler)
+	manualScaler.Status.SetConditions(oamv1alpha2.PermissionGranted(), cpv1alpha1.ReconcileSuccess())
+	return ctrl.Result{RequeueAfter: nextSource}, errors.Wrap(r.Status().Update(ctx, &manualScaler), errUpdateStatus)
+}
+
+// defaultStepInterval between two steps of a trait scaling in steps.
+const defaultStepInterval = time.Minute
+
+// stepReplicas returns the replicas to scale the deployment to now and, while
+// the trait is still stepping towards its replicas, how long to wait for the
+// next step.
+func stepReplicas(manualScaler *oamv1alpha2.ManualScalerTrait, deploy *appsv1.Deployment,
+	now time.Time) (int32, time.Duration) {
+	target := workloadReplicas(manualScaler)
+	current := deploymentReplicas(deploy)
+	step := manualScaler.Spec.StepSize
+	if step == nil || *step < 1 || len(manualScaler.Spec.ZoneReplicas) > 0 || current == target {
+		return target, 0
+	}
+	interval := defaultStepInterval
+	if manualScaler.Spec.StepInterval != nil {
+		interval = manualScaler.Spec.StepInterval.Duration
+	}
+	if last := manualScaler.Status.LastStepTime; last != nil {
+		if wait := last.Add(interval).Sub(now); wait > 0 {
+			return current, wait
+		}
+	}
+	next := current + *step
+	if target < current {
+		next = current - *step
+	}
+	if (target > current && next >= target) || (target < current && next <= target) {
+		return target, 0
+	}
+	return next, interval
+}
+
+// replicaDrift describes how the deployment differs from what a suspended
+// trait would scale it to, or returns "" if it does not
+func replicaDrift(manualScaler *oamv1alpha2.ManualScalerTrait, deploy *appsv1.Deployment) string {
+	replicas := deploymentReplicas(deploy)
+	if replicas == workloadReplicas(manualScaler) {
+		return ""
+	}
+	return fmt.Sprintf("suspended while deployment %s has %d replicas instead of %d", deploy.Name, replicas,
+		workloadReplicas(manualScaler))
+}
+
+// the replicas the trait scales the deployment of the workload to
+func workloadReplicas(manualScaler *oamv1alpha2.ManualScalerTrait) int32 {
+	if len(manualScaler.Spec.ZoneReplicas) > 0 {
+		return 0
+	}
+	return replicaCount(manualScaler)
+}
+
+// the replicas the trait runs in all zones
+func totalReplicas(manualScaler *oamv1alpha2.ManualScalerTrait) int32 {
+	if len(manualScaler.Spec.ZoneReplicas) == 0 {
+		return replicaCount(manualScaler)
+	}
+	var total int32
+	for _, n := range manualScaler.Spec.ZoneReplicas {
+		total += n
+	}
+	return total
+}
+
+// the api server defaults unset replicas to 1
+func deploymentReplicas(deploy *appsv1.Deployment) int32 {
+	if deploy.Spec.Replicas == nil {
+		return 1
+	}
+	return *deploy.Spec.Replicas
+}
+
+// scaledDeployment returns a copy of the deployment scaled by the trait
+func scaledDeployment(manualScaler *oamv1alpha2.ManualScalerTrait, scaleDeploy *appsv1.Deployment,
+	replicas int32) *appsv1.Deployment {
+	sd := scaleDeploy.DeepCopy()
+	// always set the owner reference so that we can watch this deployment
+	setTraitOwnerReference(sd, manualScaler.APIVersion, manualScaler.Kind, manualScaler)
+	// record why the replicas change so that autoscalers and humans can tell
+	if msg := replicaChange(manualScaler, sd, replicas); msg != "" {
+		meta.AddAnnotations(sd, map[string]string{AnnotationScaleReason: msg})
+	}
+	// scale replica
+	sd.Spec.Replicas = &replicas
+	return sd
+}
+
+// replicaChange describes the replica change the trait makes to the
+// deployment, naming the user who last changed the trait if it is known, or
+// returns "" if it makes none
+func replicaChange(manualScaler *oamv1alpha2.ManualScalerTrait, deploy *appsv1.Deployment, replicas int32) string {
+	current := deploymentReplicas(deploy)
+	if current == replicas {
+		return ""
+	}
+	msg := fmt.Sprintf("%s %s scaled from %d to %d replicas", manualScaler.Kind, manualScaler.Name, current,
+		replicas)
+	if by := manualScaler.GetAnnotations()[oamv1alpha2.AnnotationLastModifiedBy]; by != "" {
+		msg += ", last modified by " + by
+	}
+	return msg
+}
+
+// scaleSubresource records the change on the deployment and then updates its
+// scale subresource, leaving the deployment spec to the deployment controller
+func (r *ManualScalerTraitReconciler) scaleSubresource(ctx context.Context,
+	manualScaler *oamv1alpha2.ManualScalerTrait, deploy *appsv1.Deployment, replicas int32) error {
+	sd := scaledDeployment(manualScaler, deploy, replicas)
+	sd.Spec.Replicas = deploy.Spec.Replicas
+	if err := r.Patch(ctx, sd, client.MergeFrom(deploy)); err != nil {
+		return err
+	}
+	scales := r.Scales.Deployments(deploy.Namespace)
+	scale, err := scales.GetScale(deploy.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if scale.Spec.Replicas == replicas {
+		return nil
+	}
+	scale.Spec.Replicas = replicas
+	_, err = scales.UpdateScale(deploy.Name, scale)
+	return err
+}
+
+// overridingTrait returns the trait that takes precedence over the given one
+// among those scaling the same workload, or nil if there is none.
+func overridingTrait(manualScaler *oamv1alpha2.ManualScalerTrait,
+	traits []oamv1alpha2.ManualScalerTrait) *oamv1alpha2.ManualScalerTrait {
+	winner := manualScaler
+	for i := range traits {
+		t := &traits[i]
+		if t.UID == manualScaler.UID || t.DeletionTimestamp != nil ||
+			t.Spec.WorkloadReference.Name != manualScaler.Spec.WorkloadReference.Name {
+			continue
+		}
+		if takesPrecedence(t, winner) {
+			winner = t
+		}
+	}
+	if winner == manualScaler {
+		return nil
+	}
+	return winner
+}
+
+// a trait with a higher priority wins, ties are won by the oldest trait and
+// then by name so that the outcome is deterministic
+func takesPrecedence(a, b *oamv1alpha2.ManualScalerTrait) bool {
+	if a.Spec.Priority != b.Spec.Priority {
+		return a.Spec.Priority > b.Spec.Priority
+	}
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Name < b.Name
+}
+
+// enqueue the other traits scaling the same workload, so that they take over
+// once a trait overriding them changes or goes away
+func (r *ManualScalerTraitReconciler) siblingTraits(o handler.MapObject) []reconcile.Request {
+	trait, ok := o.Object.(*oamv1alpha2.ManualScalerTrait)
+	if !ok {
+		return nil
+	}
+	var traits oamv1alpha2.ManualScalerTraitList
+	if err := r.List(context.Background(), &traits, client.InNamespace(trait.Namespace)); err != nil {
+		r.Log.Error(err, "Failed to list the traits of a workload", "workload", trait.Spec.WorkloadReference.Name)
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, t := range traits.Items {
+		if t.UID != trait.UID && t.Spec.WorkloadReference.Name == trait.Spec.WorkloadReference.Name {
+			reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: t.Name,
+				Namespace: t.Namespace}})
+		}
+	}
+	return reqs
 }
 
 func (r *ManualScalerTraitReconciler) SetupWithManager(mgr ctrl.Manager) error {
@@ -159,5 +483,10 @@ func (r *ManualScalerTraitReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			OwnerType:    &oamv1alpha2.ManualScalerTrait{},
 			IsController: false, // we only added a owner reference to it as there can only be one
 		}).
-		Complete(r)
+		Watches(&source.Kind{
+			Type: &oamv1alpha2.ManualScalerTrait{},
+		}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.siblingTraits),
+		}).
+		Complete(r.Debug.Wrap("ManualScalerTrait", r))
 }
@@ -0,0 +1,314 @@
+package controllers
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/simtest"
+)
+
+func scalerTrait(name, workload string, priority int32, created time.Time) oamv1alpha2.ManualScalerTrait {
+	return oamv1alpha2.ManualScalerTrait{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			UID:               types.UID(name + "-uid"),
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: oamv1alpha2.ManualScalerTraitSpec{
+			Priority:          priority,
+			WorkloadReference: oamv1alpha2.ResourceReference{Name: workload},
+		},
+	}
+}
+
+func TestOverridingTrait(t *testing.T) {
+	now := time.Now()
+	older := now.Add(-time.Hour)
+	deleted := scalerTrait("deleted", "web", 10, now)
+	deleted.DeletionTimestamp = &metav1.Time{Time: now}
+
+	testCases := map[string]struct {
+		trait  oamv1alpha2.ManualScalerTrait
+		others []oamv1alpha2.ManualScalerTrait
+		want   string
+	}{
+		"Alone": {
+			trait: scalerTrait("a", "web", 0, now),
+		},
+		"HigherPriorityWins": {
+			trait:  scalerTrait("a", "web", 0, older),
+			others: []oamv1alpha2.ManualScalerTrait{scalerTrait("b", "web", 1, now)},
+			want:   "b",
+		},
+		"LowerPriorityLoses": {
+			trait:  scalerTrait("a", "web", 2, now),
+			others: []oamv1alpha2.ManualScalerTrait{scalerTrait("b", "web", 1, older)},
+		},
+		"OldestWinsTie": {
+			trait:  scalerTrait("a", "web", 1, now),
+			others: []oamv1alpha2.ManualScalerTrait{scalerTrait("b", "web", 1, older)},
+			want:   "b",
+		},
+		"NameBreaksTie": {
+			trait:  scalerTrait("b", "web", 1, now),
+			others: []oamv1alpha2.ManualScalerTrait{scalerTrait("a", "web", 1, now)},
+			want:   "a",
+		},
+		"OtherWorkload": {
+			trait:  scalerTrait("a", "web", 0, now),
+			others: []oamv1alpha2.ManualScalerTrait{scalerTrait("b", "db", 5, now)},
+		},
+		"DeletedTraitIgnored": {
+			trait:  scalerTrait("a", "web", 0, now),
+			others: []oamv1alpha2.ManualScalerTrait{deleted},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			traits := append([]oamv1alpha2.ManualScalerTrait{testCase.trait}, testCase.others...)
+			got := overridingTrait(&testCase.trait, traits)
+			if (got == nil && testCase.want != "") || (got != nil && got.Name != testCase.want) {
+				t.Errorf("overridingTrait() = %v, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestReplicaDrift(t *testing.T) {
+	replicas := func(n int32) *int32 { return &n }
+	testCases := map[string]struct {
+		deployReplicas *int32
+		want           string
+	}{
+		"InSync": {
+			deployReplicas: replicas(3),
+		},
+		"Drifted": {
+			deployReplicas: replicas(7),
+			want:           "suspended while deployment web has 7 replicas instead of 3",
+		},
+		"DefaultedReplicas": {
+			want: "suspended while deployment web has 1 replicas instead of 3",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			trait := scalerTrait("a", "web", 0, time.Now())
+			trait.Spec.ReplicaCount = 3
+			deploy := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "web"},
+				Spec:       appsv1.DeploymentSpec{Replicas: testCase.deployReplicas},
+			}
+			if got := replicaDrift(&trait, deploy); got != testCase.want {
+				t.Errorf("replicaDrift() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestScaledDeploymentReason(t *testing.T) {
+	replicas := func(n int32) *int32 { return &n }
+	testCases := map[string]struct {
+		deployReplicas *int32
+		annotations    map[string]string
+		modifiedBy     string
+		want           map[string]string
+	}{
+		"Scaled": {
+			deployReplicas: replicas(1),
+			want:           map[string]string{AnnotationScaleReason: "ManualScalerTrait a scaled from 1 to 3 replicas"},
+		},
+		"ScaledByUser": {
+			deployReplicas: replicas(1),
+			modifiedBy:     "alice@example.com",
+			want: map[string]string{AnnotationScaleReason: "ManualScalerTrait a scaled from 1 to 3 replicas, " +
+				"last modified by alice@example.com"},
+		},
+		"Unchanged": {
+			deployReplicas: replicas(3),
+			annotations:    map[string]string{AnnotationScaleReason: "earlier"},
+			want:           map[string]string{AnnotationScaleReason: "earlier"},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			trait := scalerTrait("a", "web", 0, time.Now())
+			trait.Kind = "ManualScalerTrait"
+			trait.Spec.ReplicaCount = 3
+			if testCase.modifiedBy != "" {
+				trait.Annotations = map[string]string{oamv1alpha2.AnnotationLastModifiedBy: testCase.modifiedBy}
+			}
+			deploy := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Annotations: testCase.annotations},
+				Spec:       appsv1.DeploymentSpec{Replicas: testCase.deployReplicas},
+			}
+			got := scaledDeployment(&trait, deploy, 3)
+			if !reflect.DeepEqual(got.Annotations, testCase.want) {
+				t.Errorf("scaledDeployment() annotations = %v, want %v", got.Annotations, testCase.want)
+			}
+			if *got.Spec.Replicas != 3 {
+				t.Errorf("scaledDeployment() replicas = %d, want 3", *got.Spec.Replicas)
+			}
+		})
+	}
+}
+
+func TestStepReplicas(t *testing.T) {
+	now := time.Now()
+	testCases := map[string]struct {
+		current  int32
+		target   int32
+		lastStep *time.Time
+		want     int32
+		wantWait time.Duration
+	}{
+		"FirstStepUp": {
+			current: 2, target: 20,
+			want: 7, wantWait: time.Minute,
+		},
+		"WithinInterval": {
+			current: 7, target: 20, lastStep: timePtr(now.Add(-20 * time.Second)),
+			want: 7, wantWait: 40 * time.Second,
+		},
+		"LastStep": {
+			current: 17, target: 20, lastStep: timePtr(now.Add(-time.Minute)),
+			want: 20,
+		},
+		"StepDown": {
+			current: 20, target: 2, lastStep: timePtr(now.Add(-time.Hour)),
+			want: 15, wantWait: time.Minute,
+		},
+		"SmallChange": {
+			current: 2, target: 4,
+			want: 4,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			trait := scalerTrait("a", "web", 0, now)
+			trait.Spec.ReplicaCount = testCase.target
+			step := int32(5)
+			trait.Spec.StepSize = &step
+			if testCase.lastStep != nil {
+				last := metav1.NewTime(*testCase.lastStep)
+				trait.Status.LastStepTime = &last
+			}
+			deploy := &appsv1.Deployment{Spec: appsv1.DeploymentSpec{Replicas: &testCase.current}}
+			got, wait := stepReplicas(&trait, deploy, now)
+			if got != testCase.want || wait != testCase.wantWait {
+				t.Errorf("stepReplicas() = %d, %s, want %d, %s", got, wait, testCase.want, testCase.wantWait)
+			}
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time { return &t }
+
+func TestZoneDeployment(t *testing.T) {
+	selector := map[string]string{OAMResourceNameLabel: "web-deployment"}
+	onLinux := corev1.NodeSelectorRequirement{Key: "kubernetes.io/os", Operator: corev1.NodeSelectorOpIn,
+		Values: []string{"linux"}}
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-deployment", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: selector},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: selector},
+				Spec: corev1.PodSpec{Affinity: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+							MatchExpressions: []corev1.NodeSelectorRequirement{onLinux},
+						}},
+					},
+				}}},
+			},
+		},
+	}
+
+	got := zoneDeployment(deploy, "zone-a", 3)
+	if got.Name != "web-deployment-zone-a" || *got.Spec.Replicas != 3 {
+		t.Errorf("zoneDeployment() = %s with %d replicas, want web-deployment-zone-a with 3", got.Name,
+			*got.Spec.Replicas)
+	}
+	wantLabels := map[string]string{OAMResourceNameLabel: "web-deployment", ZoneLabel: "zone-a"}
+	if !reflect.DeepEqual(got.Spec.Selector.MatchLabels, wantLabels) ||
+		!reflect.DeepEqual(got.Spec.Template.Labels, wantLabels) {
+		t.Errorf("zoneDeployment() selector %v, pod labels %v, want %v", got.Spec.Selector.MatchLabels,
+			got.Spec.Template.Labels, wantLabels)
+	}
+	wantTerms := []corev1.NodeSelectorTerm{{MatchExpressions: []corev1.NodeSelectorRequirement{onLinux, {
+		Key: corev1.LabelZoneFailureDomain, Operator: corev1.NodeSelectorOpIn, Values: []string{"zone-a"}}}}}
+	gotTerms := got.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.
+		NodeSelectorTerms
+	if !reflect.DeepEqual(gotTerms, wantTerms) {
+		t.Errorf("zoneDeployment() node selector terms = %v, want %v", gotTerms, wantTerms)
+	}
+	if len(deploy.Spec.Selector.MatchLabels) != 1 {
+		t.Errorf("zoneDeployment() modified the original deployment")
+	}
+}
+
+func TestManualScalerTraitReconcile(t *testing.T) {
+	replicas := int32(1)
+	workload := &oamv1alpha2.ContainerizedWorkload{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-deployment", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		},
+	}
+	trait := &oamv1alpha2.ManualScalerTrait{
+		ObjectMeta: metav1.ObjectMeta{Name: "scaler", Namespace: "default",
+			Annotations: map[string]string{oamv1alpha2.AnnotationLastModifiedBy: "alice"}},
+		Spec: oamv1alpha2.ManualScalerTraitSpec{ReplicaCount: 3},
+	}
+	h, err := simtest.New(workload, []runtime.Object{deploy})
+	if err != nil {
+		t.Fatal(err)
+	}
+	trait.Spec.WorkloadReference = h.WorkloadReference()
+	if err := h.Create(context.Background(), trait); err != nil {
+		t.Fatal(err)
+	}
+
+	events := record.NewFakeRecorder(1)
+	r := &ManualScalerTraitReconciler{Client: h, Log: ctrl.Log, Scheme: h.Scheme, Events: events}
+	if _, err := h.Reconcile(r, trait); err != nil {
+		t.Fatalf("Reconcile() = %v", err)
+	}
+	h.AssertPatched(t, deploy, "spec.replicas", 3)
+	select {
+	case e := <-events.Events:
+		if !strings.Contains(e, "scaled from 1 to 3 replicas, last modified by alice") {
+			t.Errorf("Reconcile() recorded %q", e)
+		}
+	default:
+		t.Errorf("Reconcile() recorded no scale event")
+	}
+
+	var got oamv1alpha2.ManualScalerTrait
+	if err := h.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "scaler"}, &got); err != nil {
+		t.Fatal(err)
+	}
+	if c := got.Status.GetCondition(cpv1alpha1.TypeSynced); c.Status != corev1.ConditionTrue {
+		t.Errorf("Reconcile() synced condition = %+v, want true", c)
+	}
+	if got.Status.Selector != "app=web" {
+		t.Errorf("Reconcile() selector = %q, want app=web", got.Status.Selector)
+	}
+}
@@ -0,0 +1,95 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// HorizontalPodAutoscaler error strings.
+const (
+	errListHPAs  = "cannot list the horizontal pod autoscalers"
+	errAdjustHPA = "cannot adjust the horizontal pod autoscaler"
+)
+
+// +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;patch
+
+// targetingHPAs returns the HorizontalPodAutoscalers scaling the deployment
+// directly, by name. Those scaling the trait through its scale subresource
+// work with it and are left out.
+func (r *ManualScalerTraitReconciler) targetingHPAs(ctx context.Context,
+	deploy *appsv1.Deployment) ([]autoscalingv1.HorizontalPodAutoscaler, error) {
+	var hpas autoscalingv1.HorizontalPodAutoscalerList
+	if err := r.List(ctx, &hpas, client.InNamespace(deploy.Namespace)); err != nil {
+		return nil, errors.Wrap(err, errListHPAs)
+	}
+	var targeting []autoscalingv1.HorizontalPodAutoscaler
+	for _, hpa := range hpas.Items {
+		ref := hpa.Spec.ScaleTargetRef
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil || gv.Group != appsv1.GroupName || ref.Kind != KindDeployment || ref.Name != deploy.Name {
+			continue
+		}
+		targeting = append(targeting, hpa)
+	}
+	sort.Slice(targeting, func(i, j int) bool { return targeting[i].Name < targeting[j].Name })
+	return targeting, nil
+}
+
+// yieldsToHPA tells whether the trait leaves scaling to the autoscalers
+// targeting its deployment. Zone replicas scale the deployment of the
+// workload to zero, which no autoscaler can be pinned to.
+func yieldsToHPA(manualScaler *oamv1alpha2.ManualScalerTrait, hpas []autoscalingv1.HorizontalPodAutoscaler) bool {
+	if len(hpas) == 0 {
+		return false
+	}
+	return manualScaler.Spec.HPAConflictPolicy != oamv1alpha2.HPAConflictAdjustHPA || workloadReplicas(manualScaler) < 1
+}
+
+// adjustHPAs pins the autoscalers to the replicas, so that they agree with
+// the trait, and describes the change.
+func (r *ManualScalerTraitReconciler) adjustHPAs(ctx context.Context, hpas []autoscalingv1.HorizontalPodAutoscaler,
+	replicas int32) (string, error) {
+	if len(hpas) == 0 {
+		return "", nil
+	}
+	names := make([]string, 0, len(hpas))
+	for i := range hpas {
+		hpa := &hpas[i]
+		names = append(names, hpa.Name)
+		if hpa.Spec.MinReplicas != nil && *hpa.Spec.MinReplicas == replicas && hpa.Spec.MaxReplicas == replicas {
+			continue
+		}
+		pinned := hpa.DeepCopy()
+		pinned.Spec.MinReplicas = &replicas
+		pinned.Spec.MaxReplicas = replicas
+		if err := r.Patch(ctx, pinned, client.MergeFrom(hpa)); err != nil {
+			return "", errors.Wrapf(err, "%s %s", errAdjustHPA, hpa.Name)
+		}
+	}
+	return fmt.Sprintf("pinned HorizontalPodAutoscaler %s to %d replicas", strings.Join(names, ", "), replicas), nil
+}
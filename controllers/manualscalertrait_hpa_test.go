@@ -0,0 +1,103 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/simtest"
+)
+
+func TestManualScalerTraitHPAConflict(t *testing.T) {
+	one := int32(1)
+	cases := map[string]struct {
+		policy oamv1alpha2.HPAConflictPolicy
+		target autoscalingv1.CrossVersionObjectReference
+		// whether the trait leaves the deployment to the autoscaler
+		ignored bool
+	}{
+		"YieldToHPA": {
+			target:  autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "web-deployment"},
+			ignored: true,
+		},
+		"AdjustHPA": {
+			policy: oamv1alpha2.HPAConflictAdjustHPA,
+			target: autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "web-deployment"},
+		},
+		"HPAOfAnotherDeployment": {
+			target: autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "other"},
+		},
+		"HPAOfTheTrait": {
+			target: autoscalingv1.CrossVersionObjectReference{
+				APIVersion: oamv1alpha2.GroupVersion.String(), Kind: "ManualScalerTrait", Name: "scaler"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			workload := &oamv1alpha2.ContainerizedWorkload{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+			deploy := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "web-deployment", Namespace: "default"},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &one,
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				},
+			}
+			hpa := &autoscalingv1.HorizontalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: "web-hpa", Namespace: "default"},
+				Spec: autoscalingv1.HorizontalPodAutoscalerSpec{
+					ScaleTargetRef: tc.target,
+					MinReplicas:    &one,
+					MaxReplicas:    10,
+				},
+			}
+			trait := &oamv1alpha2.ManualScalerTrait{
+				ObjectMeta: metav1.ObjectMeta{Name: "scaler", Namespace: "default"},
+				Spec:       oamv1alpha2.ManualScalerTraitSpec{ReplicaCount: 3, HPAConflictPolicy: tc.policy},
+			}
+			h, err := simtest.New(workload, []runtime.Object{deploy}, hpa)
+			if err != nil {
+				t.Fatal(err)
+			}
+			trait.Spec.WorkloadReference = h.WorkloadReference()
+			if err := h.Create(context.Background(), trait); err != nil {
+				t.Fatal(err)
+			}
+
+			r := &ManualScalerTraitReconciler{Client: h, Log: ctrl.Log, Scheme: h.Scheme}
+			if _, err := h.Reconcile(r, trait); err != nil {
+				t.Fatalf("Reconcile() = %v", err)
+			}
+			if tc.ignored {
+				h.AssertNotPatched(t, deploy)
+			} else {
+				h.AssertPatched(t, deploy, "spec.replicas", 3)
+			}
+			if tc.policy == oamv1alpha2.HPAConflictAdjustHPA {
+				h.AssertPatched(t, hpa, "spec.minReplicas", 3)
+				h.AssertPatched(t, hpa, "spec.maxReplicas", 3)
+			} else {
+				h.AssertNotPatched(t, hpa)
+			}
+
+			var got oamv1alpha2.ManualScalerTrait
+			if err := h.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "scaler"}, &got); err != nil {
+				t.Fatal(err)
+			}
+			want := corev1.ConditionFalse
+			if tc.ignored {
+				want = corev1.ConditionTrue
+			}
+			if c := got.Status.GetCondition(oamv1alpha2.TypeIgnoredDueToHPA); c.Status != want {
+				t.Errorf("Reconcile() %s condition = %+v, want %s", oamv1alpha2.TypeIgnoredDueToHPA, c, want)
+			}
+		})
+	}
+}
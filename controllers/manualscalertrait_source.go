@@ -0,0 +1,149 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// errReadReplicaSource is returned when the replicas cannot be read from the
+// replica source of a trait.
+const errReadReplicaSource = "cannot read the replicas from the replica source"
+
+// defaultSourceInterval between two reads of a replica source.
+const defaultSourceInterval = time.Minute
+
+// sourceClient polls the URLs of replica sources unless the reconciler has
+// its own client.
+var sourceClient = &http.Client{Timeout: 10 * time.Second}
+
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
+
+// sourceReplicas reads the replicas of the trait from its replica source,
+// unless it was read less than its interval ago, into the trait's status. It
+// returns when the source is to be read next, zero without a source.
+func (r *ManualScalerTraitReconciler) sourceReplicas(ctx context.Context, manualScaler *oamv1alpha2.ManualScalerTrait,
+	now time.Time) (time.Duration, error) {
+	src := manualScaler.Spec.ReplicaSource
+	if src == nil || len(manualScaler.Spec.ZoneReplicas) > 0 {
+		manualScaler.Status.SourcedReplicas = nil
+		manualScaler.Status.LastSourceTime = nil
+		return 0, nil
+	}
+	interval := defaultSourceInterval
+	if src.Interval != nil {
+		interval = src.Interval.Duration
+	}
+	if last := manualScaler.Status.LastSourceTime; last != nil && manualScaler.Status.SourcedReplicas != nil {
+		if wait := last.Add(interval).Sub(now); wait > 0 {
+			return wait, nil
+		}
+	}
+
+	var value string
+	switch {
+	case src.ConfigMapKeyRef != nil:
+		ref := src.ConfigMapKeyRef
+		var cm corev1.ConfigMap
+		key := client.ObjectKey{Namespace: manualScaler.Namespace, Name: ref.Name}
+		if err := r.Get(ctx, key, &cm); err != nil {
+			return 0, errors.Wrapf(err, "%s ConfigMap %s", errReadReplicaSource, ref.Name)
+		}
+		v, ok := cm.Data[ref.Key]
+		if !ok {
+			return 0, errors.Errorf("%s: ConfigMap %s has no key %s", errReadReplicaSource, ref.Name, ref.Key)
+		}
+		value = v
+	case src.URL != "":
+		v, err := r.fetchReplicas(ctx, src.URL)
+		if err != nil {
+			return 0, errors.Wrapf(err, "%s %s", errReadReplicaSource, src.URL)
+		}
+		value = v
+	default:
+		return 0, errors.Errorf("%s: neither a ConfigMap nor a URL is set", errReadReplicaSource)
+	}
+
+	replicas, err := parseReplicas(value)
+	if err != nil {
+		return 0, errors.Wrap(err, errReadReplicaSource)
+	}
+	manualScaler.Status.SourcedReplicas = &replicas
+	manualScaler.Status.LastSourceTime = &metav1.Time{Time: now}
+	return interval, nil
+}
+
+// fetchReplicas returns the body of the response of the URL.
+func (r *ManualScalerTraitReconciler) fetchReplicas(ctx context.Context, url string) (string, error) {
+	c := r.HTTPClient
+	if c == nil {
+		c = sourceClient
+	}
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := c.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	// replicas are a short number, do not read more than a few bytes
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, 64))
+	return string(body), err
+}
+
+// parseReplicas parses a replica count, capped at the most replicas a trait
+// scales a workload to.
+func parseReplicas(s string) (int32, error) {
+	n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 32)
+	if err != nil {
+		return 0, errors.Errorf("replicas %q are not a number", s)
+	}
+	if n < 0 {
+		return 0, errors.Errorf("replicas %d must not be negative", n)
+	}
+	if n > oamv1alpha2.MaxReplicaCount {
+		n = oamv1alpha2.MaxReplicaCount
+	}
+	return int32(n), nil
+}
+
+// replicaCount returns the replicas read from the trait's replica source, if
+// any, and its ReplicaCount otherwise.
+func replicaCount(manualScaler *oamv1alpha2.ManualScalerTrait) int32 {
+	if manualScaler.Spec.ReplicaSource != nil && manualScaler.Status.SourcedReplicas != nil {
+		return *manualScaler.Status.SourcedReplicas
+	}
+	return manualScaler.Spec.ReplicaCount
+}
@@ -0,0 +1,98 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/simtest"
+)
+
+func TestManualScalerTraitReplicaSource(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/web" {
+			http.NotFound(w, req)
+			return
+		}
+		fmt.Fprintln(w, "3")
+	}))
+	defer srv.Close()
+
+	capacity := func(key string) *oamv1alpha2.ReplicaSource {
+		return &oamv1alpha2.ReplicaSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "capacity"}, Key: key}}
+	}
+	cases := map[string]struct {
+		source *oamv1alpha2.ReplicaSource
+		// the replicas the deployment is scaled to, zero if it is not
+		want int32
+	}{
+		"ConfigMap":       {source: capacity("web"), want: 4},
+		"ConfigMapCapped": {source: capacity("huge"), want: oamv1alpha2.MaxReplicaCount},
+		"MissingKey":      {source: capacity("api")},
+		"URL":             {source: &oamv1alpha2.ReplicaSource{URL: srv.URL + "/web"}, want: 3},
+		"URLNotFound":     {source: &oamv1alpha2.ReplicaSource{URL: srv.URL + "/api"}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			one := int32(1)
+			workload := &oamv1alpha2.ContainerizedWorkload{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+			deploy := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "web-deployment", Namespace: "default"},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &one,
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				},
+			}
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "capacity", Namespace: "default"},
+				Data:       map[string]string{"web": "4", "huge": "100"},
+			}
+			trait := &oamv1alpha2.ManualScalerTrait{
+				ObjectMeta: metav1.ObjectMeta{Name: "scaler", Namespace: "default"},
+				Spec:       oamv1alpha2.ManualScalerTraitSpec{ReplicaCount: 2, ReplicaSource: tc.source},
+			}
+			h, err := simtest.New(workload, []runtime.Object{deploy}, cm)
+			if err != nil {
+				t.Fatal(err)
+			}
+			trait.Spec.WorkloadReference = h.WorkloadReference()
+			if err := h.Create(context.Background(), trait); err != nil {
+				t.Fatal(err)
+			}
+
+			r := &ManualScalerTraitReconciler{Client: h, Log: ctrl.Log, Scheme: h.Scheme, HTTPClient: srv.Client()}
+			if _, err := h.Reconcile(r, trait); err != nil {
+				t.Fatalf("Reconcile() = %v", err)
+			}
+			if tc.want == 0 {
+				h.AssertNotPatched(t, deploy)
+			} else {
+				h.AssertPatched(t, deploy, "spec.replicas", tc.want)
+			}
+
+			var got oamv1alpha2.ManualScalerTrait
+			if err := h.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "scaler"}, &got); err != nil {
+				t.Fatal(err)
+			}
+			want := corev1.ConditionTrue
+			if tc.want == 0 {
+				want = corev1.ConditionFalse
+			}
+			if c := got.Status.GetCondition(cpv1alpha1.TypeSynced); c.Status != want {
+				t.Errorf("Reconcile() %s condition = %+v, want %s", cpv1alpha1.TypeSynced, c, want)
+			}
+		})
+	}
+}
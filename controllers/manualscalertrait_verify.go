@@ -0,0 +1,78 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// errVerifyScale is returned when the deployment cannot be read while waiting
+// for its ready replicas.
+const errVerifyScale = "cannot verify the replicas of the deployment"
+
+// maxVerifyTimeout caps how long a reconcile waits for the ready replicas of
+// a deployment, the wait holds one of the controller's workers.
+const maxVerifyTimeout = 2 * time.Minute
+
+// verifyInterval between two reads of the deployment while waiting for its
+// ready replicas.
+var verifyInterval = time.Second
+
+// verifyScale waits for the ready replicas of the deployment to reach the
+// replicas it was scaled to, for up to the trait's verify timeout, and
+// returns the ScaleVerified condition describing how long they took or how
+// many were ready when it gave up.
+func (r *ManualScalerTraitReconciler) verifyScale(ctx context.Context, manualScaler *oamv1alpha2.ManualScalerTrait,
+	deploy *appsv1.Deployment, replicas int32) (cpv1alpha1.Condition, error) {
+	timeout := manualScaler.Spec.VerifyTimeout.Duration
+	if timeout > maxVerifyTimeout {
+		timeout = maxVerifyTimeout
+	}
+	start := time.Now()
+	key := client.ObjectKey{Namespace: deploy.Namespace, Name: deploy.Name}
+	var current appsv1.Deployment
+	err := wait.PollImmediate(verifyInterval, timeout, func() (bool, error) {
+		if err := r.Get(ctx, key, &current); err != nil {
+			return false, errors.Wrap(err, errVerifyScale)
+		}
+		return scaled(&current, replicas), nil
+	})
+	waited := time.Since(start).Round(time.Millisecond)
+	switch {
+	case err == wait.ErrWaitTimeout:
+		return oamv1alpha2.ScaleNotVerified(fmt.Sprintf("%d of %d replicas ready after %s",
+			current.Status.ReadyReplicas, replicas, waited)), nil
+	case err != nil:
+		return cpv1alpha1.Condition{}, err
+	}
+	return oamv1alpha2.ScaleVerified(fmt.Sprintf("%d replicas ready after %s", replicas, waited)), nil
+}
+
+// scaled returns true once the deployment controller observed the replicas
+// of the deployment and as many of its pods are ready.
+func scaled(deploy *appsv1.Deployment, replicas int32) bool {
+	return deploy.Status.ObservedGeneration >= deploy.Generation && deploy.Status.ReadyReplicas == replicas
+}
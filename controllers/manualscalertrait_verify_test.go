@@ -0,0 +1,95 @@
+package controllers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/simtest"
+)
+
+func TestManualScalerTraitVerifyScale(t *testing.T) {
+	verifyInterval = time.Millisecond
+	three := int32(3)
+	cases := map[string]struct {
+		ready     int32
+		verified  corev1.ConditionStatus
+		synced    corev1.ConditionStatus
+		requeue   bool
+		inMessage string
+	}{
+		"Ready": {
+			ready:     3,
+			verified:  corev1.ConditionTrue,
+			synced:    corev1.ConditionTrue,
+			inMessage: "3 replicas ready after",
+		},
+		"NotReady": {
+			ready:     1,
+			verified:  corev1.ConditionFalse,
+			synced:    corev1.ConditionFalse,
+			requeue:   true,
+			inMessage: "1 of 3 replicas ready after",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			workload := &oamv1alpha2.ContainerizedWorkload{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+			deploy := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "web-deployment", Namespace: "default"},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &three,
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				},
+				Status: appsv1.DeploymentStatus{Replicas: 3, ReadyReplicas: tc.ready},
+			}
+			trait := &oamv1alpha2.ManualScalerTrait{
+				ObjectMeta: metav1.ObjectMeta{Name: "scaler", Namespace: "default"},
+				Spec: oamv1alpha2.ManualScalerTraitSpec{
+					ReplicaCount:  3,
+					VerifyTimeout: &metav1.Duration{Duration: 20 * time.Millisecond},
+				},
+			}
+			h, err := simtest.New(workload, []runtime.Object{deploy})
+			if err != nil {
+				t.Fatal(err)
+			}
+			trait.Spec.WorkloadReference = h.WorkloadReference()
+			if err := h.Create(context.Background(), trait); err != nil {
+				t.Fatal(err)
+			}
+
+			r := &ManualScalerTraitReconciler{Client: h, Log: ctrl.Log, Scheme: h.Scheme}
+			result, err := h.Reconcile(r, trait)
+			if err != nil {
+				t.Fatalf("Reconcile() = %v", err)
+			}
+			if got := result.RequeueAfter > 0; got != tc.requeue {
+				t.Errorf("Reconcile() requeue after = %s, want requeue %t", result.RequeueAfter, tc.requeue)
+			}
+
+			var got oamv1alpha2.ManualScalerTrait
+			if err := h.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "scaler"}, &got); err != nil {
+				t.Fatal(err)
+			}
+			c := got.Status.GetCondition(oamv1alpha2.TypeScaleVerified)
+			if c.Status != tc.verified || !strings.Contains(c.Message, tc.inMessage) {
+				t.Errorf("Reconcile() %s condition = %+v, want %s with %q", oamv1alpha2.TypeScaleVerified, c,
+					tc.verified, tc.inMessage)
+			}
+			if c := got.Status.GetCondition(cpv1alpha1.TypeSynced); c.Status != tc.synced {
+				t.Errorf("Reconcile() %s condition = %+v, want %s", cpv1alpha1.TypeSynced, c, tc.synced)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+const (
+	// ZoneLabel holds the zone the pods of a zone deployment run in.
+	ZoneLabel = "core.oam.dev/zone"
+	// ZoneDeploymentOfLabel holds the name of the workload deployment a
+	// zone deployment was copied from.
+	ZoneDeploymentOfLabel = "core.oam.dev/zone-deployment-of"
+)
+
+// zoneDeployment renders a copy of the workload's deployment running the given
+// replicas on the nodes of a zone. Its pods keep the labels of the workload so
+// that the workload's service routes to them.
+func zoneDeployment(deploy *appsv1.Deployment, zone string, replicas int32) *appsv1.Deployment {
+	zd := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+			Kind:       KindDeployment,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deploy.Name + "-" + zone,
+			Namespace: deploy.Namespace,
+			Labels:    map[string]string{ZoneDeploymentOfLabel: deploy.Name, ZoneLabel: zone},
+		},
+		Spec: *deploy.Spec.DeepCopy(),
+	}
+	zd.Spec.Replicas = &replicas
+
+	// the zone label keeps the selectors of the zone deployments apart
+	if zd.Spec.Selector == nil {
+		zd.Spec.Selector = &metav1.LabelSelector{}
+	}
+	if zd.Spec.Selector.MatchLabels == nil {
+		zd.Spec.Selector.MatchLabels = map[string]string{}
+	}
+	zd.Spec.Selector.MatchLabels[ZoneLabel] = zone
+	if zd.Spec.Template.Labels == nil {
+		zd.Spec.Template.Labels = map[string]string{}
+	}
+	zd.Spec.Template.Labels[ZoneLabel] = zone
+
+	pinToZone(&zd.Spec.Template.Spec, zone)
+	return zd
+}
+
+// pinToZone requires the pod to run on a node of the zone, on top of any node
+// affinity it already has
+func pinToZone(spec *corev1.PodSpec, zone string) {
+	inZone := corev1.NodeSelectorRequirement{
+		Key:      corev1.LabelZoneFailureDomain,
+		Operator: corev1.NodeSelectorOpIn,
+		Values:   []string{zone},
+	}
+	if spec.Affinity == nil {
+		spec.Affinity = &corev1.Affinity{}
+	}
+	if spec.Affinity.NodeAffinity == nil {
+		spec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	na := spec.Affinity.NodeAffinity
+	if na.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		na.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{}
+	}
+	ns := na.RequiredDuringSchedulingIgnoredDuringExecution
+	if len(ns.NodeSelectorTerms) == 0 {
+		ns.NodeSelectorTerms = []corev1.NodeSelectorTerm{{}}
+	}
+	// terms are ORed, so every one of them must require the zone
+	for i := range ns.NodeSelectorTerms {
+		ns.NodeSelectorTerms[i].MatchExpressions = append(ns.NodeSelectorTerms[i].MatchExpressions, inZone)
+	}
+}
+
+// applyZoneDeployments applies a zone deployment for every zone of the trait
+// and deletes those of zones it no longer has
+func (r *ManualScalerTraitReconciler) applyZoneDeployments(ctx context.Context,
+	manualScaler *oamv1alpha2.ManualScalerTrait, deploy *appsv1.Deployment) error {
+	for zone, replicas := range manualScaler.Spec.ZoneReplicas {
+		zd := zoneDeployment(deploy, zone, replicas)
+		if err := ctrl.SetControllerReference(manualScaler, zd, r.Scheme); err != nil {
+			return err
+		}
+		// server side apply, only the fields we set are touched
+		applyOpts := []client.PatchOption{client.ForceOwnership, client.FieldOwner(manualScaler.Name)}
+		if err := r.Patch(ctx, zd, client.Apply, applyOpts...); err != nil {
+			return err
+		}
+	}
+
+	var zoneDeploys appsv1.DeploymentList
+	if err := r.List(ctx, &zoneDeploys, client.InNamespace(deploy.Namespace),
+		client.MatchingLabels{ZoneDeploymentOfLabel: deploy.Name}); err != nil {
+		return err
+	}
+	for i := range zoneDeploys.Items {
+		zd := &zoneDeploys.Items[i]
+		if _, ok := manualScaler.Spec.ZoneReplicas[zd.Labels[ZoneLabel]]; ok ||
+			!metav1.IsControlledBy(zd, manualScaler) {
+			continue
+		}
+		if err := r.Delete(ctx, zd); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
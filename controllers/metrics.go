@@ -0,0 +1,137 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// Results of a child apply.
+const (
+	applyCreated   = "created"
+	applyUpdated   = "updated"
+	applyUnchanged = "unchanged"
+)
+
+// Results of the resolution of the deployment of a workload by a trait.
+const (
+	resolutionCached   = "cached"
+	resolutionResolved = "resolved"
+)
+
+// Results of a workload lookup by a trait.
+const (
+	lookupHit         = "hit"
+	lookupNameMatch   = "name_match"
+	lookupNotFound    = "not_found"
+	lookupUIDMismatch = "uid_mismatch"
+)
+
+var (
+	renderDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "oam_workload_render_duration_seconds",
+		Help:    "Time taken to render the children of a workload, by workload kind.",
+		Buckets: prometheus.ExponentialBuckets(0.001, 2, 12),
+	}, []string{"kind"})
+
+	childApplies = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "oam_child_applies_total",
+		Help: "Applies of the children of workloads, by child kind and whether they created, updated or left the child unchanged.",
+	}, []string{"kind", "result"})
+
+	workloadLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "oam_workload_lookups_total",
+		Help: "Lookups of the workload a trait refers to in the informer cache, by whether the cached workload was found and matched the reference.",
+	}, []string{"result"})
+
+	deploymentResolutions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "oam_deployment_resolutions_total",
+		Help: "Resolutions of the deployment of the workload a trait refers to, by whether a cached resolution was used or the workload's resources were looked up.",
+	}, []string{"result"})
+
+	replicasGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "oam_workload_replicas",
+		Help: "Replicas of the deployments of a ContainerizedWorkload.",
+	}, []string{"namespace", "workload"})
+
+	readyReplicasGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "oam_workload_ready_replicas",
+		Help: "Ready replicas of the deployments of a ContainerizedWorkload.",
+	}, []string{"namespace", "workload"})
+
+	restartsGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "oam_workload_container_restarts",
+		Help: "Restarts of the containers of the pods of a ContainerizedWorkload.",
+	}, []string{"namespace", "workload"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(renderDuration, childApplies, workloadLookups, deploymentResolutions,
+		replicasGauge, readyReplicasGauge, restartsGauge)
+}
+
+// observeWorkload exports the replicas and restarts in the status of the
+// workload.
+func observeWorkload(namespace, name string, status *oamv1alpha2.ContainerizedWorkloadStatus) {
+	replicasGauge.WithLabelValues(namespace, name).Set(float64(status.Replicas))
+	readyReplicasGauge.WithLabelValues(namespace, name).Set(float64(status.ReadyReplicas))
+	restartsGauge.WithLabelValues(namespace, name).Set(float64(status.Restarts))
+}
+
+// forgetWorkload stops exporting the replicas and restarts of a deleted
+// workload.
+func forgetWorkload(namespace, name string) {
+	replicasGauge.DeleteLabelValues(namespace, name)
+	readyReplicasGauge.DeleteLabelValues(namespace, name)
+	restartsGauge.DeleteLabelValues(namespace, name)
+}
+
+// observeRender records the time taken to render a workload of the kind since
+// start.
+func observeRender(kind string, start time.Time) {
+	renderDuration.WithLabelValues(kind).Observe(time.Since(start).Seconds())
+}
+
+// applyChild server side applies the child and counts the apply by whether it
+// created, updated or did not change the child. The existing child is read
+// from the cache, so an apply racing with another change may be misfiled.
+func applyChild(ctx context.Context, c client.Client, scheme *runtime.Scheme, obj runtime.Object,
+	opts ...client.PatchOption) error {
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	existing := obj.DeepCopyObject()
+	result := applyUpdated
+	var before string
+	err = c.Get(ctx, client.ObjectKey{Namespace: m.GetNamespace(), Name: m.GetName()}, existing)
+	switch {
+	case apierrors.IsNotFound(err):
+		result = applyCreated
+	case err == nil:
+		if em, err := meta.Accessor(existing); err == nil {
+			before = em.GetResourceVersion()
+		}
+	}
+	if err := c.Patch(ctx, obj, client.Apply, opts...); err != nil {
+		return err
+	}
+	if result == applyUpdated && before != "" && m.GetResourceVersion() == before {
+		result = applyUnchanged
+	}
+	kind := "unknown"
+	if gvk, err := apiutil.GVKForObject(obj, scheme); err == nil {
+		kind = gvk.Kind
+	}
+	childApplies.WithLabelValues(kind, result).Inc()
+	return nil
+}
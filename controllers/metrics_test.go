@@ -0,0 +1,46 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/simtest"
+)
+
+func TestApplyChild(t *testing.T) {
+	ctx := context.Background()
+	workload := &oamv1alpha2.ContainerizedWorkload{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	h, err := simtest.New(workload, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	count := func(result string) float64 {
+		return testutil.ToFloat64(childApplies.WithLabelValues(KindService, result))
+	}
+	created, updated := count(applyCreated), count(applyUpdated)
+
+	service := func(port int32) *corev1.Service {
+		return &corev1.Service{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: KindService},
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: port}}},
+		}
+	}
+	if err := applyChild(ctx, h, h.Scheme, service(80)); err != nil {
+		t.Fatalf("applyChild() = %v", err)
+	}
+	if err := applyChild(ctx, h, h.Scheme, service(8080)); err != nil {
+		t.Fatalf("applyChild() = %v", err)
+	}
+	if got := count(applyCreated) - created; got != 1 {
+		t.Errorf("applyChild() counted %v creates, want 1", got)
+	}
+	if got := count(applyUpdated) - updated; got != 1 {
+		t.Errorf("applyChild() counted %v updates, want 1", got)
+	}
+}
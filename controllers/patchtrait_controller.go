@@ -0,0 +1,201 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/debug"
+)
+
+// Reconcile error strings.
+const (
+	errDecodePatch     = "cannot decode the patch"
+	errPatchNotAllowed = "the patch touches paths that are not allowed"
+	errPatchTemplate   = "cannot patch the pod template"
+	errApplyPatch      = "cannot apply the patch to the deployment"
+)
+
+// DefaultPatchTraitAllowedPaths are the pod template paths a PatchTrait may
+// change unless the manager is configured otherwise.
+var DefaultPatchTraitAllowedPaths = []string{"metadata.labels", "metadata.annotations"}
+
+// PatchTraitReconciler reconciles a PatchTrait object
+type PatchTraitReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// Limiter, if set, rate limits the writes of child resources per namespace.
+	Limiter *NamespaceWriteLimiter
+	// Debug, if set, records the outcome of each reconcile.
+	Debug *debug.Recorder
+	// Workloads, if set, resolves the workload the trait refers to, see
+	// WorkloadResolver.
+	Workloads *WorkloadResolver
+	// AllowedPaths of the pod template, in dotted notation, that patches may
+	// change. Everything below an allowed path may be changed.
+	AllowedPaths []string
+}
+
+// +kubebuilder:rbac:groups=core.oam.dev,resources=patchtraits,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=core.oam.dev,resources=patchtraits/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
+
+func (r *PatchTraitReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("patch trait", req.NamespacedName)
+	log.Info("Reconcile patch trait")
+
+	var trait oamv1alpha2.PatchTrait
+	if err := r.Get(ctx, req.NamespacedName, &trait); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	deleted, err := r.Workloads.finalizeManagedFields(ctx, r, log, &trait, kindPatchTrait, trait.Spec.WorkloadReference)
+	if err != nil {
+		log.Error(err, "Failed to revert the fields set by the trait")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, err
+	}
+	if deleted {
+		return ctrl.Result{}, nil
+	}
+
+	var patch map[string]interface{}
+	if err := json.Unmarshal(trait.Spec.Patch.Raw, &patch); err != nil {
+		trait.Status.SetConditions(reconcileError(errors.Wrap(err, errDecodePatch))...)
+		return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &trait), errUpdateStatus)
+	}
+	if paths := disallowedPaths(patch, r.AllowedPaths); len(paths) > 0 {
+		trait.Status.SetConditions(reconcileError(
+			errors.Errorf("%s: %s", errPatchNotAllowed, strings.Join(paths, ", ")))...)
+		log.Info("Patch is not allowed", "paths", paths)
+		return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &trait), errUpdateStatus)
+	}
+
+	deploy, err := r.Workloads.fetchWorkloadDeployment(ctx, r, log, req.Namespace, trait.Spec.WorkloadReference)
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+
+	if result, ok, err := gateReconcile(ctx, r, log, r.Limiter, kindPatchTrait, &trait, &trait.Status.ConditionedStatus,
+		&trait.Spec.WorkloadReference); !ok {
+		return result, err
+	}
+
+	// merge the patched deployment, refetching it if it changed under us
+	err = retryTransient(applyBackoff, func() error {
+		return r.Get(ctx, client.ObjectKey{Name: deploy.Name, Namespace: deploy.Namespace}, deploy)
+	}, func() error {
+		pd, err := patchedDeployment(&trait, deploy)
+		if err != nil {
+			return err
+		}
+		if err := recordManagedFields(deploy, pd, managedFieldsKey(kindPatchTrait, trait.Name)); err != nil {
+			return err
+		}
+		return errors.Wrap(r.Patch(ctx, pd, client.MergeFrom(deploy)), errApplyPatch)
+	})
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Failed to patch a deployment")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	log.Info("Successfully patched a deployment", "UID", deploy.UID)
+
+	r.Limiter.MarkForceSynced(&trait)
+	trait.Status.SetConditions(oamv1alpha2.PermissionGranted(), cpv1alpha1.ReconcileSuccess())
+	return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &trait), errUpdateStatus)
+}
+
+// disallowedPaths returns the sorted paths of the patch that are not below one
+// of the allowed paths
+func disallowedPaths(patch map[string]interface{}, allowed []string) []string {
+	var paths []string
+	var walk func(path string, v interface{})
+	walk = func(path string, v interface{}) {
+		for _, a := range allowed {
+			if path == a || strings.HasPrefix(path, a+".") {
+				return
+			}
+		}
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			paths = append(paths, path)
+			return
+		}
+		for k, child := range m {
+			walk(path+"."+k, child)
+		}
+	}
+	for k, v := range patch {
+		walk(k, v)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// patchedDeployment returns a copy of the deployment with the trait's patch
+// applied to its pod template
+func patchedDeployment(trait *oamv1alpha2.PatchTrait, deploy *appsv1.Deployment) (*appsv1.Deployment, error) {
+	original, err := json.Marshal(deploy.Spec.Template)
+	if err != nil {
+		return nil, errors.Wrap(err, errPatchTemplate)
+	}
+	patched, err := strategicpatch.StrategicMergePatch(original, trait.Spec.Patch.Raw, corev1.PodTemplateSpec{})
+	if err != nil {
+		return nil, errors.Wrap(err, errPatchTemplate)
+	}
+
+	pd := deploy.DeepCopy()
+	pd.Spec.Template = corev1.PodTemplateSpec{}
+	if err := json.Unmarshal(patched, &pd.Spec.Template); err != nil {
+		return nil, errors.Wrap(err, errPatchTemplate)
+	}
+	// always set the owner reference so that we can watch this deployment
+	setTraitOwnerReference(pd, trait.APIVersion, trait.Kind, trait)
+	return pd, nil
+}
+
+func (r *PatchTraitReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&oamv1alpha2.PatchTrait{}).
+		Watches(&source.Kind{
+			Type: &appsv1.Deployment{},
+		}, &handler.EnqueueRequestForOwner{
+			OwnerType:    &oamv1alpha2.PatchTrait{},
+			IsController: false, // other traits may own the deployment too
+		}).
+		Complete(r.Debug.Wrap("PatchTrait", r))
+}
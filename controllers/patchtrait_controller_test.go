@@ -0,0 +1,91 @@
+package controllers
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestDisallowedPaths(t *testing.T) {
+	allowed := []string{"metadata.annotations", "spec.containers"}
+	testCases := map[string]struct {
+		patch string
+		want  []string
+	}{
+		"Allowed": {
+			patch: `{"metadata": {"annotations": {"sidecar.istio.io/inject": "false"}},
+				"spec": {"containers": [{"name": "nginx", "image": "nginx:1.19"}]}}`,
+		},
+		"NotAllowed": {
+			patch: `{"metadata": {"labels": {"app": "web"}}, "spec": {"hostNetwork": true}}`,
+			want:  []string{"metadata.labels.app", "spec.hostNetwork"},
+		},
+		"PrefixIsNotAParent": {
+			patch: `{"metadata": {"annotationsExtra": "x"}}`,
+			want:  []string{"metadata.annotationsExtra"},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var patch map[string]interface{}
+			if err := json.Unmarshal([]byte(testCase.patch), &patch); err != nil {
+				t.Fatal(err)
+			}
+			if got := disallowedPaths(patch, allowed); !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("disallowedPaths() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestPatchedDeployment(t *testing.T) {
+	trait := &oamv1alpha2.PatchTrait{
+		TypeMeta:   metav1.TypeMeta{APIVersion: oamv1alpha2.GroupVersion.String(), Kind: "PatchTrait"},
+		ObjectMeta: metav1.ObjectMeta{Name: "patch", UID: "trait-uid"},
+		Spec: oamv1alpha2.PatchTraitSpec{
+			Patch: runtime.RawExtension{Raw: []byte(`{"metadata": {"annotations": {"team": "web"}},
+				"spec": {"containers": [{"name": "nginx", "image": "nginx:1.19"}]}}`)},
+		},
+	}
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-deployment"},
+		Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "test"}},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{
+				{Name: "nginx", Image: "nginx"},
+				{Name: "sidecar", Image: "envoy"},
+			}},
+		}},
+	}
+
+	got, err := patchedDeployment(trait, deploy)
+	if err != nil {
+		t.Fatalf("patchedDeployment() error = %v", err)
+	}
+	want := corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      map[string]string{"app": "test"},
+			Annotations: map[string]string{"team": "web"},
+		},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{Name: "nginx", Image: "nginx:1.19"},
+			{Name: "sidecar", Image: "envoy"},
+		}},
+	}
+	if !reflect.DeepEqual(got.Spec.Template, want) {
+		t.Errorf("patchedDeployment() template = %+v, want %+v", got.Spec.Template, want)
+	}
+	if refs := got.GetOwnerReferences(); len(refs) != 1 || refs[0].UID != trait.UID || *refs[0].Controller {
+		t.Errorf("patchedDeployment() owner references = %v", refs)
+	}
+	if len(deploy.Spec.Template.Annotations) != 0 {
+		t.Errorf("patchedDeployment() modified the original deployment")
+	}
+}
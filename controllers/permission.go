@@ -0,0 +1,49 @@
+package controllers
+
+import (
+	"fmt"
+	"regexp"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// the part of a forbidden message naming what the authorizer denied, e.g.
+// cannot get resource "deployments" in API group "apps" in the namespace "default"
+var forbiddenRequest = regexp.MustCompile(
+	`cannot (\S+) resource "([^"]*)" in API group "([^"]*)"(?: in the namespace "([^"]*)")?`)
+
+// reconcileError returns the conditions of a reconcile that failed with err, a
+// ReconcileError along with whether the API server forbade the request
+func reconcileError(err error) []cpv1alpha1.Condition {
+	return []cpv1alpha1.Condition{cpv1alpha1.ReconcileError(err), permissionCondition(err)}
+}
+
+// permissionCondition returns PermissionDenied, naming the verb and resource
+// the controller is missing, if err is or wraps a forbidden API error
+func permissionCondition(err error) cpv1alpha1.Condition {
+	cause := errors.Cause(err)
+	if !apierrors.IsForbidden(cause) {
+		return oamv1alpha2.PermissionGranted()
+	}
+	return oamv1alpha2.PermissionDenied(missingPermission(cause))
+}
+
+// missingPermission describes the permission a forbidden error lacks
+func missingPermission(err error) string {
+	m := forbiddenRequest.FindStringSubmatch(err.Error())
+	if m == nil {
+		return err.Error()
+	}
+	verb, resource, group, namespace := m[1], m[2], m[3], m[4]
+	if group != "" {
+		resource += "." + group
+	}
+	if namespace == "" {
+		return fmt.Sprintf("missing permission to %s %s", verb, resource)
+	}
+	return fmt.Sprintf("missing permission to %s %s in namespace %s", verb, resource, namespace)
+}
@@ -0,0 +1,47 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestPermissionCondition(t *testing.T) {
+	deployments := schema.GroupResource{Group: "apps", Resource: "deployments"}
+	testCases := map[string]struct {
+		err        error
+		wantStatus corev1.ConditionStatus
+		wantMsg    string
+	}{
+		"Forbidden": {
+			err: errors.Wrap(apierrors.NewForbidden(deployments, "web", errors.New(
+				`User "system:serviceaccount:oam-system:default" cannot patch resource "deployments" `+
+					`in API group "apps" in the namespace "default"`)), errLocateDeployment),
+			wantStatus: corev1.ConditionTrue,
+			wantMsg:    "missing permission to patch deployments.apps in namespace default",
+		},
+		"ForbiddenCoreClusterScope": {
+			err: apierrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "default", errors.New(
+				`User "system:serviceaccount:oam-system:default" cannot get resource "namespaces" `+
+					`in API group "" at the cluster scope`)),
+			wantStatus: corev1.ConditionTrue,
+			wantMsg:    "missing permission to get namespaces",
+		},
+		"OtherError": {
+			err:        errors.Wrap(apierrors.NewNotFound(deployments, "web"), errLocateDeployment),
+			wantStatus: corev1.ConditionFalse,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := permissionCondition(testCase.err)
+			if got.Status != testCase.wantStatus || got.Message != testCase.wantMsg {
+				t.Errorf("permissionCondition() = %s %q, want %s %q", got.Status, got.Message,
+					testCase.wantStatus, testCase.wantMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/debug"
+)
+
+// Reconcile error strings.
+const (
+	errPriorityClassDeployment = "cannot set the priority class of the deployment"
+)
+
+// PriorityClassTraitReconciler reconciles a PriorityClassTrait object
+type PriorityClassTraitReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// Limiter, if set, rate limits the writes of child resources per namespace.
+	Limiter *NamespaceWriteLimiter
+	// Debug, if set, records the outcome of each reconcile.
+	Debug *debug.Recorder
+	// Workloads, if set, resolves the workload the trait refers to, see
+	// WorkloadResolver.
+	Workloads *WorkloadResolver
+}
+
+// +kubebuilder:rbac:groups=core.oam.dev,resources=priorityclasstraits,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=core.oam.dev,resources=priorityclasstraits/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
+
+func (r *PriorityClassTraitReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("priority class trait", req.NamespacedName)
+	log.Info("Reconcile priority class trait")
+
+	var trait oamv1alpha2.PriorityClassTrait
+	if err := r.Get(ctx, req.NamespacedName, &trait); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	deleted, err := r.Workloads.finalizeManagedFields(ctx, r, log,
+		&trait, kindPriorityClassTrait, trait.Spec.WorkloadReference)
+	if err != nil {
+		log.Error(err, "Failed to revert the fields set by the trait")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, err
+	}
+	if deleted {
+		return ctrl.Result{}, nil
+	}
+
+	deploy, err := r.Workloads.fetchWorkloadDeployment(ctx, r, log, req.Namespace, trait.Spec.WorkloadReference)
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+
+	if result, ok, err := gateReconcile(ctx, r, log, r.Limiter, kindPriorityClassTrait, &trait, &trait.Status.ConditionedStatus,
+		&trait.Spec.WorkloadReference); !ok {
+		return result, err
+	}
+
+	// set the priority class, refetching the deployment if it changed under us
+	err = retryTransient(applyBackoff, func() error {
+		return r.Get(ctx, client.ObjectKey{Name: deploy.Name, Namespace: deploy.Namespace}, deploy)
+	}, func() error {
+		pd := priorityClassDeployment(&trait, deploy)
+		if err := recordManagedFields(deploy, pd, managedFieldsKey(kindPriorityClassTrait, trait.Name)); err != nil {
+			return err
+		}
+		return r.Patch(ctx, pd, client.MergeFrom(deploy))
+	})
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(errors.Wrap(err, errPriorityClassDeployment))...)
+		log.Error(err, "Failed to set the priority class of a deployment")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	log.Info("Successfully set the priority class of a deployment", "UID", deploy.UID)
+
+	r.Limiter.MarkForceSynced(&trait)
+	trait.Status.SetConditions(oamv1alpha2.PermissionGranted(), cpv1alpha1.ReconcileSuccess())
+	return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &trait), errUpdateStatus)
+}
+
+// priorityClassDeployment returns a copy of the deployment whose pods are
+// scheduled with the trait's priority class and preemption policy
+func priorityClassDeployment(trait *oamv1alpha2.PriorityClassTrait, deploy *appsv1.Deployment) *appsv1.Deployment {
+	pd := deploy.DeepCopy()
+	spec := &pd.Spec.Template.Spec
+	spec.PriorityClassName = trait.Spec.PriorityClassName
+	if p := trait.Spec.PreemptionPolicy; p != nil {
+		policy := *p
+		spec.PreemptionPolicy = &policy
+	}
+	// always set the owner reference so that we can watch this deployment
+	setTraitOwnerReference(pd, trait.APIVersion, trait.Kind, trait)
+	return pd
+}
+
+func (r *PriorityClassTraitReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&oamv1alpha2.PriorityClassTrait{}).
+		Watches(&source.Kind{
+			Type: &appsv1.Deployment{},
+		}, &handler.EnqueueRequestForOwner{
+			OwnerType:    &oamv1alpha2.PriorityClassTrait{},
+			IsController: false, // other traits may own the deployment too
+		}).
+		Complete(r.Debug.Wrap("PriorityClassTrait", r))
+}
@@ -0,0 +1,36 @@
+package controllers
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestPriorityClassDeployment(t *testing.T) {
+	never := corev1.PreemptNever
+	trait := &oamv1alpha2.PriorityClassTrait{
+		TypeMeta:   metav1.TypeMeta{APIVersion: oamv1alpha2.GroupVersion.String(), Kind: "PriorityClassTrait"},
+		ObjectMeta: metav1.ObjectMeta{Name: "priority", UID: "trait-uid"},
+		Spec:       oamv1alpha2.PriorityClassTraitSpec{PriorityClassName: "business-critical", PreemptionPolicy: &never},
+	}
+	deploy := &appsv1.Deployment{}
+
+	got := priorityClassDeployment(trait, deploy)
+	spec := got.Spec.Template.Spec
+	if spec.PriorityClassName != "business-critical" {
+		t.Errorf("priorityClassDeployment() priority class = %q, want business-critical", spec.PriorityClassName)
+	}
+	if spec.PreemptionPolicy == nil || *spec.PreemptionPolicy != corev1.PreemptNever {
+		t.Errorf("priorityClassDeployment() preemption policy = %v, want Never", spec.PreemptionPolicy)
+	}
+	if refs := got.GetOwnerReferences(); len(refs) != 1 || refs[0].UID != trait.UID {
+		t.Errorf("priorityClassDeployment() owner references = %v", refs)
+	}
+	if deploy.Spec.Template.Spec.PriorityClassName != "" {
+		t.Errorf("priorityClassDeployment() modified the original deployment")
+	}
+}
@@ -0,0 +1,122 @@
+package controllers
+
+import (
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/client-go/util/flowcontrol"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// throttledWait is how long a reconcile that ran out of write tokens waits
+// before it is retried.
+const throttledWait = time.Second
+
+// minBucketIdle is the shortest time a namespace's bucket is kept unused
+// before it is evicted.
+const minBucketIdle = time.Minute
+
+// The force sync annotations seen are kept for up to maxForceSyncs objects,
+// and for forceSyncRetention after an object was last seen. The retention
+// outlasts the manager's periodic resync, so only the objects that are gone
+// expire.
+const (
+	maxForceSyncs      = 10000
+	forceSyncRetention = 24 * time.Hour
+)
+
+// A NamespaceWriteLimiter rate limits the writes of child resources with a
+// token bucket per namespace, so one namespace's churn does not starve the
+// reconciliation of others.
+type NamespaceWriteLimiter struct {
+	qps   float32
+	burst int
+
+	// buckets unused for longer than idle have refilled and are evicted,
+	// as a new bucket would start out the same.
+	idle time.Duration
+	now  func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*namespaceBucket
+	swept   time.Time
+	// the last force sync annotation seen on each object, by UID
+	forceSyncs *cache.LRUExpireCache
+}
+
+// NewNamespaceWriteLimiter returns a limiter allowing qps writes per second
+// with bursts of up to burst writes in every namespace.
+func NewNamespaceWriteLimiter(qps float32, burst int) *NamespaceWriteLimiter {
+	idle := time.Duration(float64(burst) / float64(qps) * float64(time.Second))
+	if idle < minBucketIdle {
+		idle = minBucketIdle
+	}
+	return &NamespaceWriteLimiter{qps: qps, burst: burst, idle: idle, now: time.Now,
+		buckets: map[string]*namespaceBucket{}, forceSyncs: cache.NewLRUExpireCache(maxForceSyncs)}
+}
+
+type namespaceBucket struct {
+	flowcontrol.RateLimiter
+	used time.Time
+}
+
+// TryAccept takes a token from the namespace's bucket and returns false if
+// none is left. A nil limiter accepts every write.
+func (l *NamespaceWriteLimiter) TryAccept(namespace string) bool {
+	if l == nil {
+		return true
+	}
+	now := l.now()
+	l.mu.Lock()
+	if now.Sub(l.swept) > l.idle {
+		for ns, b := range l.buckets {
+			if now.Sub(b.used) > l.idle {
+				delete(l.buckets, ns)
+			}
+		}
+		l.swept = now
+	}
+	b, ok := l.buckets[namespace]
+	if !ok {
+		b = &namespaceBucket{RateLimiter: flowcontrol.NewTokenBucketRateLimiter(l.qps, l.burst)}
+		l.buckets[namespace] = b
+	}
+	b.used = now
+	l.mu.Unlock()
+	return b.TryAccept()
+}
+
+// TryAcceptObject is TryAccept for the namespace of the object, except that
+// writes are always accepted until the object's force sync annotation, once
+// it changed, is marked synced with MarkForceSynced. A nil limiter has no need
+// to tell force syncs apart, as it accepts every write.
+func (l *NamespaceWriteLimiter) TryAcceptObject(obj metav1.Object) bool {
+	if l == nil {
+		return true
+	}
+	v := obj.GetAnnotations()[oamv1alpha2.AnnotationForceSync]
+	if v == "" {
+		l.forceSyncs.Remove(obj.GetUID())
+		return l.TryAccept(obj.GetNamespace())
+	}
+	if synced, _ := l.forceSyncs.Get(obj.GetUID()); v != synced {
+		return true
+	}
+	return l.TryAccept(obj.GetNamespace())
+}
+
+// MarkForceSynced records that the object was synced with its current force
+// sync annotation, after which its writes are rate limited again. Reconcilers
+// call it once the object is synced, so that a forced write that failed is
+// retried without being throttled.
+func (l *NamespaceWriteLimiter) MarkForceSynced(obj metav1.Object) {
+	if l == nil {
+		return
+	}
+	if v := obj.GetAnnotations()[oamv1alpha2.AnnotationForceSync]; v != "" {
+		l.forceSyncs.Add(obj.GetUID(), v, forceSyncRetention)
+	}
+}
@@ -0,0 +1,76 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestNamespaceWriteLimiter(t *testing.T) {
+	l := NewNamespaceWriteLimiter(0.001, 2)
+	for i := 0; i < 2; i++ {
+		if !l.TryAccept("busy") {
+			t.Fatalf("TryAccept(busy) #%d = false, want true within the burst", i)
+		}
+	}
+	if l.TryAccept("busy") {
+		t.Errorf("TryAccept(busy) = true, want false once the burst is used up")
+	}
+	if !l.TryAccept("quiet") {
+		t.Errorf("TryAccept(quiet) = false, want true as namespaces have their own bucket")
+	}
+
+	var disabled *NamespaceWriteLimiter
+	if !disabled.TryAccept("busy") {
+		t.Errorf("nil limiter TryAccept() = false, want true")
+	}
+}
+
+func TestNamespaceWriteLimiterForceSync(t *testing.T) {
+	l := NewNamespaceWriteLimiter(0.001, 1)
+	obj := &metav1.ObjectMeta{Namespace: "busy", UID: "uid"}
+	if !l.TryAcceptObject(obj) {
+		t.Fatalf("TryAcceptObject() = false, want true within the burst")
+	}
+	if l.TryAcceptObject(obj) {
+		t.Fatalf("TryAcceptObject() = true, want false once the burst is used up")
+	}
+	obj.SetAnnotations(map[string]string{oamv1alpha2.AnnotationForceSync: "2020-04-01T10:00:00Z"})
+	// the forced write fails, its retries are not throttled either
+	for i := 0; i < 2; i++ {
+		if !l.TryAcceptObject(obj) {
+			t.Errorf("TryAcceptObject() = false, want true for a force sync that was not synced yet")
+		}
+	}
+	l.MarkForceSynced(obj)
+	if l.TryAcceptObject(obj) {
+		t.Errorf("TryAcceptObject() = true, want false for a force sync that was already synced")
+	}
+	obj.SetAnnotations(nil)
+	l.TryAcceptObject(obj)
+	if _, ok := l.forceSyncs.Get(obj.GetUID()); ok {
+		t.Errorf("forceSyncs has the object, want it dropped once its annotation is removed")
+	}
+}
+
+func TestNamespaceWriteLimiterEvictsIdleBuckets(t *testing.T) {
+	now := time.Date(2020, 4, 1, 10, 0, 0, 0, time.UTC)
+	l := NewNamespaceWriteLimiter(1, 60)
+	l.now = func() time.Time { return now }
+	l.TryAccept("busy")
+	l.TryAccept("quiet")
+
+	now = now.Add(30 * time.Second)
+	l.TryAccept("busy")
+	now = now.Add(45 * time.Second)
+	l.TryAccept("busy")
+	if _, ok := l.buckets["quiet"]; ok {
+		t.Errorf("buckets[quiet] exists, want it evicted after being idle for longer than it takes to refill")
+	}
+	if _, ok := l.buckets["busy"]; !ok {
+		t.Errorf("buckets[busy] missing, want it kept while in use")
+	}
+}
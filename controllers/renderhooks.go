@@ -0,0 +1,114 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/renderhook"
+)
+
+// Render hook error strings.
+const (
+	errPreRender  = "cannot run the pre-render hook"
+	errPostRender = "cannot run the post-render hook"
+)
+
+// preRender returns the workload as changed by the pre-render hook, if any.
+// Only its spec is taken from the hook.
+func (r *ContainerizedWorkloadReconciler) preRender(ctx context.Context,
+	workload *oamv1alpha2.ContainerizedWorkload) (*oamv1alpha2.ContainerizedWorkload, error) {
+	if r.PreRender == nil {
+		return workload, nil
+	}
+	u, err := toUnstructured(workload)
+	if err != nil {
+		return nil, errors.Wrap(err, errPreRender)
+	}
+	review, err := r.PreRender.Call(ctx, &renderhook.Review{Phase: renderhook.PreRender, Workload: u})
+	if err != nil {
+		return nil, errors.Wrap(err, errPreRender)
+	}
+	changed := &oamv1alpha2.ContainerizedWorkload{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(review.Workload.Object, changed); err != nil {
+		return nil, errors.Wrap(err, errPreRender)
+	}
+	w := workload.DeepCopy()
+	w.Spec = changed.Spec
+	return w, nil
+}
+
+// postRender lets the post-render hook, if any, change the deployments and
+// services rendered from the workload in place.
+func (r *ContainerizedWorkloadReconciler) postRender(ctx context.Context, workload *oamv1alpha2.ContainerizedWorkload,
+	deploys []*appsv1.Deployment, services []*corev1.Service) error {
+	if r.PostRender == nil {
+		return nil
+	}
+	objs := make([]runtime.Object, 0, len(deploys)+len(services))
+	for _, d := range deploys {
+		objs = append(objs, d)
+	}
+	for _, s := range services {
+		objs = append(objs, s)
+	}
+	review := &renderhook.Review{Phase: renderhook.PostRender}
+	var err error
+	if review.Workload, err = toUnstructured(workload); err != nil {
+		return errors.Wrap(err, errPostRender)
+	}
+	// the hook may change the review it is given
+	want := make([]*unstructured.Unstructured, 0, len(objs))
+	for _, o := range objs {
+		u, err := toUnstructured(o)
+		if err != nil {
+			return errors.Wrap(err, errPostRender)
+		}
+		review.Objects = append(review.Objects, u)
+		want = append(want, u.DeepCopy())
+	}
+
+	changed, err := r.PostRender.Call(ctx, review)
+	if err != nil {
+		return errors.Wrap(err, errPostRender)
+	}
+	// the children are tracked and garbage collected by name
+	if len(changed.Objects) != len(objs) {
+		return errors.Errorf("%s: the hook returned %d objects, want %d", errPostRender, len(changed.Objects), len(objs))
+	}
+	for i, u := range changed.Objects {
+		w := want[i]
+		if u.GetKind() != w.GetKind() || u.GetName() != w.GetName() || u.GetNamespace() != w.GetNamespace() {
+			return errors.Errorf("%s: the hook returned %s %s in place of %s %s", errPostRender,
+				u.GetKind(), u.GetName(), w.GetKind(), w.GetName())
+		}
+	}
+	for i := range deploys {
+		d := &appsv1.Deployment{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(changed.Objects[i].Object, d); err != nil {
+			return errors.Wrap(err, errPostRender)
+		}
+		*deploys[i] = *d
+	}
+	for i := range services {
+		s := &corev1.Service{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(changed.Objects[len(deploys)+i].Object, s); err != nil {
+			return errors.Wrap(err, errPostRender)
+		}
+		*services[i] = *s
+	}
+	return nil
+}
+
+func toUnstructured(obj runtime.Object) (*unstructured.Unstructured, error) {
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	return &unstructured.Unstructured{Object: m}, nil
+}
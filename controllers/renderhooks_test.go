@@ -0,0 +1,96 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/oam-dev/core-resource-controller/pkg/renderhook"
+)
+
+func TestPreRender(t *testing.T) {
+	hook := renderhook.HookFunc(func(_ context.Context, review *renderhook.Review) (*renderhook.Review, error) {
+		containers, _, _ := unstructured.NestedSlice(review.Workload.Object, "spec", "containers")
+		c := containers[0].(map[string]interface{})
+		c["image"] = "registry.local/mirror/web:1.0"
+		_ = unstructured.SetNestedSlice(review.Workload.Object, containers, "spec", "containers")
+		review.Workload.SetName("renamed")
+		return review, nil
+	})
+	workload := containerized.DeepCopy()
+	workload.Spec.Containers = []corev1.Container{{Name: "web", Image: "web:1.0"}}
+	r := ContainerizedWorkloadReconciler{PreRender: hook}
+	got, err := r.preRender(context.Background(), workload)
+	if err != nil {
+		t.Fatalf("preRender() error = %v", err)
+	}
+	if img := got.Spec.Containers[0].Image; img != "registry.local/mirror/web:1.0" {
+		t.Errorf("preRender() image = %q, want the image of the hook", img)
+	}
+	if got.Name != workload.Name {
+		t.Errorf("preRender() name = %q, want %q", got.Name, workload.Name)
+	}
+	if workload.Spec.Containers[0].Image != "web:1.0" {
+		t.Errorf("preRender() changed the workload")
+	}
+}
+
+func TestPostRender(t *testing.T) {
+	deploy := func() *appsv1.Deployment { return renderedDeployment(&containerized, 100) }
+	service := func() *corev1.Service {
+		return &corev1.Service{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: KindService},
+			ObjectMeta: metav1.ObjectMeta{Namespace: containerized.Namespace, Name: deploy().Name + "-service"},
+		}
+	}
+	testCases := map[string]struct {
+		hook    func(review *renderhook.Review)
+		wantErr bool
+	}{
+		"Annotated": {
+			hook: func(review *renderhook.Review) {
+				for _, o := range review.Objects {
+					o.SetAnnotations(map[string]string{"org.example/cost-center": "1234"})
+				}
+			},
+		},
+		"Removed": {
+			hook:    func(review *renderhook.Review) { review.Objects = review.Objects[:1] },
+			wantErr: true,
+		},
+		"Renamed": {
+			hook:    func(review *renderhook.Review) { review.Objects[1].SetName("other") },
+			wantErr: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := ContainerizedWorkloadReconciler{PostRender: renderhook.HookFunc(
+				func(_ context.Context, review *renderhook.Review) (*renderhook.Review, error) {
+					tc.hook(review)
+					return review, nil
+				})}
+			deploys := []*appsv1.Deployment{deploy()}
+			services := []*corev1.Service{service()}
+			err := r.postRender(context.Background(), &containerized, deploys, services)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("postRender() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if deploys[0].Annotations["org.example/cost-center"] != "1234" ||
+				services[0].Annotations["org.example/cost-center"] != "1234" {
+				t.Errorf("postRender() did not keep the annotations of the hook: %v, %v",
+					deploys[0].Annotations, services[0].Annotations)
+			}
+			if deploys[0].Spec.Template.Spec.Containers[0].Name != deploy().Spec.Template.Spec.Containers[0].Name {
+				t.Errorf("postRender() lost the spec of the deployment")
+			}
+		})
+	}
+}
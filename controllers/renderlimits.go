@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Render limit error strings.
+const (
+	errTooManyChildren  = "the workload renders too many children"
+	errManifestTooLarge = "a rendered manifest is too large"
+)
+
+// Defaults of the RenderLimits of the manager. A manifest stays well below
+// the request size limit of etcd.
+const (
+	DefaultMaxChildren      = 500
+	DefaultMaxManifestBytes = 1 << 20
+)
+
+// RenderLimits bound what a workload renders, so that a misconfigured
+// workload or template cannot flood the API server with objects.
+type RenderLimits struct {
+	// MaxChildren is the most children a workload may render. 0 means
+	// unlimited.
+	MaxChildren int
+	// MaxManifestBytes is the largest JSON manifest of a child. 0 means
+	// unlimited.
+	MaxManifestBytes int
+}
+
+// check returns an error naming the limit the children exceed, if any. A nil
+// RenderLimits allows any children.
+func (l *RenderLimits) check(children ...runtime.Object) error {
+	if l == nil {
+		return nil
+	}
+	if l.MaxChildren > 0 && len(children) > l.MaxChildren {
+		return errors.Errorf("%s: %d children, the limit is %d", errTooManyChildren, len(children), l.MaxChildren)
+	}
+	if l.MaxManifestBytes <= 0 {
+		return nil
+	}
+	for _, child := range children {
+		data, err := json.Marshal(child)
+		if err != nil {
+			return errors.Wrap(err, errManifestTooLarge)
+		}
+		if len(data) <= l.MaxManifestBytes {
+			continue
+		}
+		name := ""
+		if m, err := meta.Accessor(child); err == nil {
+			name = m.GetName()
+		}
+		return errors.Errorf("%s: %s %s is %d bytes, the limit is %d", errManifestTooLarge,
+			child.GetObjectKind().GroupVersionKind().Kind, name, len(data), l.MaxManifestBytes)
+	}
+	return nil
+}
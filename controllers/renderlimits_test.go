@@ -0,0 +1,57 @@
+package controllers
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestRenderLimitsCheck(t *testing.T) {
+	configMap := func(name string, size int) runtime.Object {
+		return &corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Data:       map[string]string{"data": strings.Repeat("x", size)},
+		}
+	}
+	testCases := map[string]struct {
+		limits   *RenderLimits
+		children []runtime.Object
+		wantErr  string
+	}{
+		"Unlimited": {
+			children: []runtime.Object{configMap("a", 2048), configMap("b", 10)},
+		},
+		"WithinLimits": {
+			limits:   &RenderLimits{MaxChildren: 2, MaxManifestBytes: 1024},
+			children: []runtime.Object{configMap("a", 10), configMap("b", 10)},
+		},
+		"TooManyChildren": {
+			limits:   &RenderLimits{MaxChildren: 1},
+			children: []runtime.Object{configMap("a", 10), configMap("b", 10)},
+			wantErr:  errTooManyChildren + ": 2 children, the limit is 1",
+		},
+		"ManifestTooLarge": {
+			limits:   &RenderLimits{MaxManifestBytes: 1024},
+			children: []runtime.Object{configMap("a", 10), configMap("b", 2048)},
+			wantErr:  errManifestTooLarge + ": ConfigMap b is",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.limits.check(tc.children...)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Errorf("check() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.HasPrefix(err.Error(), tc.wantErr) {
+				t.Errorf("check() error = %v, want %q", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"sort"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/debug"
+)
+
+// Reconcile error strings.
+const (
+	errNoQuota         = "the scope sets neither hard nor limits"
+	errApplyQuota      = "cannot apply the resource quota"
+	errApplyLimitRange = "cannot apply the limit range"
+)
+
+// the resources quotas share in millicores rather than whole units
+var milliResources = map[corev1.ResourceName]bool{
+	corev1.ResourceCPU:         true,
+	corev1.ResourceRequestsCPU: true,
+	corev1.ResourceLimitsCPU:   true,
+}
+
+// ResourceQuotaScopeReconciler reconciles a ResourceQuotaScope object
+type ResourceQuotaScopeReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// Debug, if set, records the outcome of each reconcile.
+	Debug *debug.Recorder
+}
+
+// +kubebuilder:rbac:groups=core.oam.dev,resources=resourcequotascopes,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=core.oam.dev,resources=resourcequotascopes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=resourcequotas;limitranges,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core.oam.dev,resources=resourcetrackers,verbs=get;list;watch;create;update;delete
+
+func (r *ResourceQuotaScopeReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("resourcequota scope", req.NamespacedName)
+	log.Info("Reconcile resource quota scope")
+
+	var scope oamv1alpha2.ResourceQuotaScope
+	if err := r.Get(ctx, req.NamespacedName, &scope); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	deleted, err := finalizeTrackedResources(ctx, r, &scope)
+	if err != nil {
+		log.Error(err, "Failed to finalize the tracked resources")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, err
+	}
+	if deleted {
+		return ctrl.Result{}, nil
+	}
+
+	if len(scope.Spec.Hard) == 0 && len(scope.Spec.Limits) == 0 {
+		scope.Status.SetConditions(reconcileError(errors.New(errNoQuota))...)
+		return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &scope), errUpdateStatus)
+	}
+
+	namespaces := scopeNamespaces(&scope)
+	shares := quotaShares(scope.Spec.Hard, namespaces)
+	var children []runtime.Object
+	used := corev1.ResourceList{}
+	// server side apply, only the fields we set are touched
+	applyOpts := []client.PatchOption{client.ForceOwnership, client.FieldOwner(scope.Name)}
+	for _, ns := range sortedNamespaces(namespaces) {
+		if len(scope.Spec.Hard) > 0 {
+			quota := &corev1.ResourceQuota{Spec: corev1.ResourceQuotaSpec{Hard: shares[ns]}}
+			quota.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("ResourceQuota"))
+			if err := r.applyScoped(ctx, &scope, ns, quota, applyOpts); err != nil {
+				scope.Status.SetConditions(reconcileError(errors.Wrap(err, errApplyQuota))...)
+				log.Error(err, "Failed to apply a resource quota", "namespace", ns)
+				return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &scope),
+					errUpdateStatus)
+			}
+			for name, q := range quota.Status.Used {
+				total := used[name]
+				total.Add(q)
+				used[name] = total
+			}
+			children = append(children, quota)
+		}
+		if len(scope.Spec.Limits) > 0 {
+			limits := &corev1.LimitRange{Spec: corev1.LimitRangeSpec{Limits: scope.Spec.Limits}}
+			limits.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("LimitRange"))
+			if err := r.applyScoped(ctx, &scope, ns, limits, applyOpts); err != nil {
+				scope.Status.SetConditions(reconcileError(errors.Wrap(err, errApplyLimitRange))...)
+				log.Error(err, "Failed to apply a limit range", "namespace", ns)
+				return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &scope),
+					errUpdateStatus)
+			}
+			children = append(children, limits)
+		}
+	}
+	log.Info("Successfully applied the quotas of the scope", "namespaces", len(namespaces))
+
+	// the namespaces no longer holding workloads of the scope are released
+	if err := releaseStaleResources(ctx, r, r.Scheme, &scope, children...); err != nil {
+		scope.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Failed to delete the resources of the namespaces no longer covered")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &scope),
+			errUpdateStatus)
+	}
+	if err := trackResources(ctx, r, r.Scheme, &scope, children...); err != nil {
+		scope.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Failed to track resources")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &scope),
+			errUpdateStatus)
+	}
+
+	scope.Status.Namespaces = sortedNamespaces(namespaces)
+	scope.Status.Used = nil
+	if len(used) > 0 {
+		scope.Status.Used = used
+	}
+	scope.Status.SetConditions(oamv1alpha2.PermissionGranted(), cpv1alpha1.ReconcileSuccess())
+	return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &scope), errUpdateStatus)
+}
+
+// applyScoped applies one of the objects the scope creates in each namespace,
+// named after the scope.
+func (r *ResourceQuotaScopeReconciler) applyScoped(ctx context.Context, scope *oamv1alpha2.ResourceQuotaScope,
+	namespace string, obj trackedOwner, opts []client.PatchOption) error {
+	obj.SetNamespace(namespace)
+	obj.SetName(scope.Name)
+	// always set the controller reference so that we can watch this object
+	if err := ctrl.SetControllerReference(scope, obj, r.Scheme); err != nil {
+		return err
+	}
+	return retryTransient(applyBackoff, nil, func() error {
+		return r.Patch(ctx, obj, client.Apply, opts...)
+	})
+}
+
+// scopeNamespaces returns the number of workloads of the scope in each of
+// their namespaces.
+func scopeNamespaces(scope *oamv1alpha2.ResourceQuotaScope) map[string]int {
+	seen := map[oamv1alpha2.ScopedWorkload]bool{}
+	namespaces := map[string]int{}
+	for _, w := range scope.Spec.WorkloadReferences {
+		if seen[w] {
+			continue
+		}
+		seen[w] = true
+		namespaces[w.Namespace]++
+	}
+	return namespaces
+}
+
+func sortedNamespaces(namespaces map[string]int) []string {
+	sorted := make([]string, 0, len(namespaces))
+	for ns := range namespaces {
+		sorted = append(sorted, ns)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// quotaShares shares the hard limits among the namespaces in proportion to
+// the number of workloads each holds. CPU is shared in millicores, the other
+// resources in whole units. The units left over go to the namespaces in
+// order, so that the shares add up to the hard limits.
+func quotaShares(hard corev1.ResourceList, namespaces map[string]int) map[string]corev1.ResourceList {
+	sorted := sortedNamespaces(namespaces)
+	total := 0
+	shares := make(map[string]corev1.ResourceList, len(sorted))
+	for _, ns := range sorted {
+		total += namespaces[ns]
+		shares[ns] = corev1.ResourceList{}
+	}
+	if total == 0 {
+		return shares
+	}
+	for name, q := range hard {
+		units := q.Value()
+		if milliResources[name] {
+			units = q.MilliValue()
+		}
+		split := make([]int64, len(sorted))
+		left := units
+		for i, ns := range sorted {
+			split[i] = units * int64(namespaces[ns]) / int64(total)
+			left -= split[i]
+		}
+		for i := 0; left > 0; i++ {
+			split[i]++
+			left--
+		}
+		for i, ns := range sorted {
+			if milliResources[name] {
+				shares[ns][name] = *resource.NewMilliQuantity(split[i], q.Format)
+			} else {
+				shares[ns][name] = *resource.NewQuantity(split[i], q.Format)
+			}
+		}
+	}
+	return shares
+}
+
+func (r *ResourceQuotaScopeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&oamv1alpha2.ResourceQuotaScope{}).
+		Owns(&corev1.ResourceQuota{}).
+		Owns(&corev1.LimitRange{}).
+		Complete(r.Debug.Wrap("ResourceQuotaScope", r))
+}
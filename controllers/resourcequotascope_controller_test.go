@@ -0,0 +1,129 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/simtest"
+)
+
+func TestQuotaShares(t *testing.T) {
+	hard := corev1.ResourceList{
+		corev1.ResourceRequestsCPU: resource.MustParse("1"),
+		corev1.ResourcePods:        resource.MustParse("10"),
+	}
+	testCases := map[string]struct {
+		namespaces map[string]int
+		want       map[string]map[corev1.ResourceName]string
+	}{
+		"Single": {
+			namespaces: map[string]int{"a": 3},
+			want:       map[string]map[corev1.ResourceName]string{"a": {"requests.cpu": "1", "pods": "10"}},
+		},
+		"Proportional": {
+			namespaces: map[string]int{"a": 1, "b": 4},
+			want: map[string]map[corev1.ResourceName]string{
+				"a": {"requests.cpu": "200m", "pods": "2"},
+				"b": {"requests.cpu": "800m", "pods": "8"},
+			},
+		},
+		"LeftOver": {
+			namespaces: map[string]int{"a": 1, "b": 1, "c": 1},
+			want: map[string]map[corev1.ResourceName]string{
+				"a": {"requests.cpu": "334m", "pods": "4"},
+				"b": {"requests.cpu": "333m", "pods": "3"},
+				"c": {"requests.cpu": "333m", "pods": "3"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			shares := quotaShares(hard, tc.namespaces)
+			for ns, want := range tc.want {
+				for res, q := range want {
+					got := shares[ns][res]
+					if got.Cmp(resource.MustParse(q)) != 0 {
+						t.Errorf("quotaShares()[%s][%s] = %s, want %s", ns, res, got.String(), q)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestResourceQuotaScopeReconcile(t *testing.T) {
+	workload := &oamv1alpha2.ContainerizedWorkload{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "team-a"}}
+	ref := func(ns, name string) oamv1alpha2.ScopedWorkload {
+		return oamv1alpha2.ScopedWorkload{APIVersion: oamv1alpha2.GroupVersion.String(),
+			Kind: kindContainerizedWorkload, Namespace: ns, Name: name}
+	}
+	scope := &oamv1alpha2.ResourceQuotaScope{
+		ObjectMeta: metav1.ObjectMeta{Name: "shop", UID: "shop-uid"},
+		Spec: oamv1alpha2.ResourceQuotaScopeSpec{
+			WorkloadReferences: []oamv1alpha2.ScopedWorkload{ref("team-a", "web"), ref("team-a", "api"),
+				ref("team-b", "worker")},
+			Hard: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("3")},
+			Limits: []corev1.LimitRangeItem{{Type: corev1.LimitTypeContainer,
+				DefaultRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")}}},
+		},
+	}
+	h, err := simtest.New(workload, nil, scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &ResourceQuotaScopeReconciler{Client: h, Log: ctrl.Log, Scheme: h.Scheme}
+	if _, err := h.Reconcile(r, scope); err != nil {
+		t.Fatalf("Reconcile() = %v", err)
+	}
+
+	ctx := context.Background()
+	for ns, want := range map[string]string{"team-a": "2", "team-b": "1"} {
+		var quota corev1.ResourceQuota
+		if err := h.Get(ctx, client.ObjectKey{Namespace: ns, Name: "shop"}, &quota); err != nil {
+			t.Fatalf("Get(%s/shop) = %v", ns, err)
+		}
+		if got := quota.Spec.Hard[corev1.ResourceRequestsCPU]; got.Cmp(resource.MustParse(want)) != 0 {
+			t.Errorf("quota of %s: requests.cpu = %s, want %s", ns, got.String(), want)
+		}
+		var limits corev1.LimitRange
+		if err := h.Get(ctx, client.ObjectKey{Namespace: ns, Name: "shop"}, &limits); err != nil {
+			t.Fatalf("Get(%s/shop) = %v", ns, err)
+		}
+		if len(limits.Spec.Limits) != 1 {
+			t.Errorf("limit range of %s: limits = %v", ns, limits.Spec.Limits)
+		}
+	}
+	if err := h.Get(ctx, client.ObjectKey{Name: "shop"}, scope); err != nil {
+		t.Fatal(err)
+	}
+	if got := scope.Status.Namespaces; len(got) != 2 || got[0] != "team-a" || got[1] != "team-b" {
+		t.Errorf("Reconcile(): status namespaces = %v", got)
+	}
+
+	// the namespace no longer holding workloads of the scope is released
+	scope.Spec.WorkloadReferences = scope.Spec.WorkloadReferences[:2]
+	if err := h.Update(ctx, scope); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Reconcile(r, scope); err != nil {
+		t.Fatalf("Reconcile() = %v", err)
+	}
+	var quota corev1.ResourceQuota
+	if err := h.Get(ctx, client.ObjectKey{Namespace: "team-b", Name: "shop"}, &quota); !apierrors.IsNotFound(err) {
+		t.Errorf("Get(team-b/shop) = %v, want the quota deleted", err)
+	}
+	if err := h.Get(ctx, client.ObjectKey{Namespace: "team-a", Name: "shop"}, &quota); err != nil {
+		t.Fatal(err)
+	}
+	if got := quota.Spec.Hard[corev1.ResourceRequestsCPU]; got.Cmp(resource.MustParse("3")) != 0 {
+		t.Errorf("quota of team-a: requests.cpu = %s, want all of it", got.String())
+	}
+}
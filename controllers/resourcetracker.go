@@ -0,0 +1,243 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// resourceTrackerFinalizer holds an owner until the resources recorded in its
+// ResourceTracker have been deleted.
+const resourceTrackerFinalizer = "resourcetracker.core.oam.dev/finalizer"
+
+// Resource tracker error strings.
+const (
+	errTrackResources   = "cannot record the created resources"
+	errReleaseResources = "cannot delete the tracked resources"
+	errUpdateFinalizer  = "cannot update the finalizer"
+	errOrphanResource   = "cannot orphan the tracked resource"
+	errResourcesPending = "waiting for the tracked resources to be deleted"
+)
+
+// trackedOwner is an object resources are created for.
+type trackedOwner interface {
+	metav1.Object
+	runtime.Object
+}
+
+// the tracker of an owner is named after its UID as owners from different
+// namespaces share the cluster scoped tracker namespace
+func resourceTrackerName(owner metav1.Object) string {
+	return string(owner.GetUID())
+}
+
+func trackedResource(obj runtime.Object, scheme *runtime.Scheme) (oamv1alpha2.TrackedResource, error) {
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return oamv1alpha2.TrackedResource{}, err
+	}
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return oamv1alpha2.TrackedResource{}, err
+	}
+	return oamv1alpha2.TrackedResource{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Namespace:  m.GetNamespace(),
+		Name:       m.GetName(),
+		UID:        m.GetUID(),
+	}, nil
+}
+
+// record the resources currently created for the owner, replacing any
+// previously recorded ones
+func trackResources(ctx context.Context, c client.Client, scheme *runtime.Scheme, owner trackedOwner,
+	children ...runtime.Object) error {
+	ownerRef, err := trackedResource(owner, scheme)
+	if err != nil {
+		return errors.Wrap(err, errTrackResources)
+	}
+	resources := make([]oamv1alpha2.TrackedResource, 0, len(children))
+	for _, child := range children {
+		ref, err := trackedResource(child, scheme)
+		if err != nil {
+			return errors.Wrap(err, errTrackResources)
+		}
+		resources = append(resources, ref)
+	}
+
+	var tracker oamv1alpha2.ResourceTracker
+	err = c.Get(ctx, client.ObjectKey{Name: resourceTrackerName(owner)}, &tracker)
+	if apierrors.IsNotFound(err) {
+		tracker = oamv1alpha2.ResourceTracker{
+			ObjectMeta: metav1.ObjectMeta{Name: resourceTrackerName(owner)},
+			Spec:       oamv1alpha2.ResourceTrackerSpec{Owner: ownerRef, Resources: resources},
+		}
+		return errors.Wrap(c.Create(ctx, &tracker), errTrackResources)
+	}
+	if err != nil {
+		return errors.Wrap(err, errTrackResources)
+	}
+	tracker.Spec.Owner = ownerRef
+	tracker.Spec.Resources = resources
+	return errors.Wrap(c.Update(ctx, &tracker), errTrackResources)
+}
+
+// deletionPropagation returns the propagation policy the owner's
+// AnnotationDeletionPropagation selects, Background unless it names another.
+func deletionPropagation(owner metav1.Object) metav1.DeletionPropagation {
+	switch p := metav1.DeletionPropagation(owner.GetAnnotations()[oamv1alpha2.AnnotationDeletionPropagation]); p {
+	case metav1.DeletePropagationForeground, metav1.DeletePropagationOrphan:
+		return p
+	}
+	return metav1.DeletePropagationBackground
+}
+
+// delete every resource recorded for the owner, or orphan them if its
+// deletion propagation policy says so, and then its tracker. With the
+// Foreground policy the tracker is kept until the resources are gone.
+func releaseResources(ctx context.Context, c client.Client, owner metav1.Object) error {
+	var tracker oamv1alpha2.ResourceTracker
+	if err := c.Get(ctx, client.ObjectKey{Name: resourceTrackerName(owner)}, &tracker); err != nil {
+		return errors.Wrap(client.IgnoreNotFound(err), errReleaseResources)
+	}
+	policy := deletionPropagation(owner)
+	remaining := 0
+	for _, res := range tracker.Spec.Resources {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(schema.FromAPIVersionAndKind(res.APIVersion, res.Kind))
+		obj.SetNamespace(res.Namespace)
+		obj.SetName(res.Name)
+		if policy == metav1.DeletePropagationOrphan {
+			if err := orphanResource(ctx, c, obj, owner.GetUID()); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := c.Delete(ctx, obj, client.PropagationPolicy(policy)); client.IgnoreNotFound(err) != nil {
+			return errors.Wrap(err, errReleaseResources)
+		}
+		if policy == metav1.DeletePropagationForeground {
+			err := c.Get(ctx, client.ObjectKey{Namespace: res.Namespace, Name: res.Name}, obj)
+			if client.IgnoreNotFound(err) != nil {
+				return errors.Wrap(err, errReleaseResources)
+			}
+			if err == nil {
+				remaining++
+			}
+		}
+	}
+	if remaining > 0 {
+		return errors.Errorf("%s: %d remaining", errResourcesPending, remaining)
+	}
+	return errors.Wrap(client.IgnoreNotFound(c.Delete(ctx, &tracker)), errReleaseResources)
+}
+
+// remove the owner references to the owner from the resource so that the
+// garbage collector leaves it alone
+func orphanResource(ctx context.Context, c client.Client, obj *unstructured.Unstructured, owner types.UID) error {
+	err := c.Get(ctx, client.ObjectKey{Namespace: obj.GetNamespace(), Name: obj.GetName()}, obj)
+	if err != nil {
+		return errors.Wrap(client.IgnoreNotFound(err), errOrphanResource)
+	}
+	refs := obj.GetOwnerReferences()
+	kept := make([]metav1.OwnerReference, 0, len(refs))
+	for _, ref := range refs {
+		if ref.UID != owner {
+			kept = append(kept, ref)
+		}
+	}
+	if len(kept) == len(refs) {
+		return nil
+	}
+	obj.SetOwnerReferences(kept)
+	return errors.Wrap(client.IgnoreNotFound(c.Update(ctx, obj)), errOrphanResource)
+}
+
+// delete the resources recorded for the owner that are not among the children
+// it currently has, e.g. because its template no longer renders them
+func releaseStaleResources(ctx context.Context, c client.Client, scheme *runtime.Scheme, owner metav1.Object,
+	children ...runtime.Object) error {
+	var tracker oamv1alpha2.ResourceTracker
+	if err := c.Get(ctx, client.ObjectKey{Name: resourceTrackerName(owner)}, &tracker); err != nil {
+		return errors.Wrap(client.IgnoreNotFound(err), errReleaseResources)
+	}
+	current := make(map[schema.GroupVersionKind]map[client.ObjectKey]bool, len(children))
+	for _, child := range children {
+		ref, err := trackedResource(child, scheme)
+		if err != nil {
+			return errors.Wrap(err, errReleaseResources)
+		}
+		gvk := schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind)
+		if current[gvk] == nil {
+			current[gvk] = map[client.ObjectKey]bool{}
+		}
+		current[gvk][client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}] = true
+	}
+	for _, res := range tracker.Spec.Resources {
+		gvk := schema.FromAPIVersionAndKind(res.APIVersion, res.Kind)
+		if current[gvk][client.ObjectKey{Namespace: res.Namespace, Name: res.Name}] {
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		obj.SetNamespace(res.Namespace)
+		obj.SetName(res.Name)
+		if err := c.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return errors.Wrap(err, errReleaseResources)
+		}
+	}
+	return nil
+}
+
+// finalizeTrackedResources makes sure the owner carries the tracker finalizer
+// while it exists and releases its tracked resources once it is being deleted.
+// It returns true when the owner is being deleted and needs no further work.
+func finalizeTrackedResources(ctx context.Context, c client.Client, owner trackedOwner) (bool, error) {
+	return finalize(ctx, c, owner, resourceTrackerFinalizer, func() error {
+		return releaseResources(ctx, c, owner)
+	})
+}
+
+// finalize makes sure the owner carries the finalizer while it exists and
+// calls release before removing the finalizer once it is being deleted. It
+// returns true when the owner is being deleted and needs no further work.
+func finalize(ctx context.Context, c client.Client, owner trackedOwner, finalizer string,
+	release func() error) (bool, error) {
+	finalizers := owner.GetFinalizers()
+	idx := -1
+	for i, f := range finalizers {
+		if f == finalizer {
+			idx = i
+			break
+		}
+	}
+
+	if owner.GetDeletionTimestamp() == nil {
+		if idx >= 0 {
+			return false, nil
+		}
+		owner.SetFinalizers(append(finalizers, finalizer))
+		return false, errors.Wrap(c.Update(ctx, owner), errUpdateFinalizer)
+	}
+
+	if idx < 0 {
+		return true, nil
+	}
+	if err := release(); err != nil {
+		return true, err
+	}
+	owner.SetFinalizers(append(finalizers[:idx:idx], finalizers[idx+1:]...))
+	return true, errors.Wrap(c.Update(ctx, owner), errUpdateFinalizer)
+}
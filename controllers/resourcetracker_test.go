@@ -0,0 +1,130 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestFinalizeTrackedResources(t *testing.T) {
+	ctx := context.Background()
+	workload := containerized.DeepCopy()
+	deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "test-deployment", Namespace: "default"}}
+	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "test-service", Namespace: "default"}}
+	c := fake.NewFakeClientWithScheme(testScheme, workload, deploy, service)
+
+	if deleted, err := finalizeTrackedResources(ctx, c, workload); deleted || err != nil {
+		t.Fatalf("finalizeTrackedResources() = %v, %v, want false, nil", deleted, err)
+	}
+	if got := workload.GetFinalizers(); len(got) != 1 || got[0] != resourceTrackerFinalizer {
+		t.Fatalf("finalizers = %v, want [%s]", got, resourceTrackerFinalizer)
+	}
+
+	if err := trackResources(ctx, c, testScheme, workload, deploy, service); err != nil {
+		t.Fatalf("trackResources() error = %v", err)
+	}
+	var tracker oamv1alpha2.ResourceTracker
+	if err := c.Get(ctx, client.ObjectKey{Name: string(workload.UID)}, &tracker); err != nil {
+		t.Fatalf("cannot get the resource tracker: %v", err)
+	}
+	if got := tracker.Spec.Resources; len(got) != 2 || got[0].Kind != KindDeployment || got[1].Kind != KindService {
+		t.Errorf("tracked resources = %v", got)
+	}
+	if tracker.Spec.Owner.Kind != "ContainerizedWorkload" || tracker.Spec.Owner.Namespace != "default" {
+		t.Errorf("tracked owner = %v", tracker.Spec.Owner)
+	}
+
+	now := metav1.Now()
+	workload.SetDeletionTimestamp(&now)
+	if deleted, err := finalizeTrackedResources(ctx, c, workload); !deleted || err != nil {
+		t.Fatalf("finalizeTrackedResources() = %v, %v, want true, nil", deleted, err)
+	}
+	if got := workload.GetFinalizers(); len(got) != 0 {
+		t.Errorf("finalizers = %v, want none", got)
+	}
+	if err := c.Get(ctx, client.ObjectKey{Name: string(workload.UID)}, &tracker); !apierrors.IsNotFound(err) {
+		t.Errorf("resource tracker still exists: %v", err)
+	}
+	err := c.Get(ctx, client.ObjectKey{Name: "test-deployment", Namespace: "default"}, deploy)
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("tracked deployment still exists: %v", err)
+	}
+	err = c.Get(ctx, client.ObjectKey{Name: "test-service", Namespace: "default"}, service)
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("tracked service still exists: %v", err)
+	}
+}
+
+func TestReleaseResourcesPropagation(t *testing.T) {
+	ctx := context.Background()
+	testCases := map[string]struct {
+		policy      string
+		wantDeleted bool
+	}{
+		"Default": {
+			wantDeleted: true,
+		},
+		"Foreground": {
+			policy:      string(metav1.DeletePropagationForeground),
+			wantDeleted: true,
+		},
+		"Orphan": {
+			policy: string(metav1.DeletePropagationOrphan),
+		},
+		"Unknown": {
+			policy:      "Eventually",
+			wantDeleted: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			workload := containerized.DeepCopy()
+			if tc.policy != "" {
+				workload.SetAnnotations(map[string]string{oamv1alpha2.AnnotationDeletionPropagation: tc.policy})
+			}
+			deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-deployment",
+				Namespace: "default",
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "v1", Kind: "ConfigMap", Name: "other", UID: "other-uid"},
+					{APIVersion: oamv1alpha2.GroupVersion.String(), Kind: "ContainerizedWorkload", Name: workload.Name,
+						UID: workload.UID},
+				},
+			}}
+			c := fake.NewFakeClientWithScheme(testScheme, workload, deploy)
+			if err := trackResources(ctx, c, testScheme, workload, deploy); err != nil {
+				t.Fatalf("trackResources() error = %v", err)
+			}
+
+			if err := releaseResources(ctx, c, workload); err != nil {
+				t.Fatalf("releaseResources() error = %v", err)
+			}
+			var tracker oamv1alpha2.ResourceTracker
+			if err := c.Get(ctx, client.ObjectKey{Name: string(workload.UID)}, &tracker); !apierrors.IsNotFound(err) {
+				t.Errorf("resource tracker still exists: %v", err)
+			}
+			var got appsv1.Deployment
+			err := c.Get(ctx, client.ObjectKey{Name: "test-deployment", Namespace: "default"}, &got)
+			if tc.wantDeleted {
+				if !apierrors.IsNotFound(err) {
+					t.Errorf("tracked deployment still exists: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("orphaned deployment was deleted: %v", err)
+			}
+			if refs := got.GetOwnerReferences(); len(refs) != 1 || refs[0].UID != "other-uid" {
+				t.Errorf("orphaned deployment owner references = %v, want only the other owner", refs)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/debug"
+)
+
+// Reconcile error strings.
+const (
+	errRestartDeployment = "cannot restart the pods of the deployment"
+)
+
+// restartHistoryLimit is the number of restarts kept in the status of a
+// RestartTrait.
+const restartHistoryLimit = 10
+
+// RestartTraitReconciler reconciles a RestartTrait object
+type RestartTraitReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// Limiter, if set, rate limits the writes of child resources per namespace.
+	Limiter *NamespaceWriteLimiter
+	// Debug, if set, records the outcome of each reconcile.
+	Debug *debug.Recorder
+	// Workloads, if set, resolves the workload the trait refers to, see
+	// WorkloadResolver.
+	Workloads *WorkloadResolver
+}
+
+// +kubebuilder:rbac:groups=core.oam.dev,resources=restarttraits,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core.oam.dev,resources=restarttraits/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
+
+func (r *RestartTraitReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("restart trait", req.NamespacedName)
+	log.Info("Reconcile restart trait")
+
+	var trait oamv1alpha2.RestartTrait
+	if err := r.Get(ctx, req.NamespacedName, &trait); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	deploy, err := r.Workloads.fetchWorkloadDeployment(ctx, r, log, req.Namespace, trait.Spec.WorkloadReference)
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+
+	// restarts are scheduled by setting a time in the future
+	now := time.Now()
+	if at := trait.Spec.RestartedAt.Time; at.After(now) {
+		log.Info("Scheduling a restart", "at", at)
+		msg := fmt.Sprintf("restart scheduled at %s", at.UTC().Format(time.RFC3339))
+		trait.Status.SetConditions(oamv1alpha2.PermissionGranted(), cpv1alpha1.ReconcileSuccess().WithMessage(msg))
+		return ctrl.Result{RequeueAfter: at.Sub(now)}, errors.Wrap(r.Status().Update(ctx, &trait), errUpdateStatus)
+	}
+
+	if restarted(deploy, trait.Spec.RestartedAt.Time) {
+		trait.Status.SetConditions(oamv1alpha2.PermissionGranted(), cpv1alpha1.ReconcileSuccess())
+		return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &trait), errUpdateStatus)
+	}
+
+	if result, ok, err := gateReconcile(ctx, r, log, r.Limiter, kindRestartTrait, &trait, &trait.Status.ConditionedStatus,
+		&trait.Spec.WorkloadReference); !ok {
+		return result, err
+	}
+
+	// restart the pods, refetching the deployment if it changed under us
+	err = retryTransient(applyBackoff, func() error {
+		return r.Get(ctx, client.ObjectKey{Name: deploy.Name, Namespace: deploy.Namespace}, deploy)
+	}, func() error {
+		return r.Patch(ctx, restartedDeployment(&trait, deploy), client.MergeFrom(deploy))
+	})
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(errors.Wrap(err, errRestartDeployment))...)
+		log.Error(err, "Failed to restart the pods of a deployment")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	log.Info("Successfully restarted the pods of a deployment", "UID", deploy.UID)
+
+	trait.Status.Restarts = append(trait.Status.Restarts, oamv1alpha2.Restart{
+		RestartedAt: trait.Spec.RestartedAt,
+		Deployment:  deploy.Name,
+		Time:        metav1.NewTime(now),
+	})
+	if n := len(trait.Status.Restarts); n > restartHistoryLimit {
+		trait.Status.Restarts = trait.Status.Restarts[n-restartHistoryLimit:]
+	}
+	r.Limiter.MarkForceSynced(&trait)
+	trait.Status.SetConditions(oamv1alpha2.PermissionGranted(), cpv1alpha1.ReconcileSuccess())
+	return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &trait), errUpdateStatus)
+}
+
+// restarted returns true if the pods of the deployment were restarted at or
+// after the given time, by the trait or e.g. kubectl rollout restart
+func restarted(deploy *appsv1.Deployment, at time.Time) bool {
+	v, ok := deploy.Spec.Template.GetAnnotations()[oamv1alpha2.AnnotationRestartedAt]
+	if !ok {
+		return false
+	}
+	last, err := time.Parse(time.RFC3339, v)
+	return err == nil && !last.Before(at.Truncate(time.Second))
+}
+
+// restartedDeployment returns a copy of the deployment whose pod template is
+// stamped with the time the trait restarts them at
+func restartedDeployment(trait *oamv1alpha2.RestartTrait, deploy *appsv1.Deployment) *appsv1.Deployment {
+	rd := deploy.DeepCopy()
+	annotations := rd.Spec.Template.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[oamv1alpha2.AnnotationRestartedAt] = trait.Spec.RestartedAt.UTC().Format(time.RFC3339)
+	rd.Spec.Template.SetAnnotations(annotations)
+	// always set the owner reference so that we can watch this deployment
+	setTraitOwnerReference(rd, trait.APIVersion, trait.Kind, trait)
+	return rd
+}
+
+func (r *RestartTraitReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&oamv1alpha2.RestartTrait{}).
+		Watches(&source.Kind{
+			Type: &appsv1.Deployment{},
+		}, &handler.EnqueueRequestForOwner{
+			OwnerType:    &oamv1alpha2.RestartTrait{},
+			IsController: false, // other traits may own the deployment too
+		}).
+		Complete(r.Debug.Wrap("RestartTrait", r))
+}
@@ -0,0 +1,113 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/simtest"
+)
+
+func TestRestartTraitReconcile(t *testing.T) {
+	at := time.Date(2020, 4, 1, 9, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		restartedAt time.Time
+		// the restartedAt annotation of the pod template before the reconcile
+		annotation string
+		restart    bool
+		requeue    bool
+	}{
+		"Restart": {
+			restartedAt: at,
+			restart:     true,
+		},
+		"RestartAgain": {
+			restartedAt: at,
+			annotation:  at.Add(-time.Hour).Format(time.RFC3339),
+			restart:     true,
+		},
+		"AlreadyRestarted": {
+			restartedAt: at,
+			annotation:  at.Format(time.RFC3339),
+		},
+		"RestartedByKubectl": {
+			restartedAt: at,
+			annotation:  at.Add(time.Minute).In(time.FixedZone("CEST", 2*60*60)).Format(time.RFC3339),
+		},
+		"Scheduled": {
+			restartedAt: time.Now().Add(time.Hour),
+			requeue:     true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			workload := &oamv1alpha2.ContainerizedWorkload{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+			deploy := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "web-deployment", Namespace: "default"},
+				Spec: appsv1.DeploymentSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				},
+			}
+			if tc.annotation != "" {
+				deploy.Spec.Template.Annotations = map[string]string{oamv1alpha2.AnnotationRestartedAt: tc.annotation}
+			}
+			trait := &oamv1alpha2.RestartTrait{
+				ObjectMeta: metav1.ObjectMeta{Name: "restart", Namespace: "default"},
+				Spec:       oamv1alpha2.RestartTraitSpec{RestartedAt: metav1.NewTime(tc.restartedAt)},
+			}
+			h, err := simtest.New(workload, []runtime.Object{deploy})
+			if err != nil {
+				t.Fatal(err)
+			}
+			trait.Spec.WorkloadReference = h.WorkloadReference()
+			if err := h.Create(context.Background(), trait); err != nil {
+				t.Fatal(err)
+			}
+
+			r := &RestartTraitReconciler{Client: h, Log: ctrl.Log, Scheme: h.Scheme}
+			result, err := h.Reconcile(r, trait)
+			if err != nil {
+				t.Fatalf("Reconcile() = %v", err)
+			}
+			if got := result.RequeueAfter > 0; got != tc.requeue {
+				t.Errorf("Reconcile() requeue after = %s, want requeue %t", result.RequeueAfter, tc.requeue)
+			}
+			if tc.restart {
+				var restartedDeploy appsv1.Deployment
+				if err := h.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: deploy.Name},
+					&restartedDeploy); err != nil {
+					t.Fatal(err)
+				}
+				if got := restartedDeploy.Spec.Template.Annotations[oamv1alpha2.AnnotationRestartedAt]; got != at.Format(time.RFC3339) {
+					t.Errorf("Reconcile() %s = %q, want %q", oamv1alpha2.AnnotationRestartedAt, got, at.Format(time.RFC3339))
+				}
+			} else {
+				h.AssertNotPatched(t, deploy)
+			}
+
+			var got oamv1alpha2.RestartTrait
+			if err := h.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "restart"}, &got); err != nil {
+				t.Fatal(err)
+			}
+			if c := got.Status.GetCondition(cpv1alpha1.TypeSynced); c.Status != corev1.ConditionTrue {
+				t.Errorf("Reconcile() %s condition = %+v, want True", cpv1alpha1.TypeSynced, c)
+			}
+			want := 0
+			if tc.restart {
+				want = 1
+			}
+			if len(got.Status.Restarts) != want {
+				t.Errorf("Reconcile() restarts = %+v, want %d", got.Status.Restarts, want)
+			}
+		})
+	}
+}
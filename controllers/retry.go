@@ -0,0 +1,70 @@
+package controllers
+
+import (
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// applyBackoff bounds how long a trait keeps retrying a transient apply
+// failure before it is reported on the trait's conditions.
+var applyBackoff = wait.Backoff{
+	Duration: 100 * time.Millisecond,
+	Factor:   2,
+	Jitter:   0.5,
+	Steps:    5,
+	Cap:      5 * time.Second,
+}
+
+// retry classes of api errors
+type retryClass int
+
+const (
+	// the error is permanent and returned right away
+	retryNever retryClass = iota
+	// the object changed under us, retry with the latest version
+	retryConflict
+	// the api server is busy, retry after backing off
+	retryThrottled
+)
+
+func classifyRetry(err error) retryClass {
+	switch {
+	case apierrors.IsConflict(err):
+		return retryConflict
+	case apierrors.IsTooManyRequests(err), apierrors.IsServerTimeout(err), apierrors.IsTimeout(err),
+		apierrors.IsServiceUnavailable(err):
+		return retryThrottled
+	default:
+		return retryNever
+	}
+}
+
+// retryTransient calls apply until it succeeds, fails with a permanent error
+// or the backoff is exhausted, in which case the last error is returned.
+// getLatest, if not nil, is called after a conflict to refresh the object
+// apply works on.
+func retryTransient(backoff wait.Backoff, getLatest func() error, apply func() error) error {
+	var lastErr error
+	err := wait.ExponentialBackoff(backoff, func() (bool, error) {
+		lastErr = apply()
+		switch classifyRetry(lastErr) {
+		case retryConflict:
+			if getLatest != nil {
+				if err := getLatest(); err != nil {
+					return false, err
+				}
+			}
+			return false, nil
+		case retryThrottled:
+			return false, nil
+		default:
+			return true, lastErr
+		}
+	})
+	if err == wait.ErrWaitTimeout {
+		return lastErr
+	}
+	return err
+}
@@ -0,0 +1,70 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+func TestRetryTransient(t *testing.T) {
+	gr := schema.GroupResource{Group: "apps", Resource: "deployments"}
+	conflict := apierrors.NewConflict(gr, "test", errors.New("changed"))
+	throttled := apierrors.NewTooManyRequests("slow down", 1)
+	permanent := apierrors.NewBadRequest("invalid")
+	backoff := wait.Backoff{Duration: time.Millisecond, Factor: 1, Steps: 3}
+
+	testCases := map[string]struct {
+		errs          []error
+		wantErr       error
+		wantApplies   int
+		wantRefreshes int
+	}{
+		"Success": {
+			errs:        []error{nil},
+			wantApplies: 1,
+		},
+		"ConflictThenSuccess": {
+			errs:          []error{conflict, nil},
+			wantApplies:   2,
+			wantRefreshes: 1,
+		},
+		"ThrottledThenSuccess": {
+			errs:        []error{throttled, nil},
+			wantApplies: 2,
+		},
+		"Permanent": {
+			errs:        []error{permanent},
+			wantErr:     permanent,
+			wantApplies: 1,
+		},
+		"Exhausted": {
+			errs:        []error{throttled, throttled, throttled},
+			wantErr:     throttled,
+			wantApplies: 3,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			applies, refreshes := 0, 0
+			err := retryTransient(backoff, func() error {
+				refreshes++
+				return nil
+			}, func() error {
+				err := testCase.errs[applies]
+				applies++
+				return err
+			})
+			if err != testCase.wantErr {
+				t.Errorf("retryTransient() error = %v, want %v", err, testCase.wantErr)
+			}
+			if applies != testCase.wantApplies || refreshes != testCase.wantRefreshes {
+				t.Errorf("retryTransient() applied %d times and refreshed %d times, want %d and %d",
+					applies, refreshes, testCase.wantApplies, testCase.wantRefreshes)
+			}
+		})
+	}
+}
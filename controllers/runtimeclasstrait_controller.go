@@ -0,0 +1,152 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	nodev1beta1 "k8s.io/api/node/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/debug"
+)
+
+// Reconcile error strings.
+const (
+	errRuntimeClassDeployment = "cannot set the runtime class of the deployment"
+	errGetRuntimeClass        = "cannot get the runtime class"
+)
+
+// RuntimeClassTraitReconciler reconciles a RuntimeClassTrait object
+type RuntimeClassTraitReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// Limiter, if set, rate limits the writes of child resources per namespace.
+	Limiter *NamespaceWriteLimiter
+	// Debug, if set, records the outcome of each reconcile.
+	Debug *debug.Recorder
+	// Workloads, if set, resolves the workload the trait refers to, see
+	// WorkloadResolver.
+	Workloads *WorkloadResolver
+}
+
+// +kubebuilder:rbac:groups=core.oam.dev,resources=runtimeclasstraits,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=core.oam.dev,resources=runtimeclasstraits/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=node.k8s.io,resources=runtimeclasses,verbs=get;list;watch
+
+func (r *RuntimeClassTraitReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("runtime class trait", req.NamespacedName)
+	log.Info("Reconcile runtime class trait")
+
+	var trait oamv1alpha2.RuntimeClassTrait
+	if err := r.Get(ctx, req.NamespacedName, &trait); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	deleted, err := r.Workloads.finalizeManagedFields(ctx, r, log,
+		&trait, kindRuntimeClassTrait, trait.Spec.WorkloadReference)
+	if err != nil {
+		log.Error(err, "Failed to revert the fields set by the trait")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, err
+	}
+	if deleted {
+		return ctrl.Result{}, nil
+	}
+
+	deploy, err := r.Workloads.fetchWorkloadDeployment(ctx, r, log, req.Namespace, trait.Spec.WorkloadReference)
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+
+	// pods referring to a missing runtime class are rejected, keep the
+	// deployment as it is until the class exists
+	rc := &nodev1beta1.RuntimeClass{}
+	if err := r.Get(ctx, client.ObjectKey{Name: trait.Spec.RuntimeClassName}, rc); err != nil {
+		if apierrors.IsNotFound(err) {
+			err = errors.Errorf("runtime class %q does not exist", trait.Spec.RuntimeClassName)
+		} else {
+			err = errors.Wrap(err, errGetRuntimeClass)
+		}
+		log.Info("Cannot use the runtime class", "reason", err.Error())
+		trait.Status.SetConditions(reconcileError(err)...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait), errUpdateStatus)
+	}
+
+	if result, ok, err := gateReconcile(ctx, r, log, r.Limiter, kindRuntimeClassTrait, &trait, &trait.Status.ConditionedStatus,
+		&trait.Spec.WorkloadReference); !ok {
+		return result, err
+	}
+
+	// set the runtime class, refetching the deployment if it changed under us
+	err = retryTransient(applyBackoff, func() error {
+		return r.Get(ctx, client.ObjectKey{Name: deploy.Name, Namespace: deploy.Namespace}, deploy)
+	}, func() error {
+		rd := runtimeClassDeployment(&trait, deploy)
+		if err := recordManagedFields(deploy, rd, managedFieldsKey(kindRuntimeClassTrait, trait.Name)); err != nil {
+			return err
+		}
+		return r.Patch(ctx, rd, client.MergeFrom(deploy))
+	})
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(errors.Wrap(err, errRuntimeClassDeployment))...)
+		log.Error(err, "Failed to set the runtime class of a deployment")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	log.Info("Successfully set the runtime class of a deployment", "UID", deploy.UID)
+
+	r.Limiter.MarkForceSynced(&trait)
+	trait.Status.SetConditions(oamv1alpha2.PermissionGranted(), cpv1alpha1.ReconcileSuccess())
+	return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &trait), errUpdateStatus)
+}
+
+// runtimeClassDeployment returns a copy of the deployment whose pods run with
+// the trait's runtime class
+func runtimeClassDeployment(trait *oamv1alpha2.RuntimeClassTrait, deploy *appsv1.Deployment) *appsv1.Deployment {
+	rd := deploy.DeepCopy()
+	name := trait.Spec.RuntimeClassName
+	rd.Spec.Template.Spec.RuntimeClassName = &name
+	// always set the owner reference so that we can watch this deployment
+	setTraitOwnerReference(rd, trait.APIVersion, trait.Kind, trait)
+	return rd
+}
+
+func (r *RuntimeClassTraitReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&oamv1alpha2.RuntimeClassTrait{}).
+		Watches(&source.Kind{
+			Type: &appsv1.Deployment{},
+		}, &handler.EnqueueRequestForOwner{
+			OwnerType:    &oamv1alpha2.RuntimeClassTrait{},
+			IsController: false, // other traits may own the deployment too
+		}).
+		Complete(r.Debug.Wrap("RuntimeClassTrait", r))
+}
@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	nodev1beta1 "k8s.io/api/node/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/simtest"
+)
+
+func TestRuntimeClassTraitReconcile(t *testing.T) {
+	ctx := context.Background()
+	workload := &oamv1alpha2.ContainerizedWorkload{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web-deployment", Namespace: "default"}}
+	trait := &oamv1alpha2.RuntimeClassTrait{
+		ObjectMeta: metav1.ObjectMeta{Name: "sandbox", Namespace: "default"},
+		Spec:       oamv1alpha2.RuntimeClassTraitSpec{RuntimeClassName: "gvisor"},
+	}
+	h, err := simtest.New(workload, []runtime.Object{deploy})
+	if err != nil {
+		t.Fatal(err)
+	}
+	trait.Spec.WorkloadReference = h.WorkloadReference()
+	if err := h.Create(ctx, trait); err != nil {
+		t.Fatal(err)
+	}
+	synced := func() cpv1alpha1.Condition {
+		var got oamv1alpha2.RuntimeClassTrait
+		if err := h.Get(ctx, client.ObjectKey{Namespace: "default", Name: "sandbox"}, &got); err != nil {
+			t.Fatal(err)
+		}
+		return got.Status.GetCondition(cpv1alpha1.TypeSynced)
+	}
+
+	r := &RuntimeClassTraitReconciler{Client: h, Log: ctrl.Log, Scheme: h.Scheme}
+	if _, err := h.Reconcile(r, trait); err != nil {
+		t.Fatalf("Reconcile() = %v", err)
+	}
+	h.AssertNotPatched(t, deploy)
+	if c := synced(); c.Status != corev1.ConditionFalse {
+		t.Errorf("Reconcile() without the runtime class: synced condition = %+v, want false", c)
+	}
+
+	if err := h.Create(ctx, &nodev1beta1.RuntimeClass{ObjectMeta: metav1.ObjectMeta{Name: "gvisor"}, Handler: "runsc"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Reconcile(r, trait); err != nil {
+		t.Fatalf("Reconcile() = %v", err)
+	}
+	h.AssertPatched(t, deploy, "spec.template.spec.runtimeClassName", "gvisor")
+	if c := synced(); c.Status != corev1.ConditionTrue {
+		t.Errorf("Reconcile() synced condition = %+v, want true", c)
+	}
+}
@@ -0,0 +1,195 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/debug"
+)
+
+// Reconcile error strings.
+const (
+	errScratchDeployment = "cannot add the scratch volumes to the deployment"
+	errScratchContainer  = "the deployment has no container"
+)
+
+// ScratchStorageTraitReconciler reconciles a ScratchStorageTrait object
+type ScratchStorageTraitReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// Limiter, if set, rate limits the writes of child resources per namespace.
+	Limiter *NamespaceWriteLimiter
+	// Debug, if set, records the outcome of each reconcile.
+	Debug *debug.Recorder
+	// Workloads, if set, resolves the workload the trait refers to, see
+	// WorkloadResolver.
+	Workloads *WorkloadResolver
+}
+
+// +kubebuilder:rbac:groups=core.oam.dev,resources=scratchstoragetraits,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=core.oam.dev,resources=scratchstoragetraits/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
+
+func (r *ScratchStorageTraitReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("scratch storage trait", req.NamespacedName)
+	log.Info("Reconcile scratch storage trait")
+
+	var trait oamv1alpha2.ScratchStorageTrait
+	if err := r.Get(ctx, req.NamespacedName, &trait); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	deleted, err := r.Workloads.finalizeManagedFields(ctx, r, log,
+		&trait, kindScratchStorageTrait, trait.Spec.WorkloadReference)
+	if err != nil {
+		log.Error(err, "Failed to revert the fields set by the trait")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, err
+	}
+	if deleted {
+		return ctrl.Result{}, nil
+	}
+
+	deploy, err := r.Workloads.fetchWorkloadDeployment(ctx, r, log, req.Namespace, trait.Spec.WorkloadReference)
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+
+	if result, ok, err := gateReconcile(ctx, r, log, r.Limiter, kindScratchStorageTrait, &trait, &trait.Status.ConditionedStatus,
+		&trait.Spec.WorkloadReference); !ok {
+		return result, err
+	}
+
+	// add the volumes, refetching the deployment if it changed under us
+	err = retryTransient(applyBackoff, func() error {
+		return r.Get(ctx, client.ObjectKey{Name: deploy.Name, Namespace: deploy.Namespace}, deploy)
+	}, func() error {
+		sd, err := scratchDeployment(&trait, deploy)
+		if err != nil {
+			return err
+		}
+		if err := recordManagedFields(deploy, sd, managedFieldsKey(kindScratchStorageTrait, trait.Name)); err != nil {
+			return err
+		}
+		return r.Patch(ctx, sd, client.MergeFrom(deploy))
+	})
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(errors.Wrap(err, errScratchDeployment))...)
+		log.Error(err, "Failed to add the scratch volumes to a deployment")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	log.Info("Successfully added the scratch volumes to a deployment", "UID", deploy.UID)
+
+	r.Limiter.MarkForceSynced(&trait)
+	trait.Status.SetConditions(oamv1alpha2.PermissionGranted(), cpv1alpha1.ReconcileSuccess())
+	return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &trait), errUpdateStatus)
+}
+
+// scratchDeployment returns a copy of the deployment whose pods have the
+// trait's scratch volumes, mounted into their containers, and whose
+// containers request the ephemeral storage the Disk volumes take
+func scratchDeployment(trait *oamv1alpha2.ScratchStorageTrait, deploy *appsv1.Deployment) (*appsv1.Deployment, error) {
+	sd := deploy.DeepCopy()
+	spec := &sd.Spec.Template.Spec
+	// the ephemeral storage each container requests at least
+	requests := map[string]int64{}
+	for _, v := range trait.Spec.Volumes {
+		size := v.Size.DeepCopy()
+		source := &corev1.EmptyDirVolumeSource{SizeLimit: &size}
+		if v.Medium == oamv1alpha2.ScratchMediumMemory {
+			source.Medium = corev1.StorageMediumMemory
+		}
+		spec.Volumes = replaceVolume(spec.Volumes, corev1.Volume{
+			Name:         v.Name,
+			VolumeSource: corev1.VolumeSource{EmptyDir: source},
+		})
+
+		only := make(map[string]bool, len(v.Containers))
+		for _, name := range v.Containers {
+			only[name] = true
+		}
+		mounted := false
+		for i := range spec.Containers {
+			c := &spec.Containers[i]
+			if len(only) > 0 && !only[c.Name] {
+				continue
+			}
+			c.VolumeMounts = replaceVolumeMount(c.VolumeMounts, corev1.VolumeMount{Name: v.Name, MountPath: v.MountPath})
+			// the volume takes up the disk of the pod once, however many
+			// containers mount it
+			if !mounted && v.Medium != oamv1alpha2.ScratchMediumMemory {
+				requests[c.Name] += v.Size.Value()
+			}
+			mounted = true
+		}
+		if !mounted {
+			return nil, errors.Errorf("%s mounting the scratch volume %s", errScratchContainer, v.Name)
+		}
+	}
+
+	for i := range spec.Containers {
+		c := &spec.Containers[i]
+		want, ok := requests[c.Name]
+		if !ok {
+			continue
+		}
+		current := c.Resources.Requests[corev1.ResourceEphemeralStorage]
+		if current.Value() >= want {
+			continue
+		}
+		if c.Resources.Requests == nil {
+			c.Resources.Requests = corev1.ResourceList{}
+		}
+		c.Resources.Requests[corev1.ResourceEphemeralStorage] = *resource.NewQuantity(want, resource.BinarySI)
+		// a limit below the request is rejected
+		if limit, ok := c.Resources.Limits[corev1.ResourceEphemeralStorage]; ok && limit.Value() < want {
+			c.Resources.Limits[corev1.ResourceEphemeralStorage] = *resource.NewQuantity(want, resource.BinarySI)
+		}
+	}
+	// always set the owner reference so that we can watch this deployment
+	setTraitOwnerReference(sd, trait.APIVersion, trait.Kind, trait)
+	return sd, nil
+}
+
+func (r *ScratchStorageTraitReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&oamv1alpha2.ScratchStorageTrait{}).
+		Watches(&source.Kind{
+			Type: &appsv1.Deployment{},
+		}, &handler.EnqueueRequestForOwner{
+			OwnerType:    &oamv1alpha2.ScratchStorageTrait{},
+			IsController: false, // other traits may own the deployment too
+		}).
+		Complete(r.Debug.Wrap("ScratchStorageTrait", r))
+}
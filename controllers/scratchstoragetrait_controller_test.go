@@ -0,0 +1,122 @@
+package controllers
+
+import (
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestScratchDeployment(t *testing.T) {
+	ephemeral := func(q string) corev1.ResourceList {
+		return corev1.ResourceList{corev1.ResourceEphemeralStorage: resource.MustParse(q)}
+	}
+	deployment := func(web corev1.ResourceRequirements) *appsv1.Deployment {
+		d := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web-deployment", Namespace: "default"}}
+		d.Spec.Template.Spec.Containers = []corev1.Container{{Name: "web", Resources: web}, {Name: "sidecar"}}
+		return d
+	}
+	testCases := map[string]struct {
+		volume  oamv1alpha2.ScratchVolume
+		web     corev1.ResourceRequirements
+		mounted []string
+		// the ephemeral storage requests and limits of the web container
+		wantRequests corev1.ResourceList
+		wantLimits   corev1.ResourceList
+		wantErr      bool
+	}{
+		"Disk": {
+			volume:       oamv1alpha2.ScratchVolume{Name: "cache", MountPath: "/cache", Size: resource.MustParse("1Gi")},
+			mounted:      []string{"web", "sidecar"},
+			wantRequests: ephemeral("1Gi"),
+		},
+		"Memory": {
+			volume: oamv1alpha2.ScratchVolume{Name: "tmp", MountPath: "/tmp", Size: resource.MustParse("64Mi"),
+				Medium: oamv1alpha2.ScratchMediumMemory},
+			mounted: []string{"web", "sidecar"},
+		},
+		"RequestKept": {
+			volume:       oamv1alpha2.ScratchVolume{Name: "cache", MountPath: "/cache", Size: resource.MustParse("1Gi")},
+			web:          corev1.ResourceRequirements{Requests: ephemeral("2Gi")},
+			mounted:      []string{"web", "sidecar"},
+			wantRequests: ephemeral("2Gi"),
+		},
+		"LimitRaised": {
+			volume:       oamv1alpha2.ScratchVolume{Name: "cache", MountPath: "/cache", Size: resource.MustParse("1Gi")},
+			web:          corev1.ResourceRequirements{Requests: ephemeral("100Mi"), Limits: ephemeral("500Mi")},
+			mounted:      []string{"web", "sidecar"},
+			wantRequests: ephemeral("1Gi"),
+			wantLimits:   ephemeral("1Gi"),
+		},
+		"Containers": {
+			volume: oamv1alpha2.ScratchVolume{Name: "cache", MountPath: "/cache", Size: resource.MustParse("1Gi"),
+				Containers: []string{"sidecar"}},
+			mounted: []string{"sidecar"},
+		},
+		"UnknownContainer": {
+			volume: oamv1alpha2.ScratchVolume{Name: "cache", MountPath: "/cache", Size: resource.MustParse("1Gi"),
+				Containers: []string{"worker"}},
+			wantErr: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			trait := &oamv1alpha2.ScratchStorageTrait{
+				ObjectMeta: metav1.ObjectMeta{Name: "scratch", Namespace: "default"},
+				Spec:       oamv1alpha2.ScratchStorageTraitSpec{Volumes: []oamv1alpha2.ScratchVolume{tc.volume}},
+			}
+			deploy := deployment(tc.web)
+			sd, err := scratchDeployment(trait, deploy)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("scratchDeployment() = %v, want error %t", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			spec := sd.Spec.Template.Spec
+			if len(spec.Volumes) != 1 || spec.Volumes[0].EmptyDir == nil ||
+				spec.Volumes[0].EmptyDir.SizeLimit.Cmp(tc.volume.Size) != 0 {
+				t.Errorf("scratchDeployment() volumes = %+v", spec.Volumes)
+			}
+			var mounted []string
+			for _, c := range spec.Containers {
+				for _, m := range c.VolumeMounts {
+					if m.Name == tc.volume.Name && m.MountPath == tc.volume.MountPath {
+						mounted = append(mounted, c.Name)
+					}
+				}
+				if c.Name == "sidecar" && len(c.Resources.Requests) != 0 && len(tc.mounted) > 1 {
+					t.Errorf("scratchDeployment() sidecar requests = %v, the volume counted twice", c.Resources.Requests)
+				}
+			}
+			if !reflect.DeepEqual(mounted, tc.mounted) {
+				t.Errorf("scratchDeployment() mounted the volume into %v, want %v", mounted, tc.mounted)
+			}
+			web := spec.Containers[0].Resources
+			got, want := web.Requests[corev1.ResourceEphemeralStorage], tc.wantRequests[corev1.ResourceEphemeralStorage]
+			if got.Cmp(want) != 0 {
+				t.Errorf("scratchDeployment() web requests %s, want %s", got.String(), want.String())
+			}
+			got, want = web.Limits[corev1.ResourceEphemeralStorage], tc.wantLimits[corev1.ResourceEphemeralStorage]
+			if got.Cmp(want) != 0 {
+				t.Errorf("scratchDeployment() web limits %s, want %s", got.String(), want.String())
+			}
+			if len(deploy.Spec.Template.Spec.Volumes) != 0 {
+				t.Errorf("scratchDeployment() modified the original deployment")
+			}
+
+			again, err := scratchDeployment(trait, sd)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(again.Spec.Template.Spec, sd.Spec.Template.Spec) {
+				t.Errorf("scratchDeployment() changed a deployment it already applied to")
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// errSelectedInformer is returned when an informer of the objects carrying
+// some labels cannot be set up.
+const errSelectedInformer = "cannot watch the labelled objects"
+
+// selectedInformer returns an informer of the objects of a core/v1 resource,
+// e.g. pods, that match the label selector, which runs along with the
+// manager. Unlike the manager's cache, which holds every object of a type in
+// the cluster, it lists and watches only the matching ones.
+func selectedInformer(mgr ctrl.Manager, resource string, obj runtime.Object,
+	selector labels.Selector) (toolscache.SharedIndexInformer, error) {
+	cs, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, errors.Wrap(err, errSelectedInformer)
+	}
+	lw := toolscache.NewFilteredListWatchFromClient(cs.CoreV1().RESTClient(), resource, metav1.NamespaceAll,
+		func(o *metav1.ListOptions) { o.LabelSelector = selector.String() })
+	informer := toolscache.NewSharedIndexInformer(lw, obj, 0,
+		toolscache.Indexers{toolscache.NamespaceIndex: toolscache.MetaNamespaceIndexFunc})
+	err = mgr.Add(manager.RunnableFunc(func(stop <-chan struct{}) error {
+		informer.Run(stop)
+		return nil
+	}))
+	return informer, errors.Wrap(err, errSelectedInformer)
+}
+
+// NewClient is the manager.NewClientFunc of the manager. Like the default
+// one it reads from the manager's cache and writes to the API server, but it
+// reads ConfigMaps and Secrets from the API server too, as caching them would
+// list and watch every one of them in the cluster. Reconcilers only read the
+// few their objects refer to, and watch the labelled ones with
+// selectedInformer.
+func NewClient(c cache.Cache, config *rest.Config, options client.Options) (client.Client, error) {
+	direct, err := client.New(config, options)
+	if err != nil {
+		return nil, err
+	}
+	return &client.DelegatingClient{
+		Reader: &uncachedConfigReader{
+			Reader: &client.DelegatingReader{CacheReader: c, ClientReader: direct},
+			direct: direct,
+		},
+		Writer:       direct,
+		StatusClient: direct,
+	}, nil
+}
+
+// an uncachedConfigReader reads ConfigMaps and Secrets from direct and any
+// other object from Reader
+type uncachedConfigReader struct {
+	client.Reader
+	direct client.Reader
+}
+
+func isConfig(obj runtime.Object) bool {
+	switch obj.(type) {
+	case *corev1.ConfigMap, *corev1.ConfigMapList, *corev1.Secret, *corev1.SecretList:
+		return true
+	}
+	return false
+}
+
+func (r *uncachedConfigReader) Get(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+	if isConfig(obj) {
+		return r.direct.Get(ctx, key, obj)
+	}
+	return r.Reader.Get(ctx, key, obj)
+}
+
+func (r *uncachedConfigReader) List(ctx context.Context, list runtime.Object, opts ...client.ListOption) error {
+	if isConfig(list) {
+		return r.direct.List(ctx, list, opts...)
+	}
+	return r.Reader.List(ctx, list, opts...)
+}
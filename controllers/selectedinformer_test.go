@@ -0,0 +1,38 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestUncachedConfigReader(t *testing.T) {
+	meta := metav1.ObjectMeta{Name: "web", Namespace: "default"}
+	// the cache only has the deployment, the API server only the config
+	cached := fake.NewFakeClient(&appsv1.Deployment{ObjectMeta: meta})
+	direct := fake.NewFakeClient(&corev1.ConfigMap{ObjectMeta: meta}, &corev1.Secret{ObjectMeta: meta})
+	r := &uncachedConfigReader{Reader: cached, direct: direct}
+	key := client.ObjectKey{Namespace: "default", Name: "web"}
+	for _, obj := range []runtime.Object{&corev1.ConfigMap{}, &corev1.Secret{}} {
+		if err := r.Get(context.Background(), key, obj); err != nil {
+			t.Errorf("Get %T: %v", obj, err)
+		}
+	}
+	var secrets corev1.SecretList
+	if err := r.List(context.Background(), &secrets); err != nil || len(secrets.Items) != 1 {
+		t.Errorf("List secrets = %d, %v, want 1 from the API server", len(secrets.Items), err)
+	}
+	if err := r.Get(context.Background(), key, &appsv1.Deployment{}); err != nil {
+		t.Errorf("Get deployment: %v", err)
+	}
+	if err := direct.Get(context.Background(), key, &appsv1.Deployment{}); !apierrors.IsNotFound(err) {
+		t.Errorf("the deployment should only be in the cache: %v", err)
+	}
+}
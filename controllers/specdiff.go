@@ -0,0 +1,119 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// eventSpecChanged is the reason of the event recorded when the spec of a
+// workload changed, before the change is applied.
+const eventSpecChanged = "SpecChanged"
+
+// the fields of a workload spec holding containers, by how they are named in
+// a summary
+var containerFields = []struct{ field, name string }{
+	{"containers", "container"},
+	{"initContainers", "init container"},
+}
+
+// appliedSpec returns the spec of a workload as recorded once it is applied
+func appliedSpec(spec *oamv1alpha2.ContainerizedWorkloadSpec) (*runtime.RawExtension, error) {
+	raw, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RawExtension{Raw: raw}, nil
+}
+
+// summarizeSpecChange describes how the spec of a workload differs from the
+// one applied before, e.g. "container sidecar added; container web changed:
+// env, image; spec changed: ttl", or returns "" if they do not differ.
+func summarizeSpecChange(applied *runtime.RawExtension, spec *oamv1alpha2.ContainerizedWorkloadSpec) (string, error) {
+	var before, after map[string]interface{}
+	if err := json.Unmarshal(applied.Raw, &before); err != nil {
+		return "", err
+	}
+	current, err := appliedSpec(spec)
+	if err != nil {
+		return "", err
+	}
+	if err := json.Unmarshal(current.Raw, &after); err != nil {
+		return "", err
+	}
+	var changes []string
+	for _, c := range containerFields {
+		changes = append(changes, containerChanges(c.name, before[c.field], after[c.field])...)
+		delete(before, c.field)
+		delete(after, c.field)
+	}
+	if fields := changedFields(before, after); len(fields) > 0 {
+		changes = append(changes, "spec changed: "+strings.Join(fields, ", "))
+	}
+	return strings.Join(changes, "; "), nil
+}
+
+// containerChanges describes the containers added, removed and changed
+// between two lists of containers, matched by name
+func containerChanges(kind string, before, after interface{}) []string {
+	old, oldNames := containersByName(before)
+	cur, curNames := containersByName(after)
+	var changes []string
+	for _, name := range curNames {
+		if _, ok := old[name]; !ok {
+			changes = append(changes, fmt.Sprintf("%s %s added", kind, name))
+		}
+	}
+	for _, name := range oldNames {
+		if _, ok := cur[name]; !ok {
+			changes = append(changes, fmt.Sprintf("%s %s removed", kind, name))
+		}
+	}
+	for _, name := range curNames {
+		if c, ok := old[name]; ok {
+			if fields := changedFields(c, cur[name]); len(fields) > 0 {
+				changes = append(changes, fmt.Sprintf("%s %s changed: %s", kind, name, strings.Join(fields, ", ")))
+			}
+		}
+	}
+	return changes
+}
+
+// containersByName returns the containers of a list by name, and their names
+// in the order of the list
+func containersByName(list interface{}) (map[string]map[string]interface{}, []string) {
+	items, _ := list.([]interface{})
+	byName := make(map[string]map[string]interface{}, len(items))
+	names := make([]string, 0, len(items))
+	for _, item := range items {
+		c, _ := item.(map[string]interface{})
+		name, _ := c["name"].(string)
+		byName[name] = c
+		names = append(names, name)
+	}
+	return byName, names
+}
+
+// changedFields returns the sorted names of the fields whose values differ
+// between two objects, including those set in only one of them
+func changedFields(before, after map[string]interface{}) []string {
+	var fields []string
+	for f, v := range after {
+		if !reflect.DeepEqual(before[f], v) {
+			fields = append(fields, f)
+		}
+	}
+	for f := range before {
+		if _, ok := after[f]; !ok {
+			fields = append(fields, f)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
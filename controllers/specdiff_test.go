@@ -0,0 +1,73 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestSummarizeSpecChange(t *testing.T) {
+	applied := oamv1alpha2.ContainerizedWorkloadSpec{
+		Containers: []corev1.Container{
+			{Name: "web", Image: "web:1", Env: []corev1.EnvVar{{Name: "LOG_LEVEL", Value: "info"}}},
+			{Name: "cache", Image: "redis:5"},
+		},
+		InitContainers: []corev1.Container{{Name: "migrate", Image: "web:1"}},
+	}
+	cases := map[string]struct {
+		change func(*oamv1alpha2.ContainerizedWorkloadSpec)
+		want   string
+	}{
+		"Unchanged": {
+			change: func(*oamv1alpha2.ContainerizedWorkloadSpec) {},
+		},
+		"ContainerAddedAndRemoved": {
+			change: func(s *oamv1alpha2.ContainerizedWorkloadSpec) {
+				s.Containers[1] = corev1.Container{Name: "sidecar", Image: "envoy:1"}
+			},
+			want: "container sidecar added; container cache removed",
+		},
+		"ContainerChanged": {
+			change: func(s *oamv1alpha2.ContainerizedWorkloadSpec) {
+				s.Containers[0].Image = "web:2"
+				s.Containers[0].Env = nil
+				s.InitContainers[0].Image = "web:2"
+			},
+			want: "container web changed: env, image; init container migrate changed: image",
+		},
+		"ContainersReordered": {
+			change: func(s *oamv1alpha2.ContainerizedWorkloadSpec) {
+				s.Containers[0], s.Containers[1] = s.Containers[1], s.Containers[0]
+			},
+		},
+		"OtherFieldsChanged": {
+			change: func(s *oamv1alpha2.ContainerizedWorkloadSpec) {
+				s.TTL = &metav1.Duration{Duration: time.Hour}
+				s.ReloadOnConfigChange = true
+				s.InitContainers = nil
+			},
+			want: "init container migrate removed; spec changed: reloadOnConfigChange, ttl",
+		},
+	}
+	raw, err := appliedSpec(&applied)
+	if err != nil {
+		t.Fatalf("appliedSpec() = %v", err)
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			spec := applied.DeepCopy()
+			tc.change(spec)
+			got, err := summarizeSpecChange(raw, spec)
+			if err != nil {
+				t.Fatalf("summarizeSpecChange() = %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("summarizeSpecChange() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/debug"
+)
+
+// Reconcile error strings.
+const (
+	errSpreadDeployment = "cannot add the topology spread constraints to the deployment"
+	errNoSpread         = "the trait sets neither topologySpreadConstraints nor awayFrom"
+)
+
+// SpreadTraitReconciler reconciles a SpreadTrait object
+type SpreadTraitReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// Limiter, if set, rate limits the writes of child resources per namespace.
+	Limiter *NamespaceWriteLimiter
+	// Debug, if set, records the outcome of each reconcile.
+	Debug *debug.Recorder
+	// Workloads, if set, resolves the workload the trait refers to, see
+	// WorkloadResolver.
+	Workloads *WorkloadResolver
+}
+
+// +kubebuilder:rbac:groups=core.oam.dev,resources=spreadtraits,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=core.oam.dev,resources=spreadtraits/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
+
+func (r *SpreadTraitReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("spread trait", req.NamespacedName)
+	log.Info("Reconcile spread trait")
+
+	var trait oamv1alpha2.SpreadTrait
+	if err := r.Get(ctx, req.NamespacedName, &trait); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	deleted, err := r.Workloads.finalizeManagedFields(ctx, r, log,
+		&trait, kindSpreadTrait, trait.Spec.WorkloadReference)
+	if err != nil {
+		log.Error(err, "Failed to revert the fields set by the trait")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, err
+	}
+	if deleted {
+		return ctrl.Result{}, nil
+	}
+
+	if len(trait.Spec.TopologySpreadConstraints) == 0 && trait.Spec.AwayFrom == nil {
+		trait.Status.SetConditions(reconcileError(errors.New(errNoSpread))...)
+		return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &trait), errUpdateStatus)
+	}
+
+	deploy, err := r.Workloads.fetchWorkloadDeployment(ctx, r, log, req.Namespace, trait.Spec.WorkloadReference)
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+
+	if result, ok, err := gateReconcile(ctx, r, log, r.Limiter, kindSpreadTrait, &trait, &trait.Status.ConditionedStatus,
+		&trait.Spec.WorkloadReference); !ok {
+		return result, err
+	}
+
+	// merge the constraints, refetching the deployment if it changed under us
+	err = retryTransient(applyBackoff, func() error {
+		return r.Get(ctx, client.ObjectKey{Name: deploy.Name, Namespace: deploy.Namespace}, deploy)
+	}, func() error {
+		sd := spreadDeployment(&trait, deploy)
+		if err := recordManagedFields(deploy, sd, managedFieldsKey(kindSpreadTrait, trait.Name)); err != nil {
+			return err
+		}
+		return r.Patch(ctx, sd, client.MergeFrom(deploy))
+	})
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(errors.Wrap(err, errSpreadDeployment))...)
+		log.Error(err, "Failed to add topology spread constraints to a deployment")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	log.Info("Successfully added topology spread constraints to a deployment", "UID", deploy.UID)
+
+	r.Limiter.MarkForceSynced(&trait)
+	trait.Status.SetConditions(oamv1alpha2.PermissionGranted(), cpv1alpha1.ReconcileSuccess())
+	return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &trait), errUpdateStatus)
+}
+
+// spreadDeployment returns a copy of the deployment with the trait's topology
+// spread constraints added to its pod template
+func spreadDeployment(trait *oamv1alpha2.SpreadTrait, deploy *appsv1.Deployment) *appsv1.Deployment {
+	sd := deploy.DeepCopy()
+	spec := &sd.Spec.Template.Spec
+	for _, c := range trait.Spec.TopologySpreadConstraints {
+		c := *c.DeepCopy()
+		// spread the pods of the workload unless told otherwise
+		if c.LabelSelector == nil {
+			c.LabelSelector = sd.Spec.Selector.DeepCopy()
+		}
+		found := false
+		for i := range spec.TopologySpreadConstraints {
+			if spec.TopologySpreadConstraints[i].TopologyKey == c.TopologyKey {
+				spec.TopologySpreadConstraints[i] = c
+				found = true
+				break
+			}
+		}
+		if !found {
+			spec.TopologySpreadConstraints = append(spec.TopologySpreadConstraints, c)
+		}
+	}
+	if a := trait.Spec.AwayFrom; a != nil {
+		spreadAwayFrom(spec, a)
+	}
+	// always set the owner reference so that we can watch this deployment
+	setTraitOwnerReference(sd, trait.APIVersion, trait.Kind, trait)
+	return sd
+}
+
+// spreadAwayFrom adds a pod anti-affinity term selecting the pods of the
+// other workloads to the pod spec. A term of another spread trait with the
+// same topology key is replaced.
+func spreadAwayFrom(spec *corev1.PodSpec, a *oamv1alpha2.SpreadAwayFrom) {
+	deploys := make([]string, 0, len(a.Workloads))
+	for _, w := range a.Workloads {
+		deploys = append(deploys, w+deploymentNameSuffix)
+	}
+	term := corev1.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+			Key:      OAMResourceNameLabel,
+			Operator: metav1.LabelSelectorOpIn,
+			Values:   deploys,
+		}}},
+		TopologyKey: a.TopologyKey,
+	}
+	if term.TopologyKey == "" {
+		term.TopologyKey = oamv1alpha2.DefaultAwayFromTopologyKey
+	}
+
+	if spec.Affinity == nil {
+		spec.Affinity = &corev1.Affinity{}
+	}
+	if spec.Affinity.PodAntiAffinity == nil {
+		spec.Affinity.PodAntiAffinity = &corev1.PodAntiAffinity{}
+	}
+	anti := spec.Affinity.PodAntiAffinity
+	if a.Required {
+		for i := range anti.RequiredDuringSchedulingIgnoredDuringExecution {
+			if awayFromTerm(anti.RequiredDuringSchedulingIgnoredDuringExecution[i], term.TopologyKey) {
+				anti.RequiredDuringSchedulingIgnoredDuringExecution[i] = term
+				return
+			}
+		}
+		anti.RequiredDuringSchedulingIgnoredDuringExecution = append(anti.RequiredDuringSchedulingIgnoredDuringExecution,
+			term)
+		return
+	}
+	weighted := corev1.WeightedPodAffinityTerm{Weight: 100, PodAffinityTerm: term}
+	for i := range anti.PreferredDuringSchedulingIgnoredDuringExecution {
+		if awayFromTerm(anti.PreferredDuringSchedulingIgnoredDuringExecution[i].PodAffinityTerm, term.TopologyKey) {
+			anti.PreferredDuringSchedulingIgnoredDuringExecution[i] = weighted
+			return
+		}
+	}
+	anti.PreferredDuringSchedulingIgnoredDuringExecution = append(anti.PreferredDuringSchedulingIgnoredDuringExecution,
+		weighted)
+}
+
+// awayFromTerm tells whether the term was added by spreadAwayFrom for the
+// topology key.
+func awayFromTerm(term corev1.PodAffinityTerm, topologyKey string) bool {
+	if term.TopologyKey != topologyKey || term.LabelSelector == nil || len(term.LabelSelector.MatchLabels) > 0 {
+		return false
+	}
+	exprs := term.LabelSelector.MatchExpressions
+	return len(exprs) == 1 && exprs[0].Key == OAMResourceNameLabel && exprs[0].Operator == metav1.LabelSelectorOpIn
+}
+
+func (r *SpreadTraitReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&oamv1alpha2.SpreadTrait{}).
+		Watches(&source.Kind{
+			Type: &appsv1.Deployment{},
+		}, &handler.EnqueueRequestForOwner{
+			OwnerType:    &oamv1alpha2.SpreadTrait{},
+			IsController: false, // other traits may own the deployment too
+		}).
+		Complete(r.Debug.Wrap("SpreadTrait", r))
+}
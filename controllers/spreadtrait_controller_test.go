@@ -0,0 +1,105 @@
+package controllers
+
+import (
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestSpreadDeployment(t *testing.T) {
+	const zone, host = "failure-domain.beta.kubernetes.io/zone", "kubernetes.io/hostname"
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{OAMResourceNameLabel: "web-deployment"}}
+	custom := &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "web"}}
+	trait := &oamv1alpha2.SpreadTrait{
+		TypeMeta:   metav1.TypeMeta{APIVersion: oamv1alpha2.GroupVersion.String(), Kind: "SpreadTrait"},
+		ObjectMeta: metav1.ObjectMeta{Name: "spread", UID: "trait-uid"},
+		Spec: oamv1alpha2.SpreadTraitSpec{TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+			{MaxSkew: 1, TopologyKey: zone, WhenUnsatisfiable: corev1.DoNotSchedule},
+			{MaxSkew: 2, TopologyKey: host, WhenUnsatisfiable: corev1.ScheduleAnyway, LabelSelector: custom},
+		}},
+	}
+	deploy := &appsv1.Deployment{Spec: appsv1.DeploymentSpec{
+		Selector: selector,
+		Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+				{MaxSkew: 5, TopologyKey: zone, WhenUnsatisfiable: corev1.ScheduleAnyway, LabelSelector: selector},
+			},
+		}},
+	}}
+
+	got := spreadDeployment(trait, deploy)
+	want := []corev1.TopologySpreadConstraint{
+		{MaxSkew: 1, TopologyKey: zone, WhenUnsatisfiable: corev1.DoNotSchedule, LabelSelector: selector},
+		{MaxSkew: 2, TopologyKey: host, WhenUnsatisfiable: corev1.ScheduleAnyway, LabelSelector: custom},
+	}
+	if !reflect.DeepEqual(got.Spec.Template.Spec.TopologySpreadConstraints, want) {
+		t.Errorf("spreadDeployment() constraints = %v, want %v", got.Spec.Template.Spec.TopologySpreadConstraints, want)
+	}
+	if refs := got.GetOwnerReferences(); len(refs) != 1 || refs[0].UID != trait.UID {
+		t.Errorf("spreadDeployment() owner references = %v", refs)
+	}
+	if deploy.Spec.Template.Spec.TopologySpreadConstraints[0].MaxSkew != 5 {
+		t.Errorf("spreadDeployment() modified the original deployment")
+	}
+}
+
+func TestSpreadAwayFrom(t *testing.T) {
+	const zone = "failure-domain.beta.kubernetes.io/zone"
+	away := func(key string, workloads ...string) corev1.PodAffinityTerm {
+		return corev1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key: OAMResourceNameLabel, Operator: metav1.LabelSelectorOpIn, Values: workloads,
+			}}},
+			TopologyKey: key,
+		}
+	}
+	other := corev1.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "db"}},
+		TopologyKey:   oamv1alpha2.DefaultAwayFromTopologyKey,
+	}
+	testCases := map[string]struct {
+		awayFrom oamv1alpha2.SpreadAwayFrom
+		existing *corev1.PodAntiAffinity
+		want     *corev1.PodAntiAffinity
+	}{
+		"Preferred": {
+			awayFrom: oamv1alpha2.SpreadAwayFrom{Workloads: []string{"api", "worker"}},
+			want: &corev1.PodAntiAffinity{PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+				{Weight: 100, PodAffinityTerm: away(oamv1alpha2.DefaultAwayFromTopologyKey, "api-deployment", "worker-deployment")},
+			}},
+		},
+		"Required": {
+			awayFrom: oamv1alpha2.SpreadAwayFrom{Workloads: []string{"api"}, TopologyKey: zone, Required: true},
+			existing: &corev1.PodAntiAffinity{RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{other}},
+			want: &corev1.PodAntiAffinity{RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+				other, away(zone, "api-deployment"),
+			}},
+		},
+		"Replaced": {
+			awayFrom: oamv1alpha2.SpreadAwayFrom{Workloads: []string{"worker"}, Required: true},
+			existing: &corev1.PodAntiAffinity{RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+				other, away(oamv1alpha2.DefaultAwayFromTopologyKey, "api-deployment"),
+			}},
+			want: &corev1.PodAntiAffinity{RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+				other, away(oamv1alpha2.DefaultAwayFromTopologyKey, "worker-deployment"),
+			}},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			spec := &corev1.PodSpec{}
+			if tc.existing != nil {
+				spec.Affinity = &corev1.Affinity{PodAntiAffinity: tc.existing.DeepCopy()}
+			}
+			spreadAwayFrom(spec, &tc.awayFrom)
+			if !reflect.DeepEqual(spec.Affinity.PodAntiAffinity, tc.want) {
+				t.Errorf("spreadAwayFrom() = %+v, want %+v", spec.Affinity.PodAntiAffinity, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/crds"
+	"github.com/oam-dev/core-resource-controller/pkg/debug"
+	"github.com/oam-dev/core-resource-controller/pkg/envtemplate"
+	"github.com/oam-dev/core-resource-controller/pkg/workloadtemplate"
+)
+
+// Reconcile error strings.
+const (
+	errGetWorkloadTemplate    = "cannot get the workload template"
+	errParseParameters        = "cannot parse the parameters of the workload"
+	errRenderWorkloadTemplate = "cannot render the workload template"
+	errForeignNamespace       = "a rendered manifest belongs to another namespace"
+	errApplyTemplatedChild    = "cannot apply a rendered manifest"
+	errInvalidTemplatedChild  = "a rendered manifest does not match the schema of its kind"
+)
+
+// TemplatedWorkloadReconciler reconciles a TemplatedWorkload object
+type TemplatedWorkloadReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// Limiter, if set, rate limits the writes of child resources per namespace.
+	Limiter *NamespaceWriteLimiter
+	// Debug, if set, records the outcome of each reconcile.
+	Debug *debug.Recorder
+	// Limits, if set, bound the manifests a workload's template renders.
+	Limits *RenderLimits
+	// GitOps, if set, annotates the manifests for the GitOps tools managing
+	// their workload.
+	GitOps *GitOpsMetadata
+}
+
+// +kubebuilder:rbac:groups=core.oam.dev,resources=templatedworkloads,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=core.oam.dev,resources=templatedworkloads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core.oam.dev,resources=workloadtemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core.oam.dev,resources=resourcetrackers,verbs=get;list;watch;create;update;delete
+
+func (r *TemplatedWorkloadReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("templated workload", req.NamespacedName)
+	log.Info("Reconcile templated workload")
+
+	var workload oamv1alpha2.TemplatedWorkload
+	if err := r.Get(ctx, req.NamespacedName, &workload); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	deleted, err := finalizeTrackedResources(ctx, r, &workload)
+	if err != nil {
+		log.Error(err, "Failed to finalize the tracked resources")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, err
+	}
+	if deleted {
+		return ctrl.Result{}, nil
+	}
+
+	if result, ok, err := gateReconcile(ctx, r, log, r.Limiter, "", &workload, &workload.Status.ConditionedStatus, nil); !ok {
+		return result, err
+	}
+
+	start := time.Now()
+	children, err := r.render(ctx, &workload)
+	if err != nil {
+		workload.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Failed to render the workload template")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
+			errUpdateStatus)
+	}
+	observeRender("TemplatedWorkload", start)
+
+	objs := make([]runtime.Object, 0, len(children))
+	for _, child := range children {
+		objs = append(objs, child)
+	}
+	r.GitOps.annotate(&workload, objs...)
+	if err := r.Limits.check(objs...); err != nil {
+		workload.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Rendered manifests exceed the render limits")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
+			errUpdateStatus)
+	}
+
+	// server side apply, only the fields we set are touched
+	applyOpts := []client.PatchOption{client.ForceOwnership, client.FieldOwner(workload.Name)}
+	resources := make([]oamv1alpha2.ResourceReference, 0, len(children))
+	for _, child := range children {
+		err := retryTransient(applyBackoff, nil, func() error {
+			return applyChild(ctx, r, r.Scheme, child, applyOpts...)
+		})
+		if err != nil {
+			workload.Status.SetConditions(reconcileError(errors.Wrapf(err, "%s: %s %s", errApplyTemplatedChild,
+				child.GetKind(), child.GetName()))...)
+			log.Error(err, "Failed to apply a rendered manifest", "kind", child.GetKind(), "name", child.GetName())
+			return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
+				errUpdateStatus)
+		}
+		uid := child.GetUID()
+		resources = append(resources, oamv1alpha2.ResourceReference{
+			APIVersion: child.GetAPIVersion(),
+			Kind:       child.GetKind(),
+			Name:       child.GetName(),
+			UID:        &uid,
+		})
+	}
+	log.Info("Successfully applied the rendered manifests", "count", len(children))
+
+	// the children the template no longer renders are deleted
+	if err := releaseStaleResources(ctx, r, r.Scheme, &workload, objs...); err != nil {
+		workload.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Failed to delete the resources no longer rendered")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
+			errUpdateStatus)
+	}
+	if err := trackResources(ctx, r, r.Scheme, &workload, objs...); err != nil {
+		workload.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Failed to track resources")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &workload),
+			errUpdateStatus)
+	}
+
+	workload.Status.Resources = resources
+	r.Limiter.MarkForceSynced(&workload)
+	workload.Status.SetConditions(cpv1alpha1.ReconcileSuccess())
+	return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &workload), errUpdateStatus)
+}
+
+// render the manifests of the workload's template, in the workload's
+// namespace and controlled by it
+func (r *TemplatedWorkloadReconciler) render(ctx context.Context,
+	workload *oamv1alpha2.TemplatedWorkload) ([]*unstructured.Unstructured, error) {
+	var tmpl oamv1alpha2.WorkloadTemplate
+	if err := r.Get(ctx, types.NamespacedName{Name: workload.Spec.Template}, &tmpl); err != nil {
+		return nil, errors.Wrap(err, errGetWorkloadTemplate)
+	}
+	data := workloadtemplate.Data{Workload: envtemplate.ForObject(workload).Workload}
+	if p := workload.Spec.Parameters; p != nil && len(p.Raw) > 0 {
+		if err := json.Unmarshal(p.Raw, &data.Parameters); err != nil {
+			return nil, errors.Wrap(err, errParseParameters)
+		}
+	}
+	objs, err := workloadtemplate.Render(tmpl.Spec.Template, data)
+	if err != nil {
+		return nil, errors.Wrap(err, errRenderWorkloadTemplate)
+	}
+	schemas, err := crds.EmbeddedValidator()
+	if err != nil {
+		return nil, errors.Wrap(err, errRenderWorkloadTemplate)
+	}
+	for _, obj := range objs {
+		switch obj.GetNamespace() {
+		case "":
+			obj.SetNamespace(workload.Namespace)
+		case workload.Namespace:
+		default:
+			return nil, errors.Errorf("%s: %s %s", errForeignNamespace, obj.GetKind(), obj.GetName())
+		}
+		// the API server silently prunes the fields the schemas of our kinds
+		// do not declare, e.g. a misspelled field of a rendered trait
+		if err := schemas.Validate(obj); err != nil {
+			return nil, errors.Wrapf(err, "%s: %s %s", errInvalidTemplatedChild, obj.GetKind(), obj.GetName())
+		}
+		// always set the controller reference so that we can watch the children
+		if err := ctrl.SetControllerReference(workload, obj, r.Scheme); err != nil {
+			return nil, errors.Wrap(err, errRenderWorkloadTemplate)
+		}
+	}
+	return objs, nil
+}
+
+// enqueue the workloads rendered from a template once it changes
+func (r *TemplatedWorkloadReconciler) templateWorkloads(o handler.MapObject) []reconcile.Request {
+	tmpl, ok := o.Object.(*oamv1alpha2.WorkloadTemplate)
+	if !ok {
+		return nil
+	}
+	var workloads oamv1alpha2.TemplatedWorkloadList
+	if err := r.List(context.Background(), &workloads); err != nil {
+		r.Log.Error(err, "Failed to list the workloads of a template", "template", tmpl.Name)
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, w := range workloads.Items {
+		if w.Spec.Template == tmpl.Name {
+			reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: w.Name,
+				Namespace: w.Namespace}})
+		}
+	}
+	return reqs
+}
+
+func (r *TemplatedWorkloadReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&oamv1alpha2.TemplatedWorkload{}).
+		Owns(&appsv1.Deployment{}).
+		Owns(&corev1.Service{}).
+		Watches(&source.Kind{
+			Type: &oamv1alpha2.WorkloadTemplate{},
+		}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.templateWorkloads),
+		}).
+		Complete(r.Debug.Wrap("TemplatedWorkload", r))
+}
@@ -0,0 +1,84 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestTemplatedWorkloadReconciler_render(t *testing.T) {
+	tmpl := &oamv1alpha2.WorkloadTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "web"},
+		Spec: oamv1alpha2.WorkloadTemplateSpec{Template: `
+apiVersion: v1
+kind: Service
+metadata:
+  name: {{ .Workload.Name }}
+  namespace: {{ .Parameters.namespace | default .Workload.Namespace }}
+spec:
+  ports:
+  - port: {{ .Parameters.port }}
+`},
+	}
+	workload := func(params string) *oamv1alpha2.TemplatedWorkload {
+		return &oamv1alpha2.TemplatedWorkload{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: oamv1alpha2.GroupVersion.String(),
+				Kind:       "TemplatedWorkload",
+			},
+			ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: "default", UID: "workload-uid"},
+			Spec: oamv1alpha2.TemplatedWorkloadSpec{
+				Template:   "web",
+				Parameters: &runtime.RawExtension{Raw: []byte(params)},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		workload *oamv1alpha2.TemplatedWorkload
+		wantErr  bool
+	}{
+		"Rendered": {
+			workload: workload(`{"port": 8080}`),
+		},
+		"SameNamespace": {
+			workload: workload(`{"port": 8080, "namespace": "default"}`),
+		},
+		"ForeignNamespace": {
+			workload: workload(`{"port": 8080, "namespace": "kube-system"}`),
+			wantErr:  true,
+		},
+		"MissingTemplate": {
+			workload: func() *oamv1alpha2.TemplatedWorkload {
+				w := workload(`{}`)
+				w.Spec.Template = "api"
+				return w
+			}(),
+			wantErr: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := TemplatedWorkloadReconciler{Client: fake.NewFakeClientWithScheme(testScheme, tmpl), Scheme: testScheme}
+			objs, err := r.render(context.Background(), tc.workload)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("render() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if len(objs) != 1 || objs[0].GetKind() != "Service" || objs[0].GetName() != "shop" ||
+				objs[0].GetNamespace() != "default" {
+				t.Fatalf("render() = %v", objs)
+			}
+			if refs := objs[0].GetOwnerReferences(); len(refs) != 1 || refs[0].UID != tc.workload.UID {
+				t.Errorf("render() owner references = %v", refs)
+			}
+		})
+	}
+}
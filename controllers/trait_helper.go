@@ -0,0 +1,232 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// kindContainerizedWorkload is the kind traits refer to unless their
+// workloadRef declares another.
+const kindContainerizedWorkload = "ContainerizedWorkload"
+
+// Kinds of the traits that do not record the fields they set.
+const (
+	kindManualScalerTrait = "ManualScalerTrait"
+	kindKEDAScalerTrait   = "KEDAScalerTrait"
+	kindHelmChartTrait    = "HelmChartTrait"
+	kindRestartTrait      = "RestartTrait"
+)
+
+// fetch the deployment rendered for the workload a trait refers to
+func (w *WorkloadResolver) fetchWorkloadDeployment(ctx context.Context, c client.Client, log logr.Logger,
+	namespace string, ref oamv1alpha2.ResourceReference) (*appsv1.Deployment, error) {
+	gvk, err := workloadGroupVersionKind(ref)
+	if err != nil {
+		return nil, err
+	}
+	key := workloadKey{GroupVersionKind: gvk, ObjectKey: client.ObjectKey{Name: ref.Name, Namespace: namespace}}
+	var deploy appsv1.Deployment
+	if e, ok := w.cache().get(key); ok {
+		if err := w.checkWorkload(log, ref, e.workload); err != nil {
+			return nil, err
+		}
+		err := c.Get(ctx, client.ObjectKey{Name: e.name, Namespace: namespace}, &deploy)
+		if err == nil {
+			deploymentResolutions.WithLabelValues(resolutionCached).Inc()
+			return &deploy, nil
+		}
+		if apierrors.IsForbidden(err) {
+			return nil, errors.Wrap(err, errLocateDeployment)
+		}
+		w.cache().forget(key)
+	}
+
+	epoch := w.cache().epoch()
+	workload, resources, err := w.fetchWorkloadResources(ctx, c, log, namespace, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO(rz): only apply if there is only one deployment
+	// Fetch the deployment we are going to modify
+	for _, res := range resources {
+		if res.Kind == KindDeployment {
+			dn := client.ObjectKey{Name: res.Name, Namespace: namespace}
+			if err := c.Get(ctx, dn, &deploy); err != nil {
+				log.Error(err, "Failed to get an associated deployment", "name ", res.Name)
+				// no other deployment can be read either
+				if apierrors.IsForbidden(err) {
+					return nil, errors.Wrap(err, errLocateDeployment)
+				}
+				continue
+			}
+			log.Info("Get the deployment the trait is going to modify", "deploy name", deploy.Name,
+				"UID", deploy.UID)
+			deploymentResolutions.WithLabelValues(resolutionResolved).Inc()
+			w.cache().put(key, epoch, resolvedDeployment{workload: resolvedWorkload(workload), name: deploy.Name})
+			return &deploy, nil
+		}
+	}
+	log.Info("Cannot locate a deployment", "total resources", len(resources))
+	return nil, fmt.Errorf(errLocateDeployment)
+}
+
+// the apiVersion and kind a workload reference declares, a
+// ContainerizedWorkload by default
+func workloadGroupVersionKind(ref oamv1alpha2.ResourceReference) (schema.GroupVersionKind, error) {
+	gvk := oamv1alpha2.GroupVersion.WithKind(kindContainerizedWorkload)
+	if ref.APIVersion != "" {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			return schema.GroupVersionKind{}, errors.Wrap(err, errLocateWorkload)
+		}
+		gvk.Group, gvk.Version = gv.Group, gv.Version
+	}
+	if ref.Kind != "" {
+		gvk.Kind = ref.Kind
+	}
+	return gvk, nil
+}
+
+// fetchWorkloadResources fetches the workload of the apiVersion and kind the
+// reference declares, a ContainerizedWorkload by default, and returns it along
+// with the resources it rendered. A Deployment is its own resource, the
+// resources of other kinds are read from their status.resources the way a
+// ContainerizedWorkload lists them.
+func (w *WorkloadResolver) fetchWorkloadResources(ctx context.Context, c client.Client, log logr.Logger,
+	namespace string, ref oamv1alpha2.ResourceReference) (metav1.Object, []oamv1alpha2.ResourceReference, error) {
+	gvk, err := workloadGroupVersionKind(ref)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Fetch the workload this trait is referring to
+	var workload metav1.Object
+	var resources []oamv1alpha2.ResourceReference
+	wn := client.ObjectKey{Name: ref.Name, Namespace: namespace}
+	switch gvk {
+	case oamv1alpha2.GroupVersion.WithKind(kindContainerizedWorkload):
+		cw := &oamv1alpha2.ContainerizedWorkload{}
+		err = c.Get(ctx, wn, cw)
+		workload, resources = cw, cw.Status.Resources
+	case appsv1.SchemeGroupVersion.WithKind(KindDeployment):
+		deploy := &appsv1.Deployment{}
+		err = c.Get(ctx, wn, deploy)
+		workload = deploy
+		resources = []oamv1alpha2.ResourceReference{{
+			APIVersion: appsv1.SchemeGroupVersion.String(), Kind: KindDeployment, Name: ref.Name,
+		}}
+	default:
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(gvk)
+		err = c.Get(ctx, wn, u)
+		workload = u
+		if err == nil {
+			resources, err = unstructuredResources(u)
+		}
+	}
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			workloadLookups.WithLabelValues(lookupNotFound).Inc()
+		}
+		return nil, nil, errors.Wrapf(err, "%s %s %s", errLocateWorkload, gvk.Kind, ref.Name)
+	}
+	log.Info("Get the workload the trait is pointing to", "workload kind", gvk.Kind, "workload name", ref.Name,
+		"UID", workload.GetUID())
+
+	if err := w.checkWorkload(log, ref, workload); err != nil {
+		return nil, nil, err
+	}
+	if ref.UID != nil && workload.GetUID() != *ref.UID {
+		log.Info("The workload was recreated, matched it by name", "trait references to ", ref.UID)
+		workloadLookups.WithLabelValues(lookupNameMatch).Inc()
+	} else {
+		workloadLookups.WithLabelValues(lookupHit).Inc()
+	}
+	return workload, resources, nil
+}
+
+// checkWorkload returns an error unless the reference names the workload and
+// the children of the workload are in the cluster traits apply to.
+func (w *WorkloadResolver) checkWorkload(log logr.Logger, ref oamv1alpha2.ResourceReference,
+	workload metav1.Object) error {
+	if !referencesWorkload(ref, workload) {
+		log.Info("Wrong workload", "trait references to ", ref.UID, "strict", ref.StrictUID)
+		workloadLookups.WithLabelValues(lookupUIDMismatch).Inc()
+		return fmt.Errorf(errLocateWorkload)
+	}
+	if name := workload.GetAnnotations()[oamv1alpha2.AnnotationKubeconfigContext]; name != "" && w.routesContexts() {
+		log.Info("The workload is applied to another cluster", "kubeconfig context", name)
+		return errors.Errorf("%s %s", errRemoteWorkload, name)
+	}
+	return nil
+}
+
+// the status.resources of a workload of a kind unknown to the manager
+func unstructuredResources(u *unstructured.Unstructured) ([]oamv1alpha2.ResourceReference, error) {
+	status, _, err := unstructured.NestedMap(u.Object, "status")
+	if err != nil {
+		return nil, err
+	}
+	var s struct {
+		Resources []oamv1alpha2.ResourceReference `json:"resources"`
+	}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(status, &s)
+	return s.Resources, err
+}
+
+// referencesWorkload returns true if the reference names the workload, which
+// is of the apiVersion and kind of the reference. Its UID must match only in
+// strict mode, so that a trait keeps applying to a workload that was deleted
+// and created again.
+func referencesWorkload(ref oamv1alpha2.ResourceReference, workload metav1.Object) bool {
+	if ref.Name != workload.GetName() {
+		return false
+	}
+	if ref.StrictUID {
+		return ref.UID != nil && *ref.UID == workload.GetUID()
+	}
+	return true
+}
+
+// add or update a non controller owner reference to the trait, a workload's
+// deployment can be modified by several traits
+func setTraitOwnerReference(obj metav1.Object, apiVersion, kind string, trait metav1.Object) {
+	isController := false
+	bod := true
+	ref := metav1.OwnerReference{
+		APIVersion:         apiVersion,
+		Kind:               kind,
+		Name:               trait.GetName(),
+		UID:                trait.GetUID(),
+		Controller:         &isController,
+		BlockOwnerDeletion: &bod,
+	}
+
+	existingRefs := obj.GetOwnerReferences()
+	fi := -1
+	for i, r := range existingRefs {
+		if r.UID == trait.GetUID() {
+			fi = i
+			break
+		}
+	}
+	if fi == -1 {
+		existingRefs = append(existingRefs, ref)
+	} else {
+		existingRefs[fi] = ref
+	}
+	obj.SetOwnerReferences(existingRefs)
+}
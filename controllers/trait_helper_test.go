@@ -0,0 +1,136 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/simtest"
+)
+
+func TestFetchWorkloadDeployment(t *testing.T) {
+	workload := &oamv1alpha2.ContainerizedWorkload{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web-deployment", Namespace: "default"}}
+	h, err := simtest.New(workload, []runtime.Object{deploy})
+	if err != nil {
+		t.Fatal(err)
+	}
+	custom := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.dev/v1",
+		"kind":       "WebService",
+		"metadata":   map[string]interface{}{"name": "custom", "namespace": "default"},
+		"status": map[string]interface{}{"resources": []interface{}{
+			map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "name": deploy.Name},
+		}},
+	}}
+	if err := h.Create(context.Background(), custom); err != nil {
+		t.Fatal(err)
+	}
+	remote := &oamv1alpha2.ContainerizedWorkload{ObjectMeta: metav1.ObjectMeta{Name: "remote", Namespace: "default",
+		Annotations: map[string]string{oamv1alpha2.AnnotationKubeconfigContext: "kind-staging"}},
+		Status: oamv1alpha2.ContainerizedWorkloadStatus{Resources: []oamv1alpha2.ResourceReference{
+			{APIVersion: "apps/v1", Kind: "Deployment", Name: deploy.Name},
+		}}}
+	if err := h.Create(context.Background(), remote); err != nil {
+		t.Fatal(err)
+	}
+	stale := types.UID("recreated-uid")
+
+	testCases := map[string]struct {
+		ref     func(ref oamv1alpha2.ResourceReference) oamv1alpha2.ResourceReference
+		routed  bool
+		wantErr bool
+	}{
+		"MatchingUID": {
+			ref: func(ref oamv1alpha2.ResourceReference) oamv1alpha2.ResourceReference { return ref },
+		},
+		"NoUID": {
+			ref: func(ref oamv1alpha2.ResourceReference) oamv1alpha2.ResourceReference {
+				ref.UID = nil
+				return ref
+			},
+		},
+		"RecreatedWorkload": {
+			ref: func(ref oamv1alpha2.ResourceReference) oamv1alpha2.ResourceReference {
+				ref.UID = &stale
+				return ref
+			},
+		},
+		"StrictMatchingUID": {
+			ref: func(ref oamv1alpha2.ResourceReference) oamv1alpha2.ResourceReference {
+				ref.StrictUID = true
+				return ref
+			},
+		},
+		"StrictRecreatedWorkload": {
+			ref: func(ref oamv1alpha2.ResourceReference) oamv1alpha2.ResourceReference {
+				ref.UID = &stale
+				ref.StrictUID = true
+				return ref
+			},
+			wantErr: true,
+		},
+		"StrictNoUID": {
+			ref: func(ref oamv1alpha2.ResourceReference) oamv1alpha2.ResourceReference {
+				ref.UID = nil
+				ref.StrictUID = true
+				return ref
+			},
+			wantErr: true,
+		},
+		"Deployment": {
+			ref: func(ref oamv1alpha2.ResourceReference) oamv1alpha2.ResourceReference {
+				return oamv1alpha2.ResourceReference{APIVersion: "apps/v1", Kind: "Deployment", Name: deploy.Name}
+			},
+		},
+		"OtherKind": {
+			ref: func(ref oamv1alpha2.ResourceReference) oamv1alpha2.ResourceReference {
+				return oamv1alpha2.ResourceReference{APIVersion: "example.dev/v1", Kind: "WebService", Name: "custom"}
+			},
+		},
+		"IgnoredKubeconfigContext": {
+			ref: func(ref oamv1alpha2.ResourceReference) oamv1alpha2.ResourceReference {
+				return oamv1alpha2.ResourceReference{Name: remote.Name}
+			},
+		},
+		"RemoteWorkload": {
+			ref: func(ref oamv1alpha2.ResourceReference) oamv1alpha2.ResourceReference {
+				return oamv1alpha2.ResourceReference{Name: remote.Name}
+			},
+			routed:  true,
+			wantErr: true,
+		},
+		"UnknownKind": {
+			ref: func(ref oamv1alpha2.ResourceReference) oamv1alpha2.ResourceReference {
+				ref.Kind = "StatefulWorkload"
+				return ref
+			},
+			wantErr: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			w := &WorkloadResolver{RoutedContexts: tc.routed}
+			got, err := w.fetchWorkloadDeployment(context.Background(), h, ctrl.Log, "default", tc.ref(h.WorkloadReference()))
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("fetchWorkloadDeployment() = %s, want an error", got.Name)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("fetchWorkloadDeployment() = %v", err)
+			}
+			if got.Name != deploy.Name {
+				t.Errorf("fetchWorkloadDeployment() = %s, want %s", got.Name, deploy.Name)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package controllers
+
+import (
+	"strings"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// eventTraitFailed is the reason of the event recorded on a workload when one
+// of its traits fails to reconcile.
+const eventTraitFailed = "TraitFailed"
+
+// errRecordTraitFailures is returned when the trait informers cannot be
+// watched.
+const errRecordTraitFailures = "cannot watch the traits to record their failures"
+
+// the traits whose failures are recorded on their workloads
+var failingTraits = map[string]runtime.Object{
+	"CostTrait":               &oamv1alpha2.CostTrait{},
+	"DebugTrait":              &oamv1alpha2.DebugTrait{},
+	"DeploymentStrategyTrait": &oamv1alpha2.DeploymentStrategyTrait{},
+	"HelmChartTrait":          &oamv1alpha2.HelmChartTrait{},
+	"IdentityTrait":           &oamv1alpha2.IdentityTrait{},
+	"InitTrait":               &oamv1alpha2.InitTrait{},
+	"KEDAScalerTrait":         &oamv1alpha2.KEDAScalerTrait{},
+	"ManualScalerTrait":       &oamv1alpha2.ManualScalerTrait{},
+	"PatchTrait":              &oamv1alpha2.PatchTrait{},
+	"PriorityClassTrait":      &oamv1alpha2.PriorityClassTrait{},
+	"RestartTrait":            &oamv1alpha2.RestartTrait{},
+	"RuntimeClassTrait":       &oamv1alpha2.RuntimeClassTrait{},
+	"ScratchStorageTrait":     &oamv1alpha2.ScratchStorageTrait{},
+	"SpreadTrait":             &oamv1alpha2.SpreadTrait{},
+	"VerticalScalerTrait":     &oamv1alpha2.VerticalScalerTrait{},
+}
+
+// the parts of a trait its failures are recorded from
+type traitStatus struct {
+	Spec struct {
+		WorkloadReference oamv1alpha2.ResourceReference `json:"workloadRef"`
+	} `json:"spec"`
+	Status cpv1alpha1.ConditionedStatus `json:"status"`
+}
+
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+// RecordTraitFailures records a warning event on the workload of a trait
+// whenever the trait starts failing to reconcile, or fails with another
+// error, so that the owners of the workload see the failures of its traits
+// without watching each of them. The traits are watched through the
+// manager's cache, those of the kinds enabled, keyed by the lower case kind,
+// e.g. manualscalertrait.
+func RecordTraitFailures(mgr ctrl.Manager, events record.EventRecorder, enabled map[string]bool) error {
+	for kind, obj := range failingTraits {
+		if !enabled[strings.ToLower(kind)] {
+			continue
+		}
+		informer, err := mgr.GetCache().GetInformer(obj)
+		if err != nil {
+			return errors.Wrap(err, errRecordTraitFailures)
+		}
+		kind := kind
+		informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(old, obj interface{}) {
+				recordTraitFailure(events, kind, old, obj)
+			},
+		})
+	}
+	return nil
+}
+
+// recordTraitFailure records an event on the workload of the trait if it
+// failed to sync with another error than before its update.
+func recordTraitFailure(events record.EventRecorder, kind string, old, obj interface{}) {
+	trait, ok := readTraitStatus(obj)
+	if !ok {
+		return
+	}
+	failure := trait.Status.GetCondition(cpv1alpha1.TypeSynced)
+	if failure.Status != corev1.ConditionFalse {
+		return
+	}
+	if previous, ok := readTraitStatus(old); ok {
+		if p := previous.Status.GetCondition(cpv1alpha1.TypeSynced); p.Status == corev1.ConditionFalse &&
+			p.Message == failure.Message {
+			return
+		}
+	}
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+	ref := trait.Spec.WorkloadReference
+	if ref.Kind == "" || ref.Name == "" {
+		return
+	}
+	workload := &unstructured.Unstructured{}
+	workload.SetAPIVersion(ref.APIVersion)
+	workload.SetKind(ref.Kind)
+	workload.SetNamespace(m.GetNamespace())
+	workload.SetName(ref.Name)
+	if ref.UID != nil {
+		workload.SetUID(*ref.UID)
+	}
+	events.Eventf(workload, corev1.EventTypeWarning, eventTraitFailed, "%s %s failed to reconcile: %s", kind,
+		m.GetName(), failure.Message)
+}
+
+func readTraitStatus(obj interface{}) (*traitStatus, bool) {
+	o, ok := obj.(runtime.Object)
+	if !ok {
+		return nil, false
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+	if err != nil {
+		return nil, false
+	}
+	trait := &traitStatus{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, trait); err != nil {
+		return nil, false
+	}
+	return trait, true
+}
@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"testing"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestRecordTraitFailure(t *testing.T) {
+	trait := func(conditions ...cpv1alpha1.Condition) *oamv1alpha2.ManualScalerTrait {
+		tr := &oamv1alpha2.ManualScalerTrait{
+			ObjectMeta: metav1.ObjectMeta{Name: "scaler", Namespace: "default"},
+			Spec: oamv1alpha2.ManualScalerTraitSpec{WorkloadReference: oamv1alpha2.ResourceReference{
+				APIVersion: oamv1alpha2.GroupVersion.String(), Kind: "ContainerizedWorkload", Name: "web",
+			}},
+		}
+		tr.Status.SetConditions(conditions...)
+		return tr
+	}
+	failing := cpv1alpha1.ReconcileError(errors.New("boom"))
+	cases := map[string]struct {
+		old, obj *oamv1alpha2.ManualScalerTrait
+		want     string
+	}{
+		"StartsFailing": {
+			old:  trait(cpv1alpha1.ReconcileSuccess()),
+			obj:  trait(failing),
+			want: "Warning TraitFailed ManualScalerTrait scaler failed to reconcile: boom",
+		},
+		"FailsAgain": {
+			old: trait(failing),
+			obj: trait(failing),
+		},
+		"FailsDifferently": {
+			old:  trait(failing),
+			obj:  trait(cpv1alpha1.ReconcileError(errors.New("bang"))),
+			want: "Warning TraitFailed ManualScalerTrait scaler failed to reconcile: bang",
+		},
+		"Recovers": {
+			old: trait(failing),
+			obj: trait(cpv1alpha1.ReconcileSuccess()),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			events := record.NewFakeRecorder(1)
+			recordTraitFailure(events, "ManualScalerTrait", tc.old, tc.obj)
+			got := ""
+			select {
+			case got = <-events.Events:
+			default:
+			}
+			if got != tc.want {
+				t.Errorf("recordTraitFailure() recorded %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
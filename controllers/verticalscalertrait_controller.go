@@ -0,0 +1,309 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/debug"
+)
+
+// Reconcile error strings.
+const (
+	errRenderVPA           = "cannot render the vertical pod autoscaler"
+	errApplyVPA            = "cannot apply the vertical pod autoscaler"
+	errParseRecommendation = "cannot parse the recommendations of the vertical pod autoscaler"
+	errApplyRequests       = "cannot apply the recommended requests"
+)
+
+// VerticalPodAutoscalerGroupVersionKind is the kind rendered by
+// VerticalScalerTraits.
+var VerticalPodAutoscalerGroupVersionKind = schema.GroupVersionKind{
+	Group:   "autoscaling.k8s.io",
+	Version: "v1",
+	Kind:    "VerticalPodAutoscaler",
+}
+
+// VerticalScalerTraitReconciler reconciles a VerticalScalerTrait object
+type VerticalScalerTraitReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// Limiter, if set, rate limits the writes of child resources per namespace.
+	Limiter *NamespaceWriteLimiter
+	// Debug, if set, records the outcome of each reconcile.
+	Debug *debug.Recorder
+	// Workloads, if set, resolves the workload the trait refers to, see
+	// WorkloadResolver.
+	Workloads *WorkloadResolver
+}
+
+// +kubebuilder:rbac:groups=core.oam.dev,resources=verticalscalertraits,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=core.oam.dev,resources=verticalscalertraits/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=autoscaling.k8s.io,resources=verticalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=core.oam.dev,resources=resourcetrackers,verbs=get;list;watch;create;update;delete
+
+func (r *VerticalScalerTraitReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("verticalscaler trait", req.NamespacedName)
+	log.Info("Reconcile vertical scaler trait")
+
+	var trait oamv1alpha2.VerticalScalerTrait
+	if err := r.Get(ctx, req.NamespacedName, &trait); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// revert the requests set in Deployment mode before the autoscaler goes
+	reverted, err := r.Workloads.finalizeManagedFields(ctx, r, log,
+		&trait, kindVerticalScalerTrait, trait.Spec.WorkloadReference)
+	if err != nil {
+		log.Error(err, "Failed to revert the fields set by the trait")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, err
+	}
+	deleted, err := finalizeTrackedResources(ctx, r, &trait)
+	if err != nil {
+		log.Error(err, "Failed to finalize the tracked resources")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, err
+	}
+	if reverted || deleted {
+		return ctrl.Result{}, nil
+	}
+
+	deploy, err := r.Workloads.fetchWorkloadDeployment(ctx, r, log, req.Namespace, trait.Spec.WorkloadReference)
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+
+	if result, ok, err := gateReconcile(ctx, r, log, r.Limiter, kindVerticalScalerTrait, &trait, &trait.Status.ConditionedStatus,
+		&trait.Spec.WorkloadReference); !ok {
+		return result, err
+	}
+
+	vpa, err := r.renderVerticalPodAutoscaler(&trait, deploy)
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(errors.Wrap(err, errRenderVPA))...)
+		log.Error(err, "Failed to render a vertical pod autoscaler")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+
+	// server side apply, only the fields we set are touched
+	applyOpts := []client.PatchOption{client.ForceOwnership, client.FieldOwner(trait.Name)}
+	err = retryTransient(applyBackoff, nil, func() error {
+		return r.Patch(ctx, vpa, client.Apply, applyOpts...)
+	})
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(errors.Wrap(err, errApplyVPA))...)
+		log.Error(err, "Failed to apply a vertical pod autoscaler")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	log.Info("Successfully applied a vertical pod autoscaler", "UID", vpa.GetUID())
+
+	if err := trackResources(ctx, r, r.Scheme, &trait, vpa); err != nil {
+		trait.Status.SetConditions(reconcileError(err)...)
+		log.Error(err, "Failed to track resources")
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	uid := vpa.GetUID()
+	trait.Status.Resources = []oamv1alpha2.ResourceReference{{
+		APIVersion: vpa.GetAPIVersion(),
+		Kind:       vpa.GetKind(),
+		Name:       vpa.GetName(),
+		UID:        &uid,
+	}}
+
+	// the api server returned the recommendations along with the autoscaler
+	recommendations, err := vpaRecommendations(vpa)
+	if err != nil {
+		trait.Status.SetConditions(reconcileError(errors.Wrap(err, errParseRecommendation))...)
+		return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+			errUpdateStatus)
+	}
+	trait.Status.Recommendations = recommendations
+
+	if trait.Spec.UpdateMode == oamv1alpha2.VerticalScalerUpdateModeDeployment && len(recommendations) > 0 {
+		// merge the requests, refetching the deployment if it changed under us
+		err = retryTransient(applyBackoff, func() error {
+			return r.Get(ctx, client.ObjectKey{Name: deploy.Name, Namespace: deploy.Namespace}, deploy)
+		}, func() error {
+			rd := recommendedDeployment(&trait, deploy)
+			if err := recordManagedFields(deploy, rd, managedFieldsKey(kindVerticalScalerTrait, trait.Name)); err != nil {
+				return err
+			}
+			return r.Patch(ctx, rd, client.MergeFrom(deploy))
+		})
+		if err != nil {
+			trait.Status.SetConditions(reconcileError(errors.Wrap(err, errApplyRequests))...)
+			log.Error(err, "Failed to apply the recommended requests to a deployment")
+			return ctrl.Result{RequeueAfter: oamReconcileWait}, errors.Wrap(r.Status().Update(ctx, &trait),
+				errUpdateStatus)
+		}
+		log.Info("Successfully applied the recommended requests to a deployment", "UID", deploy.UID)
+	}
+
+	r.Limiter.MarkForceSynced(&trait)
+	trait.Status.SetConditions(oamv1alpha2.PermissionGranted(), cpv1alpha1.ReconcileSuccess())
+	return ctrl.Result{}, errors.Wrap(r.Status().Update(ctx, &trait), errUpdateStatus)
+}
+
+// create a vertical pod autoscaler targeting the deployment
+func (r *VerticalScalerTraitReconciler) renderVerticalPodAutoscaler(trait *oamv1alpha2.VerticalScalerTrait,
+	deploy *appsv1.Deployment) (*unstructured.Unstructured, error) {
+	mode := trait.Spec.UpdateMode
+	switch mode {
+	case "":
+		mode = oamv1alpha2.VerticalScalerUpdateModeAuto
+	case oamv1alpha2.VerticalScalerUpdateModeDeployment:
+		// the trait applies the recommendations itself
+		mode = oamv1alpha2.VerticalScalerUpdateModeOff
+	}
+	spec := map[string]interface{}{
+		"targetRef": map[string]interface{}{
+			"apiVersion": appsv1.SchemeGroupVersion.String(),
+			"kind":       KindDeployment,
+			"name":       deploy.Name,
+		},
+		"updatePolicy": map[string]interface{}{"updateMode": string(mode)},
+	}
+	if len(trait.Spec.ContainerPolicies) > 0 {
+		policies := make([]interface{}, 0, len(trait.Spec.ContainerPolicies))
+		for _, p := range trait.Spec.ContainerPolicies {
+			policy := map[string]interface{}{"containerName": p.ContainerName}
+			if len(p.MinAllowed) > 0 {
+				policy["minAllowed"] = resourceListObject(p.MinAllowed)
+			}
+			if len(p.MaxAllowed) > 0 {
+				policy["maxAllowed"] = resourceListObject(p.MaxAllowed)
+			}
+			policies = append(policies, policy)
+		}
+		spec["resourcePolicy"] = map[string]interface{}{"containerPolicies": policies}
+	}
+
+	vpa := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	vpa.SetGroupVersionKind(VerticalPodAutoscalerGroupVersionKind)
+	vpa.SetName(trait.Name)
+	vpa.SetNamespace(trait.Namespace)
+
+	// always set the controller reference so that we can watch this autoscaler
+	if err := ctrl.SetControllerReference(trait, vpa, r.Scheme); err != nil {
+		return nil, err
+	}
+	return vpa, nil
+}
+
+func resourceListObject(l corev1.ResourceList) map[string]interface{} {
+	m := make(map[string]interface{}, len(l))
+	for k, v := range l {
+		m[string(k)] = v.String()
+	}
+	return m
+}
+
+// vpaRecommendations returns the target requests the autoscaler recommends
+// for each container, if it made any recommendation yet
+func vpaRecommendations(vpa *unstructured.Unstructured) ([]oamv1alpha2.VerticalScalerRecommendation, error) {
+	containers, _, err := unstructured.NestedSlice(vpa.Object, "status", "recommendation", "containerRecommendations")
+	if err != nil {
+		return nil, err
+	}
+	var recommendations []oamv1alpha2.VerticalScalerRecommendation
+	for _, c := range containers {
+		cm, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, err := unstructured.NestedString(cm, "containerName")
+		if err != nil {
+			return nil, err
+		}
+		target, _, err := unstructured.NestedStringMap(cm, "target")
+		if err != nil {
+			return nil, err
+		}
+		rec := oamv1alpha2.VerticalScalerRecommendation{ContainerName: name, Target: corev1.ResourceList{}}
+		for k, v := range target {
+			q, err := resource.ParseQuantity(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "container %s %s", name, k)
+			}
+			rec.Target[corev1.ResourceName(k)] = q
+		}
+		recommendations = append(recommendations, rec)
+	}
+	return recommendations, nil
+}
+
+// recommendedDeployment returns a copy of the deployment with the requests of
+// its containers set to those recommended, but not above their limits
+func recommendedDeployment(trait *oamv1alpha2.VerticalScalerTrait, deploy *appsv1.Deployment) *appsv1.Deployment {
+	rd := deploy.DeepCopy()
+	for _, rec := range trait.Status.Recommendations {
+		for i := range rd.Spec.Template.Spec.Containers {
+			c := &rd.Spec.Template.Spec.Containers[i]
+			if c.Name != rec.ContainerName {
+				continue
+			}
+			if c.Resources.Requests == nil {
+				c.Resources.Requests = corev1.ResourceList{}
+			}
+			for k, v := range rec.Target {
+				if limit, ok := c.Resources.Limits[k]; ok && v.Cmp(limit) > 0 {
+					v = limit
+				}
+				c.Resources.Requests[k] = v
+			}
+		}
+	}
+	// always set the owner reference so that we can watch this deployment
+	setTraitOwnerReference(rd, trait.APIVersion, trait.Kind, trait)
+	return rd
+}
+
+func (r *VerticalScalerTraitReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	vpa := &unstructured.Unstructured{}
+	vpa.SetGroupVersionKind(VerticalPodAutoscalerGroupVersionKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&oamv1alpha2.VerticalScalerTrait{}).
+		Owns(vpa).
+		Watches(&source.Kind{
+			Type: &appsv1.Deployment{},
+		}, &handler.EnqueueRequestForOwner{
+			OwnerType:    &oamv1alpha2.VerticalScalerTrait{},
+			IsController: false, // other traits may own the deployment too
+		}).
+		Complete(r.Debug.Wrap("VerticalScalerTrait", r))
+}
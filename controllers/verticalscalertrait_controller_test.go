@@ -0,0 +1,60 @@
+package controllers
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestRecommendedDeployment(t *testing.T) {
+	vpa := &unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{"recommendation": map[string]interface{}{
+			"containerRecommendations": []interface{}{map[string]interface{}{
+				"containerName": "web",
+				"target":        map[string]interface{}{"cpu": "250m", "memory": "2Gi"},
+			}},
+		}},
+	}}
+	recommendations, err := vpaRecommendations(vpa)
+	if err != nil {
+		t.Fatalf("vpaRecommendations() = %v", err)
+	}
+	trait := &oamv1alpha2.VerticalScalerTrait{
+		TypeMeta:   metav1.TypeMeta{APIVersion: oamv1alpha2.GroupVersion.String(), Kind: "VerticalScalerTrait"},
+		ObjectMeta: metav1.ObjectMeta{Name: "vpa", UID: "trait-uid"},
+		Status:     oamv1alpha2.VerticalScalerTraitStatus{Recommendations: recommendations},
+	}
+	deploy := &appsv1.Deployment{Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+		Containers: []corev1.Container{
+			{Name: "web", Resources: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			}},
+			{Name: "sidecar"},
+		},
+	}}}}
+
+	got := recommendedDeployment(trait, deploy)
+	requests := got.Spec.Template.Spec.Containers[0].Resources.Requests
+	if cpu := requests[corev1.ResourceCPU]; cpu.String() != "250m" {
+		t.Errorf("recommendedDeployment() cpu request = %s, want 250m", cpu.String())
+	}
+	// requests are capped at the limits of the container
+	if memory := requests[corev1.ResourceMemory]; memory.String() != "1Gi" {
+		t.Errorf("recommendedDeployment() memory request = %s, want 1Gi", memory.String())
+	}
+	if r := got.Spec.Template.Spec.Containers[1].Resources.Requests; len(r) != 0 {
+		t.Errorf("recommendedDeployment() set requests %v on a container without recommendation", r)
+	}
+	if refs := got.GetOwnerReferences(); len(refs) != 1 || refs[0].UID != trait.UID {
+		t.Errorf("recommendedDeployment() owner references = %v", refs)
+	}
+	if deploy.Spec.Template.Spec.Containers[0].Resources.Requests != nil {
+		t.Errorf("recommendedDeployment() modified the original deployment")
+	}
+}
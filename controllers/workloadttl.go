@@ -0,0 +1,101 @@
+package controllers
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/resync"
+)
+
+// eventWorkloadExpired is the reason of the event recorded when a workload is
+// deleted at the end of its TTL.
+const eventWorkloadExpired = "Expired"
+
+// Workload TTL error strings.
+const (
+	errDeleteTrait    = "cannot delete a trait of the workload"
+	errDeleteWorkload = "cannot delete the expired workload"
+)
+
+// +kubebuilder:rbac:groups=core.oam.dev,resources=containerizedworkloads,verbs=delete
+// +kubebuilder:rbac:groups=core.oam.dev,resources=manualscalertraits;kedascalertraits;patchtraits;inittraits;spreadtraits;verticalscalertraits;runtimeclasstraits;priorityclasstraits;identitytraits;deploymentstrategytraits;helmcharttraits;costtraits;restarttraits;debugtraits;scratchstoragetraits,verbs=list;delete
+
+// expireWorkload deletes the workload along with the traits applied to it
+// once its TTL has passed, leaving its children to the finalizer of the
+// tracked resources. A workload protected from deletion is kept along with
+// its traits, with an ExpiryBlocked condition. Otherwise it sets the
+// status.expiresAt of the workload and returns the time left until then, or
+// zero if the workload has no TTL.
+func expireWorkload(ctx context.Context, c client.Client, s *runtime.Scheme,
+	workload *oamv1alpha2.ContainerizedWorkload, now time.Time) (time.Duration, bool, error) {
+	if workload.Spec.TTL == nil {
+		workload.Status.ExpiresAt = nil
+		if workload.Status.GetCondition(oamv1alpha2.TypeExpiryBlocked).Status == corev1.ConditionTrue {
+			workload.Status.SetConditions(oamv1alpha2.ExpiryNotBlocked())
+		}
+		return 0, false, nil
+	}
+	expiresAt := workload.CreationTimestamp.Add(workload.Spec.TTL.Duration)
+	if left := expiresAt.Sub(now); left > 0 {
+		at := metav1.NewTime(expiresAt)
+		workload.Status.ExpiresAt = &at
+		workload.Status.SetConditions(oamv1alpha2.ExpiryNotBlocked())
+		return left, false, nil
+	}
+
+	// the webhook would deny the deletion of the workload after its traits
+	// are gone, so nothing is deleted
+	if protected, _ := strconv.ParseBool(workload.GetAnnotations()[oamv1alpha2.AnnotationDeletionProtection]); protected {
+		workload.Status.SetConditions(oamv1alpha2.ExpiryBlocked())
+		return 0, false, nil
+	}
+	if err := deleteTraits(ctx, c, s, workload); err != nil {
+		return 0, false, err
+	}
+	return 0, true, errors.Wrap(client.IgnoreNotFound(c.Delete(ctx, workload)), errDeleteWorkload)
+}
+
+// deleteTraits deletes the traits of every kind that refer to the workload.
+func deleteTraits(ctx context.Context, c client.Client, s *runtime.Scheme,
+	workload *oamv1alpha2.ContainerizedWorkload) error {
+	for _, kind := range resync.Kinds(s) {
+		if !strings.HasSuffix(kind, "Trait") {
+			continue
+		}
+		l := &unstructured.UnstructuredList{}
+		l.SetGroupVersionKind(oamv1alpha2.GroupVersion.WithKind(kind + "List"))
+		if err := c.List(ctx, l, client.InNamespace(workload.Namespace)); err != nil {
+			return errors.Wrapf(err, "%s of kind %s", errListTraits, kind)
+		}
+		for i := range l.Items {
+			trait := &l.Items[i]
+			if !refersTo(trait, workload) {
+				continue
+			}
+			if err := c.Delete(ctx, trait); client.IgnoreNotFound(err) != nil {
+				return errors.Wrapf(err, "%s: %s %s", errDeleteTrait, kind, trait.GetName())
+			}
+		}
+	}
+	return nil
+}
+
+// refersTo tells whether the workload reference of the trait points at the
+// workload.
+func refersTo(trait *unstructured.Unstructured, workload *oamv1alpha2.ContainerizedWorkload) bool {
+	apiVersion, _, _ := unstructured.NestedString(trait.Object, "spec", "workloadRef", "apiVersion")
+	name, _, _ := unstructured.NestedString(trait.Object, "spec", "workloadRef", "name")
+	kind, _, _ := unstructured.NestedString(trait.Object, "spec", "workloadRef", "kind")
+	return name == workload.Name && (kind == "" || kind == kindContainerizedWorkload) &&
+		(apiVersion == "" || apiVersion == oamv1alpha2.GroupVersion.String())
+}
@@ -0,0 +1,104 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oamv1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/simtest"
+)
+
+func TestExpireWorkload(t *testing.T) {
+	created := time.Date(2020, 4, 1, 9, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		ttl       *metav1.Duration
+		protected bool
+		now       time.Time
+		left      time.Duration
+		expired   bool
+		blocked   bool
+	}{
+		"NoTTL": {
+			now: created.Add(24 * time.Hour),
+		},
+		"Alive": {
+			ttl:  &metav1.Duration{Duration: time.Hour},
+			now:  created.Add(20 * time.Minute),
+			left: 40 * time.Minute,
+		},
+		"Expired": {
+			ttl:     &metav1.Duration{Duration: time.Hour},
+			now:     created.Add(time.Hour),
+			expired: true,
+		},
+		"Protected": {
+			ttl:       &metav1.Duration{Duration: time.Hour},
+			protected: true,
+			now:       created.Add(time.Hour),
+			blocked:   true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			workload := &oamv1alpha2.ContainerizedWorkload{
+				ObjectMeta: metav1.ObjectMeta{Name: "preview", Namespace: "default", CreationTimestamp: metav1.NewTime(created)},
+				Spec:       oamv1alpha2.ContainerizedWorkloadSpec{TTL: tc.ttl},
+			}
+			if tc.protected {
+				workload.Annotations = map[string]string{oamv1alpha2.AnnotationDeletionProtection: "true"}
+			}
+			other := &oamv1alpha2.ManualScalerTrait{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}}
+			other.Spec.WorkloadReference = oamv1alpha2.ResourceReference{Kind: kindContainerizedWorkload, Name: "web"}
+			foreign := &oamv1alpha2.ManualScalerTrait{ObjectMeta: metav1.ObjectMeta{Name: "foreign", Namespace: "default"}}
+			foreign.Spec.WorkloadReference = oamv1alpha2.ResourceReference{APIVersion: "example.com/v1",
+				Kind: kindContainerizedWorkload, Name: "preview"}
+			h, err := simtest.New(workload, nil, other, foreign)
+			if err != nil {
+				t.Fatal(err)
+			}
+			scaler := &oamv1alpha2.ManualScalerTrait{ObjectMeta: metav1.ObjectMeta{Name: "scaler", Namespace: "default"}}
+			scaler.Spec.WorkloadReference = h.WorkloadReference()
+			restart := &oamv1alpha2.RestartTrait{ObjectMeta: metav1.ObjectMeta{Name: "restart", Namespace: "default"}}
+			restart.Spec.WorkloadReference = h.WorkloadReference()
+			for _, obj := range []trackedOwner{scaler, restart} {
+				if err := h.Create(context.Background(), obj); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			w := h.Workload.DeepCopy()
+			left, expired, err := expireWorkload(context.Background(), h, h.Scheme, w, tc.now)
+			if err != nil {
+				t.Fatalf("expireWorkload: %v", err)
+			}
+			if left != tc.left || expired != tc.expired {
+				t.Errorf("expireWorkload = %v, %t, want %v, %t", left, expired, tc.left, tc.expired)
+			}
+			if tc.left > 0 && (w.Status.ExpiresAt == nil || !w.Status.ExpiresAt.Time.Equal(tc.now.Add(tc.left))) {
+				t.Errorf("status.expiresAt = %v, want %v", w.Status.ExpiresAt, tc.now.Add(tc.left))
+			}
+
+			gone := func(obj trackedOwner) bool {
+				key := client.ObjectKey{Namespace: "default", Name: obj.GetName()}
+				return apierrors.IsNotFound(h.Get(context.Background(), key, obj))
+			}
+			for _, obj := range []trackedOwner{h.Workload.DeepCopy(), scaler, restart} {
+				if gone(obj) != tc.expired {
+					t.Errorf("%T %s deleted: %t, want %t", obj, obj.GetName(), !tc.expired, tc.expired)
+				}
+			}
+			if gone(other) || gone(foreign) {
+				t.Error("the trait of another workload was deleted")
+			}
+			if got := w.Status.GetCondition(oamv1alpha2.TypeExpiryBlocked).Status == corev1.ConditionTrue; got != tc.blocked {
+				t.Errorf("ExpiryBlocked: %t, want %t", got, tc.blocked)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+#!/usr/bin/env bash
+
+# Regenerates the clientset, listers and informers under pkg/client from the
+# +genclient markers of api/v1alpha2.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+MODULE=github.com/oam-dev/core-resource-controller
+CODEGEN_VERSION=${CODEGEN_VERSION:-kubernetes-1.16.0}
+SCRIPT_ROOT=$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)
+
+# the generators write below GOPATH/src/<output package>, so generate into a
+# scratch GOPATH and copy the result back
+OUTPUT_BASE=$(mktemp -d)
+trap 'rm -rf "${OUTPUT_BASE}"' EXIT
+
+GOBIN="${OUTPUT_BASE}/bin"
+export GOBIN
+(
+  cd "${OUTPUT_BASE}"
+  go mod init tmp >/dev/null 2>&1
+  go get "k8s.io/code-generator/cmd/client-gen@${CODEGEN_VERSION}" \
+    "k8s.io/code-generator/cmd/lister-gen@${CODEGEN_VERSION}" \
+    "k8s.io/code-generator/cmd/informer-gen@${CODEGEN_VERSION}"
+)
+
+HEADER="${SCRIPT_ROOT}/hack/boilerplate.go.txt"
+APIS="${MODULE}/api/v1alpha2"
+OUTPUT="${MODULE}/pkg/client"
+
+cd "${SCRIPT_ROOT}"
+"${GOBIN}/client-gen" --go-header-file "${HEADER}" --output-base "${OUTPUT_BASE}" \
+  --clientset-name versioned --input-base "" --input "${APIS}" \
+  --output-package "${OUTPUT}/clientset"
+"${GOBIN}/lister-gen" --go-header-file "${HEADER}" --output-base "${OUTPUT_BASE}" \
+  --input-dirs "${APIS}" --output-package "${OUTPUT}/listers"
+"${GOBIN}/informer-gen" --go-header-file "${HEADER}" --output-base "${OUTPUT_BASE}" \
+  --input-dirs "${APIS}" --versioned-clientset-package "${OUTPUT}/clientset/versioned" \
+  --listers-package "${OUTPUT}/listers" --output-package "${OUTPUT}/informers"
+
+rm -rf "${SCRIPT_ROOT}/pkg/client"
+cp -r "${OUTPUT_BASE}/${OUTPUT}" "${SCRIPT_ROOT}/pkg/client"
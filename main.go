@@ -16,16 +16,51 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/pprof"
 	"os"
+	"os/exec"
+	"path/filepath"
+	goruntime "runtime"
+	"strings"
+	"time"
 
 	corev1alpha2 "github.com/oam-dev/core-resource-controller/api/v1alpha2"
 	"github.com/oam-dev/core-resource-controller/controllers"
+	"github.com/oam-dev/core-resource-controller/pkg/audit"
+	"github.com/oam-dev/core-resource-controller/pkg/bundle"
+	"github.com/oam-dev/core-resource-controller/pkg/capabilities"
+	"github.com/oam-dev/core-resource-controller/pkg/certs"
+	"github.com/oam-dev/core-resource-controller/pkg/compose"
+	"github.com/oam-dev/core-resource-controller/pkg/conditions"
+	"github.com/oam-dev/core-resource-controller/pkg/crds"
+	"github.com/oam-dev/core-resource-controller/pkg/debug"
+	"github.com/oam-dev/core-resource-controller/pkg/helm"
+	"github.com/oam-dev/core-resource-controller/pkg/migrate"
+	"github.com/oam-dev/core-resource-controller/pkg/policy"
+	"github.com/oam-dev/core-resource-controller/pkg/preflight"
+	"github.com/oam-dev/core-resource-controller/pkg/priorityclass"
+	"github.com/oam-dev/core-resource-controller/pkg/quota"
+	"github.com/oam-dev/core-resource-controller/pkg/renderhook"
+	"github.com/oam-dev/core-resource-controller/pkg/resync"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	appsv1client "k8s.io/client-go/kubernetes/typed/apps/v1"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/yaml"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -38,52 +73,546 @@ func init() {
 	_ = clientgoscheme.AddToScheme(scheme)
 
 	_ = corev1alpha2.AddToScheme(scheme)
+	_ = apiextensionsv1beta1.AddToScheme(scheme)
 	// +kubebuilder:scaffold:scheme
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "convert-compose" {
+		os.Exit(convertCompose(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "migrate-manualscalers" {
+		os.Exit(migrateManualScalers(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "resync" {
+		os.Exit(resyncNamespace(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		os.Exit(exportNamespace(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		os.Exit(importBundle(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "export-children" {
+		os.Exit(exportChildren(os.Args[2:]))
+	}
+
 	var metricsAddr string
 	var enableLeaderElection bool
+	var manageWebhookCerts bool
+	var namespace, certDir, webhookService, webhookSecret, capabilitiesConfigMap string
+	var scalePolicyURL string
+	var namespaceWriteQPS float64
+	var namespaceWriteBurst int
+	var patchTraitAllowedPaths string
+	var defaultObjectQuota int
+	var enableControllers string
+	var useScaleSubresource bool
+	var debugAddr string
+	var runPreflight bool
+	var installCRDs bool
+	var preRenderHookURL, postRenderHookURL string
+	var maxRenderedChildren, maxManifestBytes int
+	var devKubeconfig string
+	var helmBinary string
+	var conditionBackend string
+	var gitOpsTools string
+	var profilerAddr string
+	var blockProfileRate, mutexProfileFraction int
 	flag.StringVar(&metricsAddr, "metrics-addr", ":8080", "The address the metric endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "enable-leader-election", false,
 		"Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
+	flag.BoolVar(&manageWebhookCerts, "manage-webhook-certs", false,
+		"Generate, rotate and inject a self-signed webhook certificate instead of relying on cert-manager.")
+	flag.StringVar(&certDir, "webhook-cert-dir", filepath.Join(os.TempDir(), "k8s-webhook-server", "serving-certs"),
+		"The directory the webhook server reads tls.crt and tls.key from.")
+	flag.StringVar(&namespace, "namespace", "oam-system",
+		"The namespace the manager runs in, holding its webhook service, certificate secret and capabilities config map.")
+	flag.StringVar(&webhookService, "webhook-service", "oam-webhook-service", "The name of the webhook service.")
+	flag.StringVar(&webhookSecret, "webhook-cert-secret", "webhook-server-cert",
+		"The name of the secret the webhook certificate is stored in.")
+	flag.StringVar(&capabilitiesConfigMap, "capabilities-configmap", "oam-capabilities",
+		"The name of the config map the optional CRDs detected at startup are recorded in.")
+	flag.StringVar(&scalePolicyURL, "scale-policy-url", "",
+		"An Open Policy Agent data API URL that must allow scaling changes before traits apply them.")
+	flag.Float64Var(&namespaceWriteQPS, "namespace-write-qps", 0,
+		"The rate of child resource writes allowed per namespace. 0 disables the limit, "+
+			"and every change, including one to the oam.dev/force-sync annotation, is then applied right away.")
+	flag.IntVar(&namespaceWriteBurst, "namespace-write-burst", 10,
+		"The number of child resource writes a namespace may burst to above --namespace-write-qps.")
+	flag.StringVar(&patchTraitAllowedPaths, "patch-trait-allowed-paths",
+		strings.Join(controllers.DefaultPatchTraitAllowedPaths, ","),
+		"Comma separated pod template paths, e.g. metadata.annotations, that PatchTraits may change.")
+	flag.IntVar(&defaultObjectQuota, "default-object-quota", 0,
+		"The number of each OAM workload and trait kind a namespace may hold unless its quota.core.oam.dev/<resource> "+
+			"annotation says otherwise. 0 means unlimited.")
+	flag.StringVar(&enableControllers, "enable-controllers", "*",
+		"Comma separated controllers to run, * runs all of them. Controllers are "+
+			strings.Join(controllerNames, ", ")+".")
+	flag.BoolVar(&useScaleSubresource, "use-scale-subresource", false,
+		"Scale deployments through their scale subresource, as autoscalers do, instead of updating their spec.")
+	flag.StringVar(&debugAddr, "debug-addr", "",
+		"The address to serve the last reconcile of each object at, under "+debug.Path+". Empty disables it.")
+	flag.BoolVar(&runPreflight, "preflight", false,
+		"Check that the cluster has the APIs, CRDs, webhook support, permissions and default StorageClass the "+
+			"manager needs, print a report and exit instead of starting the controllers.")
+	flag.BoolVar(&installCRDs, "install-crds", false,
+		"Install the CRDs of the OAM kinds, or upgrade them to the version embedded in the manager, before starting.")
+	flag.StringVar(&preRenderHookURL, "pre-render-hook-url", "",
+		"A URL ContainerizedWorkloads are posted to before they are rendered, answered with the workload to render.")
+	flag.StringVar(&postRenderHookURL, "post-render-hook-url", "",
+		"A URL the objects rendered from a ContainerizedWorkload are posted to before they are applied, answered "+
+			"with the objects to apply.")
+	flag.IntVar(&maxRenderedChildren, "max-rendered-children", controllers.DefaultMaxChildren,
+		"The number of children a workload may render before its reconcile fails. 0 means unlimited.")
+	flag.IntVar(&maxManifestBytes, "max-manifest-bytes", controllers.DefaultMaxManifestBytes,
+		"The size in bytes a manifest rendered from a workload may have before its reconcile fails. 0 means unlimited.")
+	flag.StringVar(&devKubeconfig, "dev-kubeconfig", "",
+		"For development only, a kubeconfig whose contexts ContainerizedWorkloads may pick with their "+
+			corev1alpha2.AnnotationKubeconfigContext+" annotation to have their children applied to another cluster. Traits do not apply to such workloads.")
+	flag.StringVar(&helmBinary, "helm-binary", "helm",
+		"The helm binary HelmChartTraits render their charts with. The controller is skipped if it is not found.")
+	flag.StringVar(&conditionBackend, "condition-backend", conditions.DefaultBackend,
+		"How the conditions of statuses are expressed: crossplane, or standard for metav1.Condition reasons.")
+	flag.StringVar(&gitOpsTools, "gitops-metadata", "",
+		"The comma separated GitOps tools, argocd or flux, to annotate the children of workloads for.")
+	flag.StringVar(&profilerAddr, "profiler-address", "",
+		"The address to serve the pprof profiles of the manager at, under /debug/pprof/. Empty disables it.")
+	flag.IntVar(&blockProfileRate, "block-profile-rate", 0,
+		"Sample one blocking event per this many nanoseconds spent blocked for the block profile. 0 disables it.")
+	flag.IntVar(&mutexProfileFraction, "mutex-profile-fraction", 0,
+		"Sample one in this many mutex contention events for the mutex profile. 0 disables it.")
 	flag.Parse()
 
 	ctrl.SetLogger(zap.New(func(o *zap.Options) {
 		o.Development = true
 	}))
 
+	if runPreflight {
+		os.Exit(preflightCheck())
+	}
+
+	enabled, err := parseEnabledControllers(enableControllers)
+	if err != nil {
+		setupLog.Error(err, "invalid --enable-controllers")
+		os.Exit(1)
+	}
+	backend, err := conditions.Lookup(conditionBackend)
+	if err != nil {
+		setupLog.Error(err, "invalid --condition-backend")
+		os.Exit(1)
+	}
+	gitOps, err := controllers.NewGitOpsMetadata(gitOpsTools)
+	if err != nil {
+		setupLog.Error(err, "invalid --gitops-metadata")
+		os.Exit(1)
+	}
+
+	// the manager maps the kinds it watches when it is created
+	if installCRDs {
+		if err := installEmbeddedCRDs(); err != nil {
+			setupLog.Error(err, "unable to install CRDs")
+			os.Exit(1)
+		}
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:             scheme,
 		MetricsBindAddress: metricsAddr,
 		LeaderElection:     enableLeaderElection,
 		Port:               9443,
+		CertDir:            certDir,
+		// ConfigMaps and Secrets are read from the API server, not cached
+		NewClient: controllers.NewClient,
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
 	}
 
-	if err = (&controllers.ContainerizedWorkloadReconciler{
-		Client: mgr.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("ContainerizedWorkload"),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "ContainerizedWorkload")
+	// the manager's client can't be used until its cache has started
+	c, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
+	if err != nil {
+		setupLog.Error(err, "unable to create client")
 		os.Exit(1)
 	}
-	if err = (&controllers.ManualScalerTraitReconciler{
-		Client: mgr.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("ManualScalerTrait"),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "ManualScalerTrait")
+
+	dc, err := discovery.NewDiscoveryClientForConfig(mgr.GetConfig())
+	if err != nil {
+		setupLog.Error(err, "unable to create discovery client")
+		os.Exit(1)
+	}
+	caps := capabilities.Detect(dc, capabilities.Known)
+	setupLog.Info("detected optional capabilities", "capabilities", caps)
+	if err := capabilities.Publish(context.Background(), c, namespace, capabilitiesConfigMap, caps); err != nil {
+		// not fatal, the config map is informational only
+		setupLog.Error(err, "unable to publish capabilities")
+	}
+	if !manageWebhookCerts && !caps.Has(capabilities.CertManager) {
+		setupLog.Info("cert-manager is not installed, webhooks need --manage-webhook-certs or a certificate " +
+			"provisioned by other means")
+	}
+
+	if manageWebhookCerts {
+		b := &certs.Bootstrapper{
+			Client:                          c,
+			Log:                             ctrl.Log.WithName("certs"),
+			Namespace:                       namespace,
+			ServiceName:                     webhookService,
+			SecretName:                      webhookSecret,
+			CertDir:                         certDir,
+			MutatingWebhookConfigurations:   []string{"oam-mutating-webhook-configuration"},
+			ValidatingWebhookConfigurations: []string{"oam-validating-webhook-configuration"},
+		}
+		if err := b.Bootstrap(context.Background()); err != nil {
+			setupLog.Error(err, "unable to bootstrap webhook certificates")
+			os.Exit(1)
+		}
+		if err := mgr.Add(b); err != nil {
+			setupLog.Error(err, "unable to add webhook certificate rotation")
+			os.Exit(1)
+		}
+	}
+
+	// controllers record the observed generation and kstatus conditions of the
+	// statuses they write, expressed the way the backend does
+	reconcileClient := conditions.NewClient(mgr.GetClient(), backend)
+
+	limits := &controllers.RenderLimits{MaxChildren: maxRenderedChildren, MaxManifestBytes: maxManifestBytes}
+	var limiter *controllers.NamespaceWriteLimiter
+	if namespaceWriteQPS > 0 {
+		limiter = controllers.NewNamespaceWriteLimiter(float32(namespaceWriteQPS), namespaceWriteBurst)
+	}
+	// traits resolve the deployment of their workload once until its
+	// resources change, and refuse the workloads applied to another cluster
+	workloads, err := controllers.NewWorkloadResolver(mgr,
+		enabled["containerizedworkload"] && devKubeconfig != "")
+	if err != nil {
+		setupLog.Error(err, "unable to cache the deployments of workloads")
+		os.Exit(1)
+	}
+	// the owners of workloads see the failures of their traits
+	if err := controllers.RecordTraitFailures(mgr, mgr.GetEventRecorderFor("traits"), enabled); err != nil {
+		setupLog.Error(err, "unable to record the failures of traits")
+		os.Exit(1)
+	}
+	var scales appsv1client.DeploymentsGetter
+	if useScaleSubresource {
+		scales = kubernetes.NewForConfigOrDie(mgr.GetConfig()).AppsV1()
+	}
+	var scalePolicy policy.ScaleChecker
+	if scalePolicyURL != "" {
+		scalePolicy = policy.NewOPAChecker(scalePolicyURL)
+	}
+
+	var preRender, postRender renderhook.Hook
+	if preRenderHookURL != "" {
+		preRender = renderhook.NewHTTPHook(preRenderHookURL)
+	}
+	if postRenderHookURL != "" {
+		postRender = renderhook.NewHTTPHook(postRenderHookURL)
+	}
+
+	if profilerAddr != "" {
+		goruntime.SetBlockProfileRate(blockProfileRate)
+		goruntime.SetMutexProfileFraction(mutexProfileFraction)
+		if err := mgr.Add(profilerServer(profilerAddr)); err != nil {
+			setupLog.Error(err, "unable to add the profiler server")
+			os.Exit(1)
+		}
+	}
+
+	var recorder *debug.Recorder
+	if debugAddr != "" {
+		recorder = debug.NewRecorder()
+		if err := mgr.Add(debugServer(debugAddr, &debug.Handler{
+			Recorder: recorder,
+			Client:   mgr.GetClient(),
+			Gatherer: metrics.Registry,
+		})); err != nil {
+			setupLog.Error(err, "unable to add the debug server")
+			os.Exit(1)
+		}
+	}
+
+	if enabled["containerizedworkload"] {
+		var devClusters *controllers.DevClusters
+		if devKubeconfig != "" {
+			setupLog.Info("Applying the children of workloads to the clusters of their kubeconfig contexts",
+				"kubeconfig", devKubeconfig)
+			devClusters = controllers.NewDevClusters(devKubeconfig, mgr.GetScheme())
+		}
+		if err = (&controllers.ContainerizedWorkloadReconciler{
+			Client:     devClusters.Client(reconcileClient),
+			Log:        ctrl.Log.WithName("controllers").WithName("ContainerizedWorkload"),
+			Scheme:     mgr.GetScheme(),
+			Limiter:    limiter,
+			Debug:      recorder,
+			Events:     mgr.GetEventRecorderFor("containerizedworkload"),
+			PreRender:  preRender,
+			PostRender: postRender,
+			Limits:     limits,
+			GitOps:     gitOps,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ContainerizedWorkload")
+			os.Exit(1)
+		}
+	}
+	if enabled["templatedworkload"] {
+		if err = (&controllers.TemplatedWorkloadReconciler{
+			Client:  reconcileClient,
+			Log:     ctrl.Log.WithName("controllers").WithName("TemplatedWorkload"),
+			Scheme:  mgr.GetScheme(),
+			Limiter: limiter,
+			Debug:   recorder,
+			Limits:  limits,
+			GitOps:  gitOps,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "TemplatedWorkload")
+			os.Exit(1)
+		}
+	}
+	if enabled["manualscalertrait"] {
+		if err = (&controllers.ManualScalerTraitReconciler{
+			Client:    reconcileClient,
+			Log:       ctrl.Log.WithName("controllers").WithName("ManualScalerTrait"),
+			Scheme:    mgr.GetScheme(),
+			Limiter:   limiter,
+			Debug:     recorder,
+			Workloads: workloads,
+			Policy:    scalePolicy,
+			Scales:    scales,
+			Events:    mgr.GetEventRecorderFor("manualscalertrait"),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ManualScalerTrait")
+			os.Exit(1)
+		}
+	}
+	if enabled["patchtrait"] {
+		if err = (&controllers.PatchTraitReconciler{
+			Client:       reconcileClient,
+			Log:          ctrl.Log.WithName("controllers").WithName("PatchTrait"),
+			Scheme:       mgr.GetScheme(),
+			Limiter:      limiter,
+			Debug:        recorder,
+			Workloads:    workloads,
+			AllowedPaths: strings.Split(patchTraitAllowedPaths, ","),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "PatchTrait")
+			os.Exit(1)
+		}
+	}
+	if enabled["inittrait"] {
+		if err = (&controllers.InitTraitReconciler{
+			Client:    reconcileClient,
+			Log:       ctrl.Log.WithName("controllers").WithName("InitTrait"),
+			Scheme:    mgr.GetScheme(),
+			Limiter:   limiter,
+			Debug:     recorder,
+			Workloads: workloads,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "InitTrait")
+			os.Exit(1)
+		}
+	}
+	if enabled["spreadtrait"] {
+		if err = (&controllers.SpreadTraitReconciler{
+			Client:    reconcileClient,
+			Log:       ctrl.Log.WithName("controllers").WithName("SpreadTrait"),
+			Scheme:    mgr.GetScheme(),
+			Limiter:   limiter,
+			Debug:     recorder,
+			Workloads: workloads,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "SpreadTrait")
+			os.Exit(1)
+		}
+	}
+	if enabled["runtimeclasstrait"] {
+		if err = (&controllers.RuntimeClassTraitReconciler{
+			Client:    reconcileClient,
+			Log:       ctrl.Log.WithName("controllers").WithName("RuntimeClassTrait"),
+			Scheme:    mgr.GetScheme(),
+			Limiter:   limiter,
+			Debug:     recorder,
+			Workloads: workloads,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "RuntimeClassTrait")
+			os.Exit(1)
+		}
+	}
+	if enabled["priorityclasstrait"] {
+		if err = (&controllers.PriorityClassTraitReconciler{
+			Client:    reconcileClient,
+			Log:       ctrl.Log.WithName("controllers").WithName("PriorityClassTrait"),
+			Scheme:    mgr.GetScheme(),
+			Limiter:   limiter,
+			Debug:     recorder,
+			Workloads: workloads,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "PriorityClassTrait")
+			os.Exit(1)
+		}
+	}
+	if enabled["identitytrait"] {
+		if err = (&controllers.IdentityTraitReconciler{
+			Client:    reconcileClient,
+			Log:       ctrl.Log.WithName("controllers").WithName("IdentityTrait"),
+			Scheme:    mgr.GetScheme(),
+			Limiter:   limiter,
+			Debug:     recorder,
+			Workloads: workloads,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "IdentityTrait")
+			os.Exit(1)
+		}
+	}
+	if enabled["deploymentstrategytrait"] {
+		if err = (&controllers.DeploymentStrategyTraitReconciler{
+			Client:    reconcileClient,
+			Log:       ctrl.Log.WithName("controllers").WithName("DeploymentStrategyTrait"),
+			Scheme:    mgr.GetScheme(),
+			Limiter:   limiter,
+			Debug:     recorder,
+			Workloads: workloads,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DeploymentStrategyTrait")
+			os.Exit(1)
+		}
+	}
+	if enabled["costtrait"] {
+		if err = (&controllers.CostTraitReconciler{
+			Client:    reconcileClient,
+			Log:       ctrl.Log.WithName("controllers").WithName("CostTrait"),
+			Scheme:    mgr.GetScheme(),
+			Limiter:   limiter,
+			Debug:     recorder,
+			Workloads: workloads,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "CostTrait")
+			os.Exit(1)
+		}
+	}
+	if enabled["restarttrait"] {
+		if err = (&controllers.RestartTraitReconciler{
+			Client:    reconcileClient,
+			Log:       ctrl.Log.WithName("controllers").WithName("RestartTrait"),
+			Scheme:    mgr.GetScheme(),
+			Limiter:   limiter,
+			Debug:     recorder,
+			Workloads: workloads,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "RestartTrait")
+			os.Exit(1)
+		}
+	}
+	if enabled["debugtrait"] {
+		if err = (&controllers.DebugTraitReconciler{
+			Client:    reconcileClient,
+			Log:       ctrl.Log.WithName("controllers").WithName("DebugTrait"),
+			Scheme:    mgr.GetScheme(),
+			Limiter:   limiter,
+			Debug:     recorder,
+			Workloads: workloads,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DebugTrait")
+			os.Exit(1)
+		}
+	}
+	if enabled["scratchstoragetrait"] {
+		if err = (&controllers.ScratchStorageTraitReconciler{
+			Client:    reconcileClient,
+			Log:       ctrl.Log.WithName("controllers").WithName("ScratchStorageTrait"),
+			Scheme:    mgr.GetScheme(),
+			Limiter:   limiter,
+			Debug:     recorder,
+			Workloads: workloads,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ScratchStorageTrait")
+			os.Exit(1)
+		}
+	}
+	if enabled["resourcequotascope"] {
+		if err = (&controllers.ResourceQuotaScopeReconciler{
+			Client: reconcileClient,
+			Log:    ctrl.Log.WithName("controllers").WithName("ResourceQuotaScope"),
+			Scheme: mgr.GetScheme(),
+			Debug:  recorder,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ResourceQuotaScope")
+			os.Exit(1)
+		}
+	}
+	if !enabled["kedascalertrait"] {
+		setupLog.Info("Controller is not enabled", "controller", "KEDAScalerTrait")
+	} else if caps.Has(capabilities.KEDA) {
+		if err = (&controllers.KEDAScalerTraitReconciler{
+			Client:    reconcileClient,
+			Log:       ctrl.Log.WithName("controllers").WithName("KEDAScalerTrait"),
+			Scheme:    mgr.GetScheme(),
+			Limiter:   limiter,
+			Debug:     recorder,
+			Workloads: workloads,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "KEDAScalerTrait")
+			os.Exit(1)
+		}
+	} else {
+		setupLog.Info("KEDA is not installed, skipping controller", "controller", "KEDAScalerTrait")
+	}
+	if !enabled["verticalscalertrait"] {
+		setupLog.Info("Controller is not enabled", "controller", "VerticalScalerTrait")
+	} else if caps.Has(capabilities.VPA) {
+		if err = (&controllers.VerticalScalerTraitReconciler{
+			Client:    reconcileClient,
+			Log:       ctrl.Log.WithName("controllers").WithName("VerticalScalerTrait"),
+			Scheme:    mgr.GetScheme(),
+			Limiter:   limiter,
+			Debug:     recorder,
+			Workloads: workloads,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "VerticalScalerTrait")
+			os.Exit(1)
+		}
+	} else {
+		setupLog.Info("The vertical pod autoscaler is not installed, skipping controller",
+			"controller", "VerticalScalerTrait")
+	}
+	if !enabled["helmcharttrait"] {
+		setupLog.Info("Controller is not enabled", "controller", "HelmChartTrait")
+	} else if _, err := exec.LookPath(helmBinary); err == nil {
+		if err = (&controllers.HelmChartTraitReconciler{
+			Client:    reconcileClient,
+			Log:       ctrl.Log.WithName("controllers").WithName("HelmChartTrait"),
+			Scheme:    mgr.GetScheme(),
+			Charts:    &helm.Template{Binary: helmBinary},
+			Mapper:    mgr.GetRESTMapper(),
+			Limiter:   limiter,
+			Debug:     recorder,
+			Workloads: workloads,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "HelmChartTrait")
+			os.Exit(1)
+		}
+	} else {
+		setupLog.Info("helm is not installed, skipping controller", "controller", "HelmChartTrait",
+			"binary", helmBinary)
+	}
+	if err = (&corev1alpha2.ContainerizedWorkload{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ContainerizedWorkload")
 		os.Exit(1)
 	}
 	if err = (&corev1alpha2.ManualScalerTrait{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "ManualScalerTrait")
 		os.Exit(1)
 	}
+	mgr.GetWebhookServer().Register(quota.Path, &webhook.Admission{
+		Handler: &quota.Validator{Client: mgr.GetClient(), Default: defaultObjectQuota},
+	})
+	mgr.GetWebhookServer().Register(priorityclass.Path, &webhook.Admission{
+		Handler: &priorityclass.Validator{Client: mgr.GetClient()},
+	})
+	mgr.GetWebhookServer().Register(audit.Path, &webhook.Admission{Handler: &audit.Annotator{}})
 	// +kubebuilder:scaffold:builder
 
 	setupLog.Info("starting manager")
@@ -92,3 +621,398 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// debugServer serves the debug handler at addr until the manager stops.
+func debugServer(addr string, h http.Handler) manager.Runnable {
+	mux := http.NewServeMux()
+	mux.Handle(debug.Path, h)
+	return serve(addr, mux)
+}
+
+// profilerServer serves the pprof profiles at addr until the manager stops.
+func profilerServer(addr string) manager.Runnable {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	return serve(addr, mux)
+}
+
+// serve serves the handler at addr until the manager stops.
+func serve(addr string, h http.Handler) manager.Runnable {
+	return manager.RunnableFunc(func(stop <-chan struct{}) error {
+		srv := &http.Server{Addr: addr, Handler: h}
+		go func() {
+			<-stop
+			_ = srv.Shutdown(context.Background())
+		}()
+		if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+			return err
+		}
+		return nil
+	})
+}
+
+// convertCompose prints the workloads and traits converted from a
+// docker-compose.yml as YAML documents.
+func convertCompose(args []string) int {
+	fs := flag.NewFlagSet("convert-compose", flag.ContinueOnError)
+	namespace := fs.String("namespace", "default", "The namespace of the converted workloads and traits.")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: manager convert-compose [--namespace=<namespace>] <docker-compose.yml>")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+	data, err := ioutil.ReadFile(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	objs, err := compose.Convert(data, *namespace)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return printObjects(objs)
+}
+
+// migrateManualScalers prints a KEDAScalerTrait for each ManualScalerTrait
+// in the given file, "-" for standard input, and the commands deleting the
+// ManualScalerTraits, which must run first, on standard error.
+func migrateManualScalers(args []string) int {
+	fs := flag.NewFlagSet("migrate-manualscalers", flag.ContinueOnError)
+	cpuUtilization := fs.Int("cpu-utilization", 80,
+		"The average CPU utilization, in percent of the requests, the autoscaler keeps the pods at.")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: manager migrate-manualscalers [--cpu-utilization=<percent>] <traits.yaml|->")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+	var data []byte
+	var err error
+	if fs.Arg(0) == "-" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(fs.Arg(0))
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	objs, err := migrate.ManualScalerTraits(data, int32(*cpuUtilization))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if cmds := migrate.DeleteCommands(objs); len(cmds) > 0 {
+		fmt.Fprintln(os.Stderr, "Delete the ManualScalerTraits before applying the KEDAScalerTraits, "+
+			"or both scale the workloads:")
+		for _, cmd := range cmds {
+			fmt.Fprintln(os.Stderr, "  "+cmd)
+		}
+	}
+	return printObjects(objs)
+}
+
+// resyncNamespace annotates every OAM object of a namespace so that the
+// controllers of a running manager reconcile them.
+func resyncNamespace(args []string) int {
+	fs := flag.NewFlagSet("resync", flag.ContinueOnError)
+	namespace := fs.String("namespace", "default", "The namespace whose workloads and traits are reconciled.")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: manager resync [--namespace=<namespace>]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 0 {
+		fs.Usage()
+		return 2
+	}
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	c, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	n, err := resync.Namespace(context.Background(), c, *namespace, resync.Kinds(scheme),
+		time.Now().UTC().Format(time.RFC3339))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	fmt.Printf("Annotated %d objects in namespace %s\n", n, *namespace)
+	return 0
+}
+
+// exportNamespace prints the workloads and traits of a namespace as a bundle
+// that importBundle re-creates, e.g. in another cluster.
+func exportNamespace(args []string) int {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	namespace := fs.String("namespace", "default", "The namespace whose workloads and traits are exported.")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: manager export [--namespace=<namespace>]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 0 {
+		fs.Usage()
+		return 2
+	}
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	c, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	exported, err := bundle.Export(context.Background(), c, *namespace, resync.Kinds(scheme))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	objs := make([]runtime.Object, 0, len(exported))
+	for _, o := range exported {
+		objs = append(objs, o)
+	}
+	return printObjects(objs)
+}
+
+// importBundle creates the workloads and traits of a bundle printed by
+// exportNamespace, read from the given file, "-" for standard input.
+func importBundle(args []string) int {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	namespace := fs.String("namespace", "", "The namespace to create the objects in, instead of the one they were exported from.")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: manager import [--namespace=<namespace>] <bundle.yaml|->")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+	var data []byte
+	var err error
+	if fs.Arg(0) == "-" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(fs.Arg(0))
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	objs, err := bundle.Parse(data)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	c, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	n, err := bundle.Import(context.Background(), c, *namespace, objs)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	fmt.Printf("Created %d objects\n", n)
+	return 0
+}
+
+// exportChildren writes the resources rendered for a workload to a directory
+// as a kustomize base or a Helm chart, to be applied without the controllers.
+func exportChildren(args []string) int {
+	fs := flag.NewFlagSet("export-children", flag.ContinueOnError)
+	namespace := fs.String("namespace", "default", "The namespace of the workload.")
+	kind := fs.String("kind", "ContainerizedWorkload", "The kind of the workload.")
+	format := fs.String("format", "kustomize", "The format to write the resources in, kustomize or helm.")
+	output := fs.String("output", "", "The directory to write the resources to, the name of the workload if empty.")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: manager export-children [--namespace=<namespace>] [--kind=<kind>] "+
+			"[--format=kustomize|helm] [--output=<dir>] <workload>")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1 || (*format != "kustomize" && *format != "helm") {
+		fs.Usage()
+		return 2
+	}
+	name := fs.Arg(0)
+	dir := *output
+	if dir == "" {
+		dir = name
+	}
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	c, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	objs, err := bundle.ExportChildren(context.Background(), c, *namespace, *kind, name)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if *format == "helm" {
+		err = bundle.WriteHelmChart(dir, name, objs)
+	} else {
+		err = bundle.WriteKustomization(dir, objs)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	fmt.Printf("Wrote %d resources to %s\n", len(objs), dir)
+	return 0
+}
+
+// installEmbeddedCRDs installs or upgrades the CRDs embedded in the manager
+// and waits until the API server serves their kinds.
+func installEmbeddedCRDs() error {
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return err
+	}
+	c, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		return err
+	}
+	embedded, err := crds.CRDs()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	n, err := crds.Install(ctx, c, embedded)
+	if err != nil {
+		return err
+	}
+	setupLog.Info("installed CRDs", "changed", n, "total", len(embedded))
+	return crds.Wait(ctx, c, embedded, time.Second)
+}
+
+// preflightCheck prints a report of the checks of the cluster. It returns 1
+// if a check failed.
+func preflightCheck() int {
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	c, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	dc, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	reconciled := resync.Kinds(scheme)
+	checker := &preflight.Checker{
+		Discovery: dc,
+		Client:    c,
+		// resync.Kinds leaves out the cluster scoped kinds
+		Kinds: append(append([]string(nil), reconciled...),
+			"ResourceQuotaScope", "ResourceTracker", "WorkloadTemplate"),
+		Reconciled: reconciled,
+		Optional:   capabilities.Known,
+	}
+	report := checker.Run(context.Background())
+	out, err := yaml.Marshal(report)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	fmt.Print(string(out))
+	if report.Failed() {
+		return 1
+	}
+	return 0
+}
+
+// printObjects prints the objects as a YAML stream.
+func printObjects(objs []runtime.Object) int {
+	for _, o := range objs {
+		out, err := yaml.Marshal(o)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		fmt.Printf("---\n%s", out)
+	}
+	return 0
+}
+
+// controllerNames that can be passed to --enable-controllers.
+var controllerNames = []string{
+	"containerizedworkload", "manualscalertrait", "patchtrait", "inittrait", "spreadtrait", "kedascalertrait",
+	"verticalscalertrait", "runtimeclasstrait", "priorityclasstrait", "identitytrait", "deploymentstrategytrait",
+	"helmcharttrait", "templatedworkload", "costtrait", "restarttrait", "debugtrait",
+	"resourcequotascope", "scratchstoragetrait",
+}
+
+// parseEnabledControllers returns the set of controllers enabled by the given
+// --enable-controllers value.
+func parseEnabledControllers(s string) (map[string]bool, error) {
+	enabled := map[string]bool{}
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name == "*" {
+			for _, n := range controllerNames {
+				enabled[n] = true
+			}
+			continue
+		}
+		known := false
+		for _, n := range controllerNames {
+			known = known || n == name
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown controller %q", name)
+		}
+		enabled[name] = true
+	}
+	return enabled, nil
+}
@@ -0,0 +1,91 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit records on OAM objects the user who last changed them.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+
+	"github.com/pkg/errors"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// errDecodeObject is returned when the object under admission cannot be
+// decoded.
+const errDecodeObject = "cannot decode the object"
+
+// +kubebuilder:webhook:verbs=create;update,path=/mutate-core-oam-dev-v1alpha2-audit,mutating=true,failurePolicy=fail,groups=core.oam.dev,resources=containerizedworkloads;manualscalertraits;kedascalertraits;patchtraits;inittraits;spreadtraits;verticalscalertraits;runtimeclasstraits;priorityclasstraits;identitytraits;deploymentstrategytraits;helmcharttraits;templatedworkloads;costtraits;restarttraits;debugtraits;scratchstoragetraits,versions=v1alpha2,name=audit.mutate.core.oam.dev
+
+// Path the Annotator is served at.
+const Path = "/mutate-core-oam-dev-v1alpha2-audit"
+
+// An Annotator sets the v1alpha2.AnnotationLastModifiedBy annotation of the
+// OAM objects whose spec a request creates or changes to the user making the
+// request. Requests leaving the spec alone, e.g. the controllers adding their
+// finalizers, keep the annotation as it was.
+type Annotator struct{}
+
+var _ admission.Handler = &Annotator{}
+
+// Handle implements admission.Handler.
+func (a *Annotator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.SubResource != "" ||
+		(req.Operation != admissionv1beta1.Create && req.Operation != admissionv1beta1.Update) {
+		return admission.Allowed("")
+	}
+	obj := &unstructured.Unstructured{}
+	if err := json.Unmarshal(req.Object.Raw, &obj.Object); err != nil {
+		return admission.Errored(http.StatusBadRequest, errors.Wrap(err, errDecodeObject))
+	}
+
+	by, modified := req.UserInfo.Username, true
+	if req.Operation == admissionv1beta1.Update {
+		old := &unstructured.Unstructured{}
+		if err := json.Unmarshal(req.OldObject.Raw, &old.Object); err != nil {
+			return admission.Errored(http.StatusBadRequest, errors.Wrap(err, errDecodeObject))
+		}
+		if reflect.DeepEqual(obj.Object["spec"], old.Object["spec"]) {
+			// the annotation a request leaving the spec alone sets is ignored
+			by, modified = old.GetAnnotations()[v1alpha2.AnnotationLastModifiedBy]
+		}
+	}
+
+	annotations := obj.GetAnnotations()
+	if current, ok := annotations[v1alpha2.AnnotationLastModifiedBy]; ok == modified && current == by {
+		return admission.Allowed("")
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if modified {
+		annotations[v1alpha2.AnnotationLastModifiedBy] = by
+	} else {
+		delete(annotations, v1alpha2.AnnotationLastModifiedBy)
+	}
+	obj.SetAnnotations(annotations)
+	raw, err := json.Marshal(obj.Object)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, raw)
+}
@@ -0,0 +1,89 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestAnnotator(t *testing.T) {
+	trait := func(replicas int32, by string) runtime.RawExtension {
+		s := &v1alpha2.ManualScalerTrait{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default",
+			Annotations: map[string]string{"team": "web"}}}
+		s.Spec.ReplicaCount = replicas
+		if by != "" {
+			s.Annotations[v1alpha2.AnnotationLastModifiedBy] = by
+		}
+		raw, _ := json.Marshal(s)
+		return runtime.RawExtension{Raw: raw}
+	}
+	request := func(op admissionv1beta1.Operation, user string, obj, old runtime.RawExtension) admission.Request {
+		return admission.Request{AdmissionRequest: admissionv1beta1.AdmissionRequest{
+			Operation: op,
+			Namespace: "default",
+			Resource:  metav1.GroupVersionResource{Group: "core.oam.dev", Version: "v1alpha2", Resource: "manualscalertraits"},
+			UserInfo:  authenticationv1.UserInfo{Username: user},
+			Object:    obj,
+			OldObject: old,
+		}}
+	}
+	const annotation = "/metadata/annotations/core.oam.dev~1last-modified-by"
+	testCases := map[string]struct {
+		req admission.Request
+		// the operation patching the annotation and the value it sets, if any
+		op   string
+		want string
+	}{
+		"Created": {
+			req:  request(admissionv1beta1.Create, "alice", trait(3, ""), runtime.RawExtension{}),
+			op:   "add",
+			want: "alice",
+		},
+		"SpecChanged": {
+			req:  request(admissionv1beta1.Update, "bob", trait(5, "alice"), trait(3, "alice")),
+			op:   "replace",
+			want: "bob",
+		},
+		"SpecUnchanged": {
+			req: request(admissionv1beta1.Update, "system:serviceaccount:oam-system:manager", trait(3, "alice"),
+				trait(3, "alice")),
+		},
+		"Forged": {
+			req:  request(admissionv1beta1.Update, "mallory", trait(3, "alice@example.com"), trait(3, "alice")),
+			op:   "replace",
+			want: "alice",
+		},
+		"ForgedOnUnannotated": {
+			req: request(admissionv1beta1.Update, "mallory", trait(3, "alice"), trait(3, "")),
+			op:  "remove",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp := (&Annotator{}).Handle(context.Background(), tc.req)
+			if !resp.Allowed {
+				t.Fatalf("Handle() denied the request: %v", resp.Result)
+			}
+			var op, got string
+			for _, p := range resp.Patches {
+				if p.Path != annotation {
+					t.Errorf("Handle() patched %s", p.Path)
+					continue
+				}
+				op = p.Operation
+				got, _ = p.Value.(string)
+			}
+			if op != tc.op || got != tc.want {
+				t.Errorf("Handle() patch %s %q, want %s %q", op, got, tc.op, tc.want)
+			}
+		})
+	}
+}
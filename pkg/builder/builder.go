@@ -0,0 +1,196 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package builder builds a ContainerizedWorkload along with the traits
+// applied to it, e.g.
+//
+//	app, err := builder.NewContainerizedWorkload("default", "web").
+//	    WithContainer(corev1.Container{Name: "web", Image: "nginx"}).
+//	    WithTrait(&v1alpha2.ManualScalerTrait{Spec: v1alpha2.ManualScalerTraitSpec{ReplicaCount: 3}}).
+//	    Build()
+package builder
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// kindContainerizedWorkload is the kind traits refer to the workload by.
+const kindContainerizedWorkload = "ContainerizedWorkload"
+
+// A Trait is one of the trait kinds of core.oam.dev/v1alpha2.
+type Trait interface {
+	metav1.Object
+	runtime.Object
+}
+
+// An Application is a ContainerizedWorkload along with the traits applied to
+// it.
+type Application struct {
+	Workload *v1alpha2.ContainerizedWorkload
+	Traits   []Trait
+
+	// whether Build named each trait
+	generated []bool
+}
+
+// Objects returns the workload followed by its traits, e.g. to seed a fake
+// client.
+func (a *Application) Objects() []runtime.Object {
+	objs := make([]runtime.Object, 0, len(a.Traits)+1)
+	objs = append(objs, a.Workload)
+	for _, t := range a.Traits {
+		objs = append(objs, t)
+	}
+	return objs
+}
+
+// A WorkloadBuilder builds an Application.
+type WorkloadBuilder struct {
+	workload *v1alpha2.ContainerizedWorkload
+	traits   []Trait
+}
+
+// NewContainerizedWorkload starts building a ContainerizedWorkload.
+func NewContainerizedWorkload(namespace, name string) *WorkloadBuilder {
+	return &WorkloadBuilder{workload: &v1alpha2.ContainerizedWorkload{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha2.GroupVersion.String(),
+			Kind:       kindContainerizedWorkload,
+		},
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+	}}
+}
+
+// WithLabels adds labels to the workload.
+func (b *WorkloadBuilder) WithLabels(labels map[string]string) *WorkloadBuilder {
+	if b.workload.Labels == nil {
+		b.workload.Labels = make(map[string]string, len(labels))
+	}
+	for k, v := range labels {
+		b.workload.Labels[k] = v
+	}
+	return b
+}
+
+// WithContainer adds a container to the workload.
+func (b *WorkloadBuilder) WithContainer(c corev1.Container) *WorkloadBuilder {
+	b.workload.Spec.Containers = append(b.workload.Spec.Containers, c)
+	return b
+}
+
+// WithInitContainer adds an init container to the workload.
+func (b *WorkloadBuilder) WithInitContainer(c corev1.Container) *WorkloadBuilder {
+	b.workload.Spec.InitContainers = append(b.workload.Spec.InitContainers, c)
+	return b
+}
+
+// WithTrait applies a trait to the workload. Build points the trait at the
+// workload, puts it in the workload's namespace and, unless it has one, gives
+// it the name TraitName returns.
+func (b *WorkloadBuilder) WithTrait(t Trait) *WorkloadBuilder {
+	b.traits = append(b.traits, t)
+	return b
+}
+
+// Build returns the workload and its traits. It fails if a trait is not of a
+// kind of core.oam.dev/v1alpha2.
+func (b *WorkloadBuilder) Build() (*Application, error) {
+	app := &Application{Workload: b.workload.DeepCopy()}
+	ref := v1alpha2.ResourceReference{
+		APIVersion: v1alpha2.GroupVersion.String(),
+		Kind:       kindContainerizedWorkload,
+		Name:       app.Workload.Name,
+	}
+	// the number of traits of each kind named so far
+	n := map[string]int{}
+	for _, trait := range b.traits {
+		t, ok := trait.DeepCopyObject().(Trait)
+		if !ok {
+			return nil, fmt.Errorf("unsupported trait %T", trait)
+		}
+		kind, err := setWorkloadReference(t, ref)
+		if err != nil {
+			return nil, err
+		}
+		t.GetObjectKind().SetGroupVersionKind(v1alpha2.GroupVersion.WithKind(kind))
+		t.SetNamespace(app.Workload.Namespace)
+		generated := t.GetName() == ""
+		if generated {
+			t.SetName(TraitName(app.Workload.Name, kind, n[kind]))
+			n[kind]++
+		}
+		app.Traits = append(app.Traits, t)
+		app.generated = append(app.generated, generated)
+	}
+	return app, nil
+}
+
+// setWorkloadReference points the trait at the workload and returns its kind.
+func setWorkloadReference(t Trait, ref v1alpha2.ResourceReference) (string, error) {
+	switch t := t.(type) {
+	case *v1alpha2.ManualScalerTrait:
+		t.Spec.WorkloadReference = ref
+		return "ManualScalerTrait", nil
+	case *v1alpha2.KEDAScalerTrait:
+		t.Spec.WorkloadReference = ref
+		return "KEDAScalerTrait", nil
+	case *v1alpha2.PatchTrait:
+		t.Spec.WorkloadReference = ref
+		return "PatchTrait", nil
+	case *v1alpha2.InitTrait:
+		t.Spec.WorkloadReference = ref
+		return "InitTrait", nil
+	case *v1alpha2.SpreadTrait:
+		t.Spec.WorkloadReference = ref
+		return "SpreadTrait", nil
+	case *v1alpha2.VerticalScalerTrait:
+		t.Spec.WorkloadReference = ref
+		return "VerticalScalerTrait", nil
+	case *v1alpha2.RuntimeClassTrait:
+		t.Spec.WorkloadReference = ref
+		return "RuntimeClassTrait", nil
+	case *v1alpha2.PriorityClassTrait:
+		t.Spec.WorkloadReference = ref
+		return "PriorityClassTrait", nil
+	case *v1alpha2.IdentityTrait:
+		t.Spec.WorkloadReference = ref
+		return "IdentityTrait", nil
+	case *v1alpha2.DeploymentStrategyTrait:
+		t.Spec.WorkloadReference = ref
+		return "DeploymentStrategyTrait", nil
+	case *v1alpha2.HelmChartTrait:
+		t.Spec.WorkloadReference = ref
+		return "HelmChartTrait", nil
+	case *v1alpha2.CostTrait:
+		t.Spec.WorkloadReference = ref
+		return "CostTrait", nil
+	case *v1alpha2.RestartTrait:
+		t.Spec.WorkloadReference = ref
+		return "RestartTrait", nil
+	case *v1alpha2.DebugTrait:
+		t.Spec.WorkloadReference = ref
+		return "DebugTrait", nil
+	case *v1alpha2.ScratchStorageTrait:
+		t.Spec.WorkloadReference = ref
+		return "ScratchStorageTrait", nil
+	}
+	return "", fmt.Errorf("unsupported trait %T", t)
+}
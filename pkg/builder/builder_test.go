@@ -0,0 +1,68 @@
+package builder
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestBuild(t *testing.T) {
+	ref := v1alpha2.ResourceReference{
+		APIVersion: "core.oam.dev/v1alpha2",
+		Kind:       "ContainerizedWorkload",
+		Name:       "web",
+	}
+	testCases := map[string]struct {
+		trait   Trait
+		want    Trait
+		wantErr bool
+	}{
+		"DefaultName": {
+			trait: &v1alpha2.ManualScalerTrait{Spec: v1alpha2.ManualScalerTraitSpec{ReplicaCount: 3}},
+			want: &v1alpha2.ManualScalerTrait{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "core.oam.dev/v1alpha2", Kind: "ManualScalerTrait"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web-manualscalertrait"},
+				Spec:       v1alpha2.ManualScalerTraitSpec{ReplicaCount: 3, WorkloadReference: ref},
+			},
+		},
+		"KeepsName": {
+			trait: &v1alpha2.PatchTrait{ObjectMeta: metav1.ObjectMeta{Name: "labels"}},
+			want: &v1alpha2.PatchTrait{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "core.oam.dev/v1alpha2", Kind: "PatchTrait"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "labels"},
+				Spec:       v1alpha2.PatchTraitSpec{WorkloadReference: ref},
+			},
+		},
+		"UnsupportedTrait": {
+			trait:   &v1alpha2.ResourceTracker{},
+			wantErr: true,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			app, err := NewContainerizedWorkload("default", "web").
+				WithContainer(corev1.Container{Name: "web", Image: "nginx"}).
+				WithTrait(testCase.trait).
+				Build()
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatal("Build() succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Build() = %v", err)
+			}
+			if got := app.Traits[0]; !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("Build() trait = %+v, want %+v", got, testCase.want)
+			}
+			if len(app.Workload.Spec.Containers) != 1 || app.Workload.Kind != "ContainerizedWorkload" {
+				t.Errorf("Build() workload = %+v", app.Workload)
+			}
+		})
+	}
+}
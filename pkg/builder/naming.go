@@ -0,0 +1,124 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// MaxTraitNameLength is the length of the longest name Build gives a trait,
+// so that the name fits in the label values controllers set from it.
+const MaxTraitNameLength = 63
+
+// errListTraits is returned when the existing traits cannot be listed.
+const errListTraits = "cannot list the existing traits"
+
+// TraitName returns the name Build gives the nth trait, counting from 0, of
+// the kind applied to the workload. The first is named after the workload and
+// the kind, e.g. web-manualscalertrait. The others, and names that would be
+// longer than MaxTraitNameLength, are truncated as needed and end with a hash
+// of the workload, the kind and n, so that no two traits get the same name.
+func TraitName(workload, kind string, n int) string {
+	name := workload + "-" + strings.ToLower(kind)
+	if n == 0 && len(name) <= MaxTraitNameLength {
+		return name
+	}
+	h := fnv.New32a()
+	fmt.Fprintf(h, "%s/%s/%d", workload, kind, n)
+	suffix := fmt.Sprintf("-%08x", h.Sum32())
+	if max := MaxTraitNameLength - len(suffix); len(name) > max {
+		// names must not end with a dash
+		name = strings.TrimRight(name[:max], "-.")
+	}
+	return name + suffix
+}
+
+// Adopt renames the traits Build named to the names of the existing traits
+// of the same kind that apply to the workload, e.g. ones created before the
+// naming of traits changed or renamed since, so that applying the traits
+// updates those rather than creating a second instance of each. Existing
+// traits with the name Build gave keep it, the others are adopted in the
+// order of their names.
+func (a *Application) Adopt(ctx context.Context, c client.Reader) error {
+	byKind := map[string][]int{}
+	// the kinds of the traits Build named, in order
+	var kinds []string
+	adopting := map[string]bool{}
+	for i, t := range a.Traits {
+		kind := t.GetObjectKind().GroupVersionKind().Kind
+		byKind[kind] = append(byKind[kind], i)
+		if a.named(i) && !adopting[kind] {
+			adopting[kind] = true
+			kinds = append(kinds, kind)
+		}
+	}
+
+	for _, kind := range kinds {
+		l := &unstructured.UnstructuredList{}
+		l.SetGroupVersionKind(v1alpha2.GroupVersion.WithKind(kind + "List"))
+		if err := c.List(ctx, l, client.InNamespace(a.Workload.Namespace)); err != nil {
+			return errors.Wrapf(err, "%s of kind %s", errListTraits, kind)
+		}
+		existing := map[string]bool{}
+		var names []string
+		for _, t := range l.Items {
+			ref, _, _ := unstructured.NestedString(t.Object, "spec", "workloadRef", "name")
+			if ref != a.Workload.Name {
+				continue
+			}
+			existing[t.GetName()] = true
+			names = append(names, t.GetName())
+		}
+		sort.Strings(names)
+
+		claimed := map[string]bool{}
+		var unnamed []int
+		for _, i := range byKind[kind] {
+			// the traits named by the caller are never renamed
+			if name := a.Traits[i].GetName(); existing[name] || !a.named(i) {
+				claimed[name] = true
+				continue
+			}
+			unnamed = append(unnamed, i)
+		}
+		for _, name := range names {
+			if len(unnamed) == 0 {
+				break
+			}
+			if claimed[name] {
+				continue
+			}
+			a.Traits[unnamed[0]].SetName(name)
+			unnamed = unnamed[1:]
+		}
+	}
+	return nil
+}
+
+// named tells whether Build named the ith trait.
+func (a *Application) named(i int) bool {
+	return i < len(a.generated) && a.generated[i]
+}
@@ -0,0 +1,117 @@
+package builder
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestTraitName(t *testing.T) {
+	long := strings.Repeat("a", 60)
+	testCases := map[string]struct {
+		workload string
+		kind     string
+		n        int
+		want     string
+	}{
+		"First": {
+			workload: "web",
+			kind:     "ManualScalerTrait",
+			want:     "web-manualscalertrait",
+		},
+		"Second": {
+			workload: "web",
+			kind:     "ManualScalerTrait",
+			n:        1,
+			want:     TraitName("web", "ManualScalerTrait", 1),
+		},
+		"Truncated": {
+			workload: long,
+			kind:     "PatchTrait",
+			want:     TraitName(long, "PatchTrait", 0),
+		},
+	}
+	seen := map[string]bool{}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := TraitName(tc.workload, tc.kind, tc.n)
+			if got != tc.want {
+				t.Errorf("TraitName() = %q, want %q", got, tc.want)
+			}
+			if len(got) > MaxTraitNameLength || strings.HasSuffix(got, "-") {
+				t.Errorf("TraitName() = %q, not a valid name", got)
+			}
+			if seen[got] {
+				t.Errorf("TraitName() = %q, a name given to another trait", got)
+			}
+			seen[got] = true
+		})
+	}
+	if a, b := TraitName(long, "PatchTrait", 0), TraitName(long+"b", "PatchTrait", 0); a == b {
+		t.Errorf("TraitName() = %q for two workloads", a)
+	}
+}
+
+func TestAdopt(t *testing.T) {
+	ref := v1alpha2.ResourceReference{APIVersion: "core.oam.dev/v1alpha2", Kind: "ContainerizedWorkload", Name: "web"}
+	scaler := func(name, workload string) runtime.Object {
+		s := &v1alpha2.ManualScalerTrait{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name}}
+		s.Spec.WorkloadReference = ref
+		s.Spec.WorkloadReference.Name = workload
+		return s
+	}
+	testCases := map[string]struct {
+		existing []runtime.Object
+		// the traits applied, unnamed ones are named by Build
+		names []string
+		want  []string
+	}{
+		"None": {
+			names: []string{"", ""},
+			want:  []string{"web-manualscalertrait", TraitName("web", "ManualScalerTrait", 1)},
+		},
+		"Renamed": {
+			existing: []runtime.Object{scaler("scaler", "web"), scaler("api-scaler", "api")},
+			names:    []string{""},
+			want:     []string{"scaler"},
+		},
+		"KeepsName": {
+			existing: []runtime.Object{scaler("old", "web"), scaler("web-manualscalertrait", "web")},
+			names:    []string{"", ""},
+			want:     []string{"web-manualscalertrait", "old"},
+		},
+		"NamedByCaller": {
+			existing: []runtime.Object{scaler("pinned", "web")},
+			names:    []string{"pinned", ""},
+			want:     []string{"pinned", TraitName("web", "ManualScalerTrait", 0)},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			_ = v1alpha2.AddToScheme(s)
+			b := NewContainerizedWorkload("default", "web")
+			for _, n := range tc.names {
+				b.WithTrait(&v1alpha2.ManualScalerTrait{ObjectMeta: metav1.ObjectMeta{Name: n}})
+			}
+			app, err := b.Build()
+			if err != nil {
+				t.Fatalf("Build() = %v", err)
+			}
+			if err := app.Adopt(context.Background(), fake.NewFakeClientWithScheme(s, tc.existing...)); err != nil {
+				t.Fatalf("Adopt() = %v", err)
+			}
+			for i, trait := range app.Traits {
+				if trait.GetName() != tc.want[i] {
+					t.Errorf("Adopt() trait %d is named %q, want %q", i, trait.GetName(), tc.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bundle exports the OAM objects of a namespace to a portable YAML
+// bundle and imports them again, e.g. into another cluster when recovering
+// from a disaster or moving clusters. It also exports the resources rendered
+// for a workload as a kustomize base or a Helm chart.
+package bundle
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// Error strings.
+const (
+	errListObjects    = "cannot list the objects"
+	errParse          = "cannot parse the bundle"
+	errCreateObject   = "cannot create the object"
+	errRemapReference = "cannot find the workload the object refers to"
+)
+
+// the metadata that is specific to the cluster the objects were exported from
+var clusterMetadata = [][]string{
+	{"metadata", "uid"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "generation"},
+	{"metadata", "creationTimestamp"},
+	{"metadata", "deletionTimestamp"},
+	{"metadata", "deletionGracePeriodSeconds"},
+	{"metadata", "selfLink"},
+	{"metadata", "managedFields"},
+	{"metadata", "ownerReferences"},
+	{"metadata", "finalizers"},
+	{"status"},
+}
+
+// Export returns the objects of the kinds in the namespace, workloads first,
+// without the metadata and status specific to the cluster. The UIDs in the
+// workload references of traits are remapped by Import.
+func Export(ctx context.Context, c client.Reader, namespace string, kinds []string) ([]*unstructured.Unstructured, error) {
+	var workloads, traits []*unstructured.Unstructured
+	for _, kind := range kinds {
+		l := &unstructured.UnstructuredList{}
+		l.SetGroupVersionKind(v1alpha2.GroupVersion.WithKind(kind + "List"))
+		if err := c.List(ctx, l, client.InNamespace(namespace)); err != nil {
+			return nil, errors.Wrapf(err, "%s %s", errListObjects, kind)
+		}
+		for i := range l.Items {
+			obj := l.Items[i].DeepCopy()
+			for _, path := range clusterMetadata {
+				unstructured.RemoveNestedField(obj.Object, path...)
+			}
+			if _, ok := workloadReference(obj); ok {
+				traits = append(traits, obj)
+			} else {
+				workloads = append(workloads, obj)
+			}
+		}
+	}
+	return append(workloads, traits...), nil
+}
+
+// Parse returns the objects of a YAML stream, e.g. an exported bundle.
+func Parse(data []byte) ([]*unstructured.Unstructured, error) {
+	r := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	var objs []*unstructured.Unstructured
+	for {
+		doc, err := r.Read()
+		if err == io.EOF {
+			return objs, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, errParse)
+		}
+		obj := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal(doc, &obj.Object); err != nil {
+			return nil, errors.Wrap(err, errParse)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		objs = append(objs, obj)
+	}
+}
+
+// Import creates the objects in the namespace, or in their own namespace if
+// it is empty, workloads first. The UID of the workload reference of each
+// trait is set to that of the workload it names, as created by the import or
+// found in the namespace. It returns the number of objects created.
+func Import(ctx context.Context, c client.Client, namespace string, objs []*unstructured.Unstructured) (int, error) {
+	ordered := make([]*unstructured.Unstructured, 0, len(objs))
+	var traits []*unstructured.Unstructured
+	for _, o := range objs {
+		if _, ok := workloadReference(o); ok {
+			traits = append(traits, o)
+			continue
+		}
+		ordered = append(ordered, o)
+	}
+	ordered = append(ordered, traits...)
+
+	n := 0
+	for _, o := range ordered {
+		obj := o.DeepCopy()
+		if namespace != "" {
+			obj.SetNamespace(namespace)
+		}
+		if ref, ok := workloadReference(obj); ok {
+			uid, err := workloadUID(ctx, c, obj.GetNamespace(), ref)
+			if err != nil {
+				return n, errors.Wrapf(err, "%s %s %s", errRemapReference, obj.GetKind(), obj.GetName())
+			}
+			if err := unstructured.SetNestedField(obj.Object, uid, "spec", "workloadRef", "uid"); err != nil {
+				return n, errors.Wrapf(err, "%s %s %s", errRemapReference, obj.GetKind(), obj.GetName())
+			}
+		}
+		if err := c.Create(ctx, obj); err != nil {
+			return n, errors.Wrapf(err, "%s %s %s", errCreateObject, obj.GetKind(), obj.GetName())
+		}
+		n++
+	}
+	return n, nil
+}
+
+// workloadReference returns the spec.workloadRef of a trait
+func workloadReference(obj *unstructured.Unstructured) (map[string]interface{}, bool) {
+	ref, ok, err := unstructured.NestedMap(obj.Object, "spec", "workloadRef")
+	return ref, ok && err == nil
+}
+
+// the UID of the workload a reference names
+func workloadUID(ctx context.Context, c client.Reader, namespace string, ref map[string]interface{}) (string, error) {
+	apiVersion, _ := ref["apiVersion"].(string)
+	kind, _ := ref["kind"].(string)
+	name, _ := ref["name"].(string)
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return "", err
+	}
+	w := &unstructured.Unstructured{}
+	w.SetGroupVersionKind(gv.WithKind(kind))
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, w); err != nil {
+		return "", errors.Wrapf(err, "%s %s", kind, name)
+	}
+	return string(w.GetUID()), nil
+}
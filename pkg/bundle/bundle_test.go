@@ -0,0 +1,107 @@
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/resync"
+)
+
+// uidClient assigns UIDs on create the way the API server does
+type uidClient struct {
+	client.Client
+	n int
+}
+
+func (c *uidClient) Create(ctx context.Context, obj runtime.Object, opts ...client.CreateOption) error {
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	c.n++
+	m.SetUID(types.UID(fmt.Sprintf("new-uid-%d", c.n)))
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func TestExportImport(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha2.AddToScheme(scheme)
+
+	uid := types.UID("old-uid")
+	workload := &v1alpha2.ContainerizedWorkload{ObjectMeta: metav1.ObjectMeta{
+		Name: "web", Namespace: "apps", UID: uid, ResourceVersion: "7", Finalizers: []string{"x"},
+	}}
+	trait := &v1alpha2.ManualScalerTrait{
+		ObjectMeta: metav1.ObjectMeta{Name: "scaler", Namespace: "apps", UID: "trait-uid"},
+		Spec: v1alpha2.ManualScalerTraitSpec{ReplicaCount: 3, WorkloadReference: v1alpha2.ResourceReference{
+			APIVersion: v1alpha2.GroupVersion.String(), Kind: "ContainerizedWorkload", Name: "web", UID: &uid,
+		}},
+	}
+	src := fake.NewFakeClientWithScheme(scheme, trait, workload)
+
+	exported, err := Export(context.Background(), src, "apps", resync.Kinds(scheme))
+	if err != nil {
+		t.Fatalf("Export() = %v", err)
+	}
+	if len(exported) != 2 || exported[0].GetKind() != "ContainerizedWorkload" || exported[1].GetKind() != "ManualScalerTrait" {
+		t.Fatalf("Export() = %v, want the workload followed by its trait", exported)
+	}
+	for _, o := range exported {
+		if o.GetUID() != "" || o.GetResourceVersion() != "" || len(o.GetFinalizers()) > 0 {
+			t.Errorf("Export() kept the cluster specific metadata of %s: %v", o.GetName(), o.Object["metadata"])
+		}
+	}
+
+	var data []byte
+	for _, o := range exported {
+		out, err := yaml.Marshal(o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data = append(append(data, "---\n"...), out...)
+	}
+	objs, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() = %v", err)
+	}
+
+	dst := &uidClient{Client: fake.NewFakeClientWithScheme(scheme)}
+	n, err := Import(context.Background(), dst, "restored", objs)
+	if err != nil {
+		t.Fatalf("Import() = %v", err)
+	}
+	if n != 2 {
+		t.Errorf("Import() created %d objects, want 2", n)
+	}
+	w := &v1alpha2.ContainerizedWorkload{}
+	if err := dst.Get(context.Background(), client.ObjectKey{Namespace: "restored", Name: "web"}, w); err != nil {
+		t.Fatal(err)
+	}
+	got := &v1alpha2.ManualScalerTrait{}
+	if err := dst.Get(context.Background(), client.ObjectKey{Namespace: "restored", Name: "scaler"}, got); err != nil {
+		t.Fatal(err)
+	}
+	if ref := got.Spec.WorkloadReference.UID; ref == nil || *ref != w.UID {
+		t.Errorf("Import() workloadRef.uid = %v, want %q", ref, w.UID)
+	}
+	if got.Spec.ReplicaCount != 3 {
+		t.Errorf("Import() replicaCount = %d, want 3", got.Spec.ReplicaCount)
+	}
+
+	// a trait whose workload is neither imported nor in the namespace
+	if _, err := Import(context.Background(), dst, "elsewhere", objs[1:]); err == nil {
+		t.Errorf("Import() of a trait without its workload succeeded")
+	}
+}
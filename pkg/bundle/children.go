@@ -0,0 +1,158 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// Error strings.
+const (
+	errGetWorkload   = "cannot get the workload"
+	errGetChild      = "cannot get the resource of the workload"
+	errWriteManifest = "cannot write the manifest"
+)
+
+// the annotations the controllers keep on the children of a workload
+var controllerAnnotations = []string{
+	"core.oam.dev/trait-managed-fields",
+	"kubectl.kubernetes.io/last-applied-configuration",
+}
+
+// ExportChildren returns the resources rendered for the workload of the kind,
+// as listed in its status, without the metadata and status specific to the
+// cluster, their namespace and the annotations of the controllers, so that
+// they can be applied to any namespace without the workload.
+func ExportChildren(ctx context.Context, c client.Reader, namespace, kind, name string) ([]*unstructured.Unstructured, error) {
+	w := &unstructured.Unstructured{}
+	w.SetGroupVersionKind(v1alpha2.GroupVersion.WithKind(kind))
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, w); err != nil {
+		return nil, errors.Wrapf(err, "%s %s %s", errGetWorkload, kind, name)
+	}
+	refs, _, err := unstructured.NestedSlice(w.Object, "status", "resources")
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s %s %s", errGetWorkload, kind, name)
+	}
+	objs := make([]*unstructured.Unstructured, 0, len(refs))
+	for _, r := range refs {
+		ref, _ := r.(map[string]interface{})
+		apiVersion, _ := ref["apiVersion"].(string)
+		refKind, _ := ref["kind"].(string)
+		refName, _ := ref["name"].(string)
+		gv, err := schema.ParseGroupVersion(apiVersion)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s %s %s", errGetChild, refKind, refName)
+		}
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gv.WithKind(refKind))
+		if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: refName}, obj); err != nil {
+			return nil, errors.Wrapf(err, "%s %s %s", errGetChild, refKind, refName)
+		}
+		for _, path := range clusterMetadata {
+			unstructured.RemoveNestedField(obj.Object, path...)
+		}
+		unstructured.RemoveNestedField(obj.Object, "metadata", "namespace")
+		for _, a := range controllerAnnotations {
+			unstructured.RemoveNestedField(obj.Object, "metadata", "annotations", a)
+		}
+		if len(obj.GetAnnotations()) == 0 {
+			unstructured.RemoveNestedField(obj.Object, "metadata", "annotations")
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
+
+// WriteKustomization writes the objects to dir as a kustomize base, one
+// manifest per object listed in its kustomization.yaml.
+func WriteKustomization(dir string, objs []*unstructured.Unstructured) error {
+	files, err := writeManifests(dir, objs, false)
+	if err != nil {
+		return err
+	}
+	k := map[string]interface{}{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  files,
+	}
+	return writeYAML(filepath.Join(dir, "kustomization.yaml"), k)
+}
+
+// WriteHelmChart writes the objects to dir as a Helm chart of the name, one
+// template per object. The templates have no parameters; the chart installs
+// the objects as they were exported into the namespace of the release.
+func WriteHelmChart(dir, name string, objs []*unstructured.Unstructured) error {
+	if _, err := writeManifests(filepath.Join(dir, "templates"), objs, true); err != nil {
+		return err
+	}
+	chart := map[string]interface{}{
+		"apiVersion":  "v2",
+		"name":        name,
+		"description": fmt.Sprintf("The resources rendered for the workload %s.", name),
+		"type":        "application",
+		"version":     "0.1.0",
+	}
+	if err := writeYAML(filepath.Join(dir, "Chart.yaml"), chart); err != nil {
+		return err
+	}
+	return errors.Wrap(ioutil.WriteFile(filepath.Join(dir, "values.yaml"), nil, 0644), errWriteManifest)
+}
+
+// writeManifests writes each object to dir as <kind>-<name>.yaml and returns
+// the names of the files. If the manifests are templates, the "{{" in them are
+// quoted as template actions so that Helm leaves them as they are.
+func writeManifests(dir string, objs []*unstructured.Unstructured, templates bool) ([]string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrap(err, errWriteManifest)
+	}
+	files := make([]string, 0, len(objs))
+	for _, o := range objs {
+		file := strings.ToLower(o.GetKind()) + "-" + o.GetName() + ".yaml"
+		data, err := yaml.Marshal(o.Object)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s %s", errWriteManifest, file)
+		}
+		if templates {
+			data = []byte(strings.ReplaceAll(string(data), "{{", `{{ "{{" }}`))
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, file), data, 0644); err != nil {
+			return nil, errors.Wrapf(err, "%s %s", errWriteManifest, file)
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+func writeYAML(path string, v interface{}) error {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return errors.Wrapf(err, "%s %s", errWriteManifest, filepath.Base(path))
+	}
+	return errors.Wrapf(ioutil.WriteFile(path, data, 0644), "%s %s", errWriteManifest, filepath.Base(path))
+}
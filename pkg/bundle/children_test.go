@@ -0,0 +1,110 @@
+package bundle
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestExportChildren(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha2.AddToScheme(scheme)
+
+	workload := &v1alpha2.ContainerizedWorkload{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "apps"},
+		Status: v1alpha2.ContainerizedWorkloadStatus{Resources: []v1alpha2.ResourceReference{
+			{APIVersion: "apps/v1", Kind: "Deployment", Name: "web"},
+			{APIVersion: "v1", Kind: "Service", Name: "web"},
+		}},
+	}
+	owner := metav1.OwnerReference{APIVersion: v1alpha2.GroupVersion.String(), Kind: "ContainerizedWorkload", Name: "web"}
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+		Name: "web", Namespace: "apps", UID: "deployment-uid", ResourceVersion: "3",
+		OwnerReferences: []metav1.OwnerReference{owner},
+		Annotations:     map[string]string{"core.oam.dev/trait-managed-fields": "{}"},
+	}}
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "web", Namespace: "apps", OwnerReferences: []metav1.OwnerReference{owner},
+			Annotations: map[string]string{"note": "{{ not a template }}"},
+		},
+		Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{
+			Ingress: []corev1.LoadBalancerIngress{{IP: "10.0.0.1"}},
+		}},
+	}
+	c := fake.NewFakeClientWithScheme(scheme, workload, deployment, service)
+
+	objs, err := ExportChildren(context.Background(), c, "apps", "ContainerizedWorkload", "web")
+	if err != nil {
+		t.Fatalf("ExportChildren() = %v", err)
+	}
+	if len(objs) != 2 || objs[0].GetKind() != "Deployment" || objs[1].GetKind() != "Service" {
+		t.Fatalf("ExportChildren() = %v, want the deployment and the service", objs)
+	}
+	for _, o := range objs {
+		if o.GetNamespace() != "" || o.GetUID() != "" || o.GetResourceVersion() != "" || len(o.GetOwnerReferences()) != 0 {
+			t.Errorf("%s metadata = %v, want none specific to the cluster", o.GetKind(), o.Object["metadata"])
+		}
+		if _, ok := o.Object["status"]; ok {
+			t.Errorf("%s status = %v, want none", o.GetKind(), o.Object["status"])
+		}
+	}
+	if a := objs[0].GetAnnotations(); len(a) != 0 {
+		t.Errorf("Deployment annotations = %v, want none", a)
+	}
+
+	if _, err := ExportChildren(context.Background(), c, "apps", "ContainerizedWorkload", "missing"); err == nil {
+		t.Error("ExportChildren() of a missing workload = nil, want an error")
+	}
+
+	dir, err := ioutil.TempDir("", "children")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := WriteKustomization(filepath.Join(dir, "base"), objs); err != nil {
+		t.Fatalf("WriteKustomization() = %v", err)
+	}
+	k, err := ioutil.ReadFile(filepath.Join(dir, "base", "kustomization.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(k), "- deployment-web.yaml\n- service-web.yaml\n") {
+		t.Errorf("kustomization.yaml = %s, want both manifests as resources", k)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "base", "service-web.yaml")); err != nil {
+		t.Errorf("service manifest: %v", err)
+	}
+
+	if err := WriteHelmChart(filepath.Join(dir, "chart"), "web", objs); err != nil {
+		t.Fatalf("WriteHelmChart() = %v", err)
+	}
+	chart, err := ioutil.ReadFile(filepath.Join(dir, "chart", "Chart.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(chart), "name: web\n") || !strings.Contains(string(chart), "apiVersion: v2\n") {
+		t.Errorf("Chart.yaml = %s, want a v2 chart named web", chart)
+	}
+	tmpl, err := ioutil.ReadFile(filepath.Join(dir, "chart", "templates", "service-web.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{{ "{{" }} not a template }}`; !strings.Contains(string(tmpl), want) {
+		t.Errorf("service template = %s, want %s", tmpl, want)
+	}
+}
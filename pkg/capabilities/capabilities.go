@@ -0,0 +1,128 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package capabilities detects optional CRDs installed in the cluster so that
+// controllers depending on them are only started when they can work.
+package capabilities
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;create;update
+
+// A Capability is an optional API the manager can make use of.
+type Capability struct {
+	// Name the capability is reported under.
+	Name string
+	// GroupVersion that serves Kind, e.g. monitoring.coreos.com/v1.
+	GroupVersion string
+	// Kind that must be served for the capability to be present.
+	Kind string
+}
+
+// Optional capabilities known to the manager.
+var (
+	Istio = Capability{
+		Name:         "istio",
+		GroupVersion: "networking.istio.io/v1alpha3",
+		Kind:         "VirtualService",
+	}
+	PrometheusOperator = Capability{
+		Name:         "prometheus-operator",
+		GroupVersion: "monitoring.coreos.com/v1",
+		Kind:         "ServiceMonitor",
+	}
+	CertManager = Capability{
+		Name:         "cert-manager",
+		GroupVersion: "cert-manager.io/v1alpha2",
+		Kind:         "Certificate",
+	}
+	KEDA = Capability{
+		Name:         "keda",
+		GroupVersion: "keda.sh/v1alpha1",
+		Kind:         "ScaledObject",
+	}
+	VPA = Capability{
+		Name:         "vertical-pod-autoscaler",
+		GroupVersion: "autoscaling.k8s.io/v1",
+		Kind:         "VerticalPodAutoscaler",
+	}
+)
+
+// Known lists every capability Detect checks for by default.
+var Known = []Capability{Istio, PrometheusOperator, CertManager, KEDA, VPA}
+
+const errPublish = "cannot publish detected capabilities"
+
+// Result records which capabilities were found, keyed by name.
+type Result map[string]bool
+
+// Has returns true if the capability was detected.
+func (r Result) Has(c Capability) bool {
+	return r[c.Name]
+}
+
+// Detect checks which of the supplied capabilities the API server serves. A
+// capability whose group version cannot be discovered for any reason is
+// treated as absent so that the manager can still start without it.
+func Detect(d discovery.ServerResourcesInterface, caps []Capability) Result {
+	r := Result{}
+	for _, c := range caps {
+		r[c.Name] = false
+		rl, err := d.ServerResourcesForGroupVersion(c.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, res := range rl.APIResources {
+			if res.Kind == c.Kind {
+				r[c.Name] = true
+				break
+			}
+		}
+	}
+	return r
+}
+
+// Publish records the result in a ConfigMap so that operators can see which
+// optional features are enabled.
+func Publish(ctx context.Context, c client.Client, namespace, name string, r Result) error {
+	data := make(map[string]string, len(r))
+	for k, v := range r {
+		data[k] = strconv.FormatBool(v)
+	}
+
+	cm := &corev1.ConfigMap{}
+	err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, cm)
+	switch {
+	case err == nil:
+		cm.Data = data
+		err = c.Update(ctx, cm)
+	case client.IgnoreNotFound(err) == nil:
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Data:       data,
+		}
+		err = c.Create(ctx, cm)
+	}
+	return errors.Wrap(err, errPublish)
+}
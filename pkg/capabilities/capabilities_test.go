@@ -0,0 +1,46 @@
+package capabilities
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestDetect(t *testing.T) {
+	testCases := map[string]struct {
+		resources []*metav1.APIResourceList
+		want      Result
+	}{
+		"NoneInstalled": {
+			want: Result{"istio": false, "prometheus-operator": false, "cert-manager": false, "keda": false,
+				"vertical-pod-autoscaler": false},
+		},
+		"CertManagerInstalled": {
+			resources: []*metav1.APIResourceList{{
+				GroupVersion: "cert-manager.io/v1alpha2",
+				APIResources: []metav1.APIResource{{Kind: "Issuer"}, {Kind: "Certificate"}},
+			}},
+			want: Result{"istio": false, "prometheus-operator": false, "cert-manager": true, "keda": false,
+				"vertical-pod-autoscaler": false},
+		},
+		"GroupWithoutKind": {
+			resources: []*metav1.APIResourceList{{
+				GroupVersion: "monitoring.coreos.com/v1",
+				APIResources: []metav1.APIResource{{Kind: "PrometheusRule"}},
+			}},
+			want: Result{"istio": false, "prometheus-operator": false, "cert-manager": false, "keda": false,
+				"vertical-pod-autoscaler": false},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{Resources: testCase.resources}}
+			if got := Detect(d, Known); !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("Detect() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
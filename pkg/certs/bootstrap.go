@@ -0,0 +1,233 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	admissionv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// RotateBefore is how long before expiry a serving certificate is replaced.
+	RotateBefore = 30 * 24 * time.Hour
+	// CheckInterval is how often the certificate is checked for rotation.
+	CheckInterval = time.Hour
+)
+
+// Bootstrap error strings.
+const (
+	errGetSecret      = "cannot get webhook certificate secret"
+	errApplySecret    = "cannot apply webhook certificate secret"
+	errGenerate       = "cannot generate webhook certificate"
+	errWriteCertFiles = "cannot write webhook certificate files"
+	errPatchMutating  = "cannot patch CA bundle of mutating webhook configuration"
+	errPatchValidate  = "cannot patch CA bundle of validating webhook configuration"
+)
+
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;create;update
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=mutatingwebhookconfigurations;validatingwebhookconfigurations,verbs=get;update
+
+// A Bootstrapper keeps a self-signed webhook serving certificate stored in a
+// Secret, written to the webhook server's certificate directory, and trusted
+// by the webhook configurations that call the server.
+type Bootstrapper struct {
+	// Client must be usable before the manager's cache has started.
+	Client client.Client
+	Log    logr.Logger
+
+	// Namespace of the webhook Service and certificate Secret.
+	Namespace string
+	// ServiceName of the Service in front of the webhook server.
+	ServiceName string
+	// SecretName of the Secret the certificate is stored in.
+	SecretName string
+	// CertDir the webhook server reads tls.crt and tls.key from.
+	CertDir string
+
+	// MutatingWebhookConfigurations whose CA bundle should be patched.
+	MutatingWebhookConfigurations []string
+	// ValidatingWebhookConfigurations whose CA bundle should be patched.
+	ValidatingWebhookConfigurations []string
+}
+
+// NeedLeaderElection returns false so that every replica keeps its own
+// certificate files up to date.
+func (b *Bootstrapper) NeedLeaderElection() bool {
+	return false
+}
+
+// Start periodically rotates the certificate until stop is closed.
+func (b *Bootstrapper) Start(stop <-chan struct{}) error {
+	t := time.NewTicker(CheckInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-stop:
+			return nil
+		case <-t.C:
+			if err := b.Bootstrap(context.Background()); err != nil {
+				b.Log.Error(err, "Failed to rotate webhook certificate")
+			}
+		}
+	}
+}
+
+// Bootstrap makes sure a valid certificate exists, generating a new one if it
+// is missing or about to expire, then installs it.
+func (b *Bootstrapper) Bootstrap(ctx context.Context) error {
+	dnsName := b.ServiceName + "." + b.Namespace + ".svc"
+
+	secret := &corev1.Secret{}
+	err := b.Client.Get(ctx, client.ObjectKey{Namespace: b.Namespace, Name: b.SecretName}, secret)
+	if client.IgnoreNotFound(err) != nil {
+		return errors.Wrap(err, errGetSecret)
+	}
+	exists := err == nil
+
+	current := &Artifacts{
+		CACert: secret.Data[corev1.ServiceAccountRootCAKey],
+		Cert:   secret.Data[corev1.TLSCertKey],
+		Key:    secret.Data[corev1.TLSPrivateKeyKey],
+	}
+	if err := current.Verify(dnsName, time.Now(), RotateBefore); err != nil {
+		b.Log.Info("Generating webhook certificate", "secret", b.SecretName, "reason", err.Error())
+		next, err := Generate(b.ServiceName, []string{dnsName, dnsName + ".cluster.local"}, time.Now())
+		if err != nil {
+			return errors.Wrap(err, errGenerate)
+		}
+		// Keep trusting the previous authority until the next rotation so
+		// that replicas still serving the old certificate keep working.
+		if previous, _ := pem.Decode(current.CACert); previous != nil {
+			next.CACert = append(next.CACert, pem.EncodeToMemory(previous)...)
+		}
+		current = next
+
+		secret.SetName(b.SecretName)
+		secret.SetNamespace(b.Namespace)
+		secret.Type = corev1.SecretTypeTLS
+		secret.Data = map[string][]byte{
+			corev1.ServiceAccountRootCAKey: current.CACert,
+			corev1.TLSCertKey:              current.Cert,
+			corev1.TLSPrivateKeyKey:        current.Key,
+		}
+		if exists {
+			err = b.Client.Update(ctx, secret)
+		} else {
+			err = b.Client.Create(ctx, secret)
+		}
+		if err != nil {
+			// Another replica may have rotated first; we'll pick its
+			// certificate up on the next check.
+			return errors.Wrap(err, errApplySecret)
+		}
+	}
+
+	if err := b.writeCertFiles(current); err != nil {
+		return errors.Wrap(err, errWriteCertFiles)
+	}
+	if err := b.patchMutating(ctx, current.CACert); err != nil {
+		return errors.Wrap(err, errPatchMutating)
+	}
+	return errors.Wrap(b.patchValidating(ctx, current.CACert), errPatchValidate)
+}
+
+func (b *Bootstrapper) writeCertFiles(a *Artifacts) error {
+	if err := os.MkdirAll(b.CertDir, 0700); err != nil {
+		return err
+	}
+	files := map[string][]byte{corev1.TLSCertKey: a.Cert, corev1.TLSPrivateKeyKey: a.Key}
+	for name, data := range files {
+		path := filepath.Join(b.CertDir, name)
+		if existing, err := ioutil.ReadFile(path); err == nil && bytes.Equal(existing, data) {
+			continue
+		}
+		// write then rename so the webhook server never reads a partial file
+		tmp := path + ".tmp"
+		if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+			return err
+		}
+		if err := os.Rename(tmp, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *Bootstrapper) patchMutating(ctx context.Context, caBundle []byte) error {
+	for _, name := range b.MutatingWebhookConfigurations {
+		var wc admissionv1beta1.MutatingWebhookConfiguration
+		if err := b.Client.Get(ctx, client.ObjectKey{Name: name}, &wc); err != nil {
+			if apierrors.IsNotFound(err) {
+				b.Log.Info("Skipping missing webhook configuration", "name", name)
+				continue
+			}
+			return err
+		}
+		changed := false
+		for i := range wc.Webhooks {
+			if !bytes.Equal(wc.Webhooks[i].ClientConfig.CABundle, caBundle) {
+				wc.Webhooks[i].ClientConfig.CABundle = caBundle
+				changed = true
+			}
+		}
+		if changed {
+			if err := b.Client.Update(ctx, &wc); err != nil {
+				return err
+			}
+			b.Log.Info("Patched CA bundle", "mutatingwebhookconfiguration", name)
+		}
+	}
+	return nil
+}
+
+func (b *Bootstrapper) patchValidating(ctx context.Context, caBundle []byte) error {
+	for _, name := range b.ValidatingWebhookConfigurations {
+		var wc admissionv1beta1.ValidatingWebhookConfiguration
+		if err := b.Client.Get(ctx, client.ObjectKey{Name: name}, &wc); err != nil {
+			if apierrors.IsNotFound(err) {
+				b.Log.Info("Skipping missing webhook configuration", "name", name)
+				continue
+			}
+			return err
+		}
+		changed := false
+		for i := range wc.Webhooks {
+			if !bytes.Equal(wc.Webhooks[i].ClientConfig.CABundle, caBundle) {
+				wc.Webhooks[i].ClientConfig.CABundle = caBundle
+				changed = true
+			}
+		}
+		if changed {
+			if err := b.Client.Update(ctx, &wc); err != nil {
+				return err
+			}
+			b.Log.Info("Patched CA bundle", "validatingwebhookconfiguration", name)
+		}
+	}
+	return nil
+}
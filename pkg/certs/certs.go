@@ -0,0 +1,147 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package certs generates and rotates a self-signed certificate authority and
+// serving certificate for the webhook server, so that webhooks work in local
+// clusters that do not run cert-manager.
+package certs
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	keySize = 2048
+
+	// CAValidity is how long a generated certificate authority is valid.
+	CAValidity = 10 * 365 * 24 * time.Hour
+	// CertValidity is how long a generated serving certificate is valid.
+	CertValidity = 365 * 24 * time.Hour
+)
+
+const (
+	errGenerateKey  = "cannot generate private key"
+	errCreateCert   = "cannot create certificate"
+	errParseCert    = "cannot parse certificate"
+	errDecodeCert   = "cannot decode PEM certificate"
+	errGenerateSN   = "cannot generate serial number"
+	errParseCAChain = "cannot parse certificate authority"
+)
+
+// Artifacts are the PEM encoded certificates and key used by the webhook server.
+type Artifacts struct {
+	// CACert is the certificate of the authority that signed Cert.
+	CACert []byte
+	// Cert is the serving certificate.
+	Cert []byte
+	// Key is the private key of the serving certificate.
+	Key []byte
+}
+
+// Generate creates a new certificate authority and a serving certificate
+// signed by it that is valid for the supplied DNS names.
+func Generate(commonName string, dnsNames []string, now time.Time) (*Artifacts, error) {
+	caKey, err := rsa.GenerateKey(rand.Reader, keySize)
+	if err != nil {
+		return nil, errors.Wrap(err, errGenerateKey)
+	}
+	caSN, err := serialNumber()
+	if err != nil {
+		return nil, err
+	}
+	caTmpl := &x509.Certificate{
+		SerialNumber:          caSN,
+		Subject:               pkix.Name{CommonName: commonName + "-ca"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(CAValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateCert)
+	}
+	ca, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseCert)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, keySize)
+	if err != nil {
+		return nil, errors.Wrap(err, errGenerateKey)
+	}
+	sn, err := serialNumber()
+	if err != nil {
+		return nil, err
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: sn,
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     dnsNames,
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(CertValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateCert)
+	}
+
+	return &Artifacts{
+		CACert: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		Cert:   pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		Key:    pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+	}, nil
+}
+
+// Verify returns an error unless the serving certificate was signed by the
+// certificate authority, is valid for the DNS name and does not expire
+// within the supplied grace period.
+func (a *Artifacts) Verify(dnsName string, now time.Time, grace time.Duration) error {
+	block, _ := pem.Decode(a.Cert)
+	if block == nil {
+		return errors.New(errDecodeCert)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return errors.Wrap(err, errParseCert)
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(a.CACert) {
+		return errors.New(errParseCAChain)
+	}
+	_, err = cert.Verify(x509.VerifyOptions{
+		DNSName:     dnsName,
+		Roots:       roots,
+		CurrentTime: now.Add(grace),
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	return err
+}
+
+func serialNumber() (*big.Int, error) {
+	sn, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	return sn, errors.Wrap(err, errGenerateSN)
+}
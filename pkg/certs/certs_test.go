@@ -0,0 +1,63 @@
+package certs
+
+import (
+	"testing"
+	"time"
+)
+
+func TestArtifacts_Verify(t *testing.T) {
+	now := time.Now()
+	a, err := Generate("webhook-service", []string{"webhook-service.oam-system.svc"}, now)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	other, err := Generate("webhook-service", []string{"webhook-service.oam-system.svc"}, now)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	testCases := map[string]struct {
+		artifacts *Artifacts
+		dnsName   string
+		grace     time.Duration
+		wantErr   bool
+	}{
+		"Valid": {
+			artifacts: a,
+			dnsName:   "webhook-service.oam-system.svc",
+			grace:     RotateBefore,
+		},
+		"WrongDNSName": {
+			artifacts: a,
+			dnsName:   "other.oam-system.svc",
+			wantErr:   true,
+		},
+		"ExpiresWithinGracePeriod": {
+			artifacts: a,
+			dnsName:   "webhook-service.oam-system.svc",
+			grace:     CertValidity,
+			wantErr:   true,
+		},
+		"SignedByAnotherAuthority": {
+			artifacts: &Artifacts{CACert: other.CACert, Cert: a.Cert, Key: a.Key},
+			dnsName:   "webhook-service.oam-system.svc",
+			wantErr:   true,
+		},
+		"TrustedByCombinedBundle": {
+			artifacts: &Artifacts{CACert: append(append([]byte{}, other.CACert...), a.CACert...), Cert: a.Cert},
+			dnsName:   "webhook-service.oam-system.svc",
+		},
+		"Empty": {
+			artifacts: &Artifacts{},
+			dnsName:   "webhook-service.oam-system.svc",
+			wantErr:   true,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if err := testCase.artifacts.Verify(testCase.dnsName, now, testCase.grace); (err != nil) != testCase.wantErr {
+				t.Errorf("Verify() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package compose converts the services of a docker-compose.yml into
+// ContainerizedWorkloads and the traits applied to them.
+package compose
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/builder"
+)
+
+// Error strings.
+const (
+	errParseFile = "cannot parse the compose file"
+	errService   = "cannot convert service"
+)
+
+// A File is the part of a docker-compose.yml that is converted.
+type File struct {
+	Services map[string]Service `json:"services"`
+}
+
+// A Service of a compose file.
+type Service struct {
+	Image       string      `json:"image"`
+	Entrypoint  stringList  `json:"entrypoint,omitempty"`
+	Command     stringList  `json:"command,omitempty"`
+	Environment environment `json:"environment,omitempty"`
+	Ports       []port      `json:"ports,omitempty"`
+	WorkingDir  string      `json:"working_dir,omitempty"`
+	Deploy      *Deploy     `json:"deploy,omitempty"`
+}
+
+// Deploy holds the deployment settings of a service.
+type Deploy struct {
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+// Convert returns a ContainerizedWorkload per service of the compose file
+// and a ManualScalerTrait for each service setting deploy.replicas, in the
+// order of the service names.
+func Convert(data []byte, namespace string) ([]runtime.Object, error) {
+	var f File
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, errors.Wrap(err, errParseFile)
+	}
+	names := make([]string, 0, len(f.Services))
+	for name := range f.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var objs []runtime.Object
+	for _, name := range names {
+		app, err := convertService(namespace, name, f.Services[name])
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s %q", errService, name)
+		}
+		objs = append(objs, app.Objects()...)
+	}
+	return objs, nil
+}
+
+func convertService(namespace, name string, s Service) (*builder.Application, error) {
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return nil, errors.New(strings.Join(errs, ", "))
+	}
+	if s.Image == "" {
+		return nil, errors.New("services without an image are not supported")
+	}
+	c := corev1.Container{
+		Name:       name,
+		Image:      s.Image,
+		Command:    s.Entrypoint,
+		Args:       s.Command,
+		WorkingDir: s.WorkingDir,
+	}
+	for _, k := range sortedKeys(s.Environment) {
+		c.Env = append(c.Env, corev1.EnvVar{Name: k, Value: s.Environment[k]})
+	}
+	for _, p := range s.Ports {
+		c.Ports = append(c.Ports, corev1.ContainerPort{ContainerPort: p.Target, Protocol: p.Protocol})
+	}
+
+	b := builder.NewContainerizedWorkload(namespace, name).WithContainer(c)
+	if s.Deploy != nil && s.Deploy.Replicas != nil {
+		b = b.WithTrait(&v1alpha2.ManualScalerTrait{
+			Spec: v1alpha2.ManualScalerTraitSpec{ReplicaCount: *s.Deploy.Replicas},
+		})
+	}
+	return b.Build()
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// a stringList is either a string, split on white space without any shell
+// quoting, or a list of strings
+type stringList []string
+
+func (l *stringList) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*l = strings.Fields(s)
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(l))
+}
+
+// an environment is either a map or a list of NAME=VALUE strings
+type environment map[string]string
+
+func (e *environment) UnmarshalJSON(data []byte) error {
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		var m map[string]*string
+		if err := json.Unmarshal(data, &m); err != nil {
+			return err
+		}
+		*e = make(environment, len(m))
+		for k, v := range m {
+			if v != nil {
+				(*e)[k] = *v
+			}
+		}
+		return nil
+	}
+	*e = make(environment, len(list))
+	for _, kv := range list {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) == 2 {
+			(*e)[parts[0]] = parts[1]
+		}
+	}
+	return nil
+}
+
+// a port the container listens on, in the short [[ip:]published:]target[/protocol]
+// or the long {target, protocol} syntax
+type port struct {
+	Target   int32
+	Protocol corev1.Protocol
+}
+
+func (p *port) UnmarshalJSON(data []byte) error {
+	var long struct {
+		Target   int32  `json:"target"`
+		Protocol string `json:"protocol"`
+	}
+	if err := json.Unmarshal(data, &long); err == nil {
+		p.Target = long.Target
+		p.Protocol = corev1.Protocol(strings.ToUpper(long.Protocol))
+		return p.validate()
+	}
+
+	var short string
+	if err := json.Unmarshal(data, &short); err != nil {
+		// a bare number is a target port
+		var n int32
+		if err := json.Unmarshal(data, &n); err != nil {
+			return err
+		}
+		p.Target = n
+		return p.validate()
+	}
+	target := short
+	if i := strings.LastIndex(target, "/"); i >= 0 {
+		p.Protocol = corev1.Protocol(strings.ToUpper(target[i+1:]))
+		target = target[:i]
+	}
+	if i := strings.LastIndex(target, ":"); i >= 0 {
+		target = target[i+1:]
+	}
+	if strings.Contains(target, "-") {
+		return fmt.Errorf("port ranges are not supported: %s", short)
+	}
+	n, err := strconv.ParseInt(target, 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid port: %s", short)
+	}
+	p.Target = int32(n)
+	return p.validate()
+}
+
+func (p *port) validate() error {
+	if p.Protocol == "" {
+		p.Protocol = corev1.ProtocolTCP
+	}
+	if errs := validation.IsValidPortNum(int(p.Target)); len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+	return nil
+}
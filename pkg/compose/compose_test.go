@@ -0,0 +1,103 @@
+package compose
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestConvert(t *testing.T) {
+	testCases := map[string]struct {
+		file           string
+		wantContainers []corev1.Container
+		wantReplicas   []int32
+		wantErr        bool
+	}{
+		"ShortSyntax": {
+			file: `
+services:
+  web:
+    image: nginx
+    command: nginx -g daemon-off
+    ports: ["8080:80", "127.0.0.1:5353:53/udp"]
+    environment: ["MODE=prod"]
+    deploy:
+      replicas: 3
+`,
+			wantContainers: []corev1.Container{{
+				Name:  "web",
+				Image: "nginx",
+				Args:  []string{"nginx", "-g", "daemon-off"},
+				Env:   []corev1.EnvVar{{Name: "MODE", Value: "prod"}},
+				Ports: []corev1.ContainerPort{
+					{ContainerPort: 80, Protocol: corev1.ProtocolTCP},
+					{ContainerPort: 53, Protocol: corev1.ProtocolUDP},
+				},
+			}},
+			wantReplicas: []int32{3},
+		},
+		"LongSyntax": {
+			file: `
+services:
+  db:
+    image: postgres
+    entrypoint: [docker-entrypoint.sh]
+    ports: [{target: 5432}]
+    environment: {B: "2", A: "1"}
+  cache:
+    image: redis
+`,
+			wantContainers: []corev1.Container{{
+				Name:  "cache",
+				Image: "redis",
+			}, {
+				Name:    "db",
+				Image:   "postgres",
+				Command: []string{"docker-entrypoint.sh"},
+				Env:     []corev1.EnvVar{{Name: "A", Value: "1"}, {Name: "B", Value: "2"}},
+				Ports:   []corev1.ContainerPort{{ContainerPort: 5432, Protocol: corev1.ProtocolTCP}},
+			}},
+		},
+		"NoImage": {
+			file:    "services:\n  web:\n    build: .\n",
+			wantErr: true,
+		},
+		"PortRange": {
+			file:    "services:\n  web:\n    image: nginx\n    ports: [\"8000-8010\"]\n",
+			wantErr: true,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			objs, err := Convert([]byte(testCase.file), "default")
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatal("Convert() succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Convert() = %v", err)
+			}
+			var containers []corev1.Container
+			var replicas []int32
+			for _, o := range objs {
+				switch o := o.(type) {
+				case *v1alpha2.ContainerizedWorkload:
+					containers = append(containers, o.Spec.Containers...)
+				case *v1alpha2.ManualScalerTrait:
+					replicas = append(replicas, o.Spec.ReplicaCount)
+				}
+			}
+			if !reflect.DeepEqual(containers, testCase.wantContainers) {
+				t.Errorf("Convert() containers = %+v, want %+v", containers, testCase.wantContainers)
+			}
+			if !reflect.DeepEqual(replicas, testCase.wantReplicas) {
+				t.Errorf("Convert() replicas = %v, want %v", replicas, testCase.wantReplicas)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conditions expresses the conditions controllers set in the statuses
+// of objects through a pluggable Backend. The Crossplane backend stores them
+// as crossplane-runtime sets them. The Standard backend follows the
+// conventions of metav1.Condition, e.g. CamelCase reasons, for tools like
+// kubectl wait and kstatus. A client returned by NewClient summarizes and
+// expresses the conditions of the statuses it writes:
+//
+//	b, err := conditions.Lookup("standard")
+//	c := conditions.NewClient(mgr.GetClient(), b)
+package conditions
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// DefaultBackend is the name of the backend used unless another is selected,
+// e.g. at build time with
+// -ldflags "-X github.com/oam-dev/core-resource-controller/pkg/conditions.DefaultBackend=standard".
+var DefaultBackend = "crossplane"
+
+// the package of the OAM API types, whose statuses are expressed
+var oamPackage = reflect.TypeOf(v1alpha2.ContainerizedWorkload{}).PkgPath()
+
+// errExpressConditions is returned when the conditions of a status cannot be
+// read or written.
+const errExpressConditions = "cannot express the conditions of the status"
+
+// A Backend expresses the conditions controllers set.
+type Backend interface {
+	// Express returns the condition the way the backend stores it.
+	Express(c cpv1alpha1.Condition) cpv1alpha1.Condition
+}
+
+// Crossplane stores conditions as crossplane-runtime sets them.
+type Crossplane struct{}
+
+// Express returns the condition unchanged.
+func (Crossplane) Express(c cpv1alpha1.Condition) cpv1alpha1.Condition {
+	return c
+}
+
+// Standard stores conditions following the conventions of metav1.Condition:
+// a reason is a CamelCase identifier, e.g. SuccessfullyReconciledResource
+// rather than "Successfully reconciled resource", and is never empty.
+type Standard struct{}
+
+// Express returns the condition with its reason in CamelCase.
+func (Standard) Express(c cpv1alpha1.Condition) cpv1alpha1.Condition {
+	c.Reason = cpv1alpha1.ConditionReason(camelCase(string(c.Reason)))
+	if c.Reason == "" {
+		c.Reason = cpv1alpha1.ConditionReason(c.Type)
+	}
+	return c
+}
+
+// camelCase joins the words of s, capitalised, dropping everything but
+// letters and digits. The result starts with a letter.
+func camelCase(s string) string {
+	var b strings.Builder
+	for _, w := range strings.FieldsFunc(s, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }) {
+		if b.Len() == 0 && !unicode.IsLetter([]rune(w)[0]) {
+			continue
+		}
+		r := []rune(w)
+		r[0] = unicode.ToUpper(r[0])
+		b.WriteString(string(r))
+	}
+	return b.String()
+}
+
+// Backends by the names they are selected by.
+var Backends = map[string]Backend{
+	"crossplane": Crossplane{},
+	"standard":   Standard{},
+}
+
+// Lookup returns the backend of the name.
+func Lookup(name string) (Backend, error) {
+	b, ok := Backends[name]
+	if !ok {
+		names := make([]string, 0, len(Backends))
+		for n := range Backends {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("unknown condition backend %q, want one of %s", name, strings.Join(names, ", "))
+	}
+	return b, nil
+}
+
+// NewClient returns a client that, when it updates or patches the status of
+// an OAM object, records the generation it observed, summarizes its
+// conditions and expresses them through the backend.
+func NewClient(c client.Client, b Backend) client.Client {
+	if b == nil {
+		b = Crossplane{}
+	}
+	return &conditionClient{Client: c, backend: b}
+}
+
+type conditionClient struct {
+	client.Client
+	backend Backend
+}
+
+func (c *conditionClient) Status() client.StatusWriter {
+	return &statusWriter{StatusWriter: c.Client.Status(), client: c}
+}
+
+type statusWriter struct {
+	client.StatusWriter
+	client *conditionClient
+}
+
+func (sw *statusWriter) Update(ctx context.Context, obj runtime.Object, opts ...client.UpdateOption) error {
+	if err := sw.client.express(ctx, obj); err != nil {
+		return err
+	}
+	return sw.StatusWriter.Update(ctx, obj, opts...)
+}
+
+func (sw *statusWriter) Patch(ctx context.Context, obj runtime.Object, patch client.Patch,
+	opts ...client.PatchOption) error {
+	if err := sw.client.express(ctx, obj); err != nil {
+		return err
+	}
+	return sw.StatusWriter.Patch(ctx, obj, patch, opts...)
+}
+
+// express records the generation the status reflects, summarizes the
+// conditions of the status and rewrites them through the backend.
+// Controllers set conditions as crossplane-runtime expresses them, which
+// differ from the stored ones, so the last transition times of conditions
+// that did not change are taken from the stored object.
+func (c *conditionClient) express(ctx context.Context, obj runtime.Object) error {
+	if t := reflect.TypeOf(obj); t.Kind() != reflect.Ptr || t.Elem().PkgPath() != oamPackage {
+		return nil
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return errors.Wrap(err, errExpressConditions)
+	}
+	var status cpv1alpha1.ConditionedStatus
+	if !conditionsOf(u, &status) {
+		return nil
+	}
+	Summarize(&status)
+	if m, err := meta.Accessor(obj); err == nil {
+		if err := unstructured.SetNestedField(u, m.GetGeneration(), "status", "observedGeneration"); err != nil {
+			return errors.Wrap(err, errExpressConditions)
+		}
+	}
+
+	var stored cpv1alpha1.ConditionedStatus
+	if m, err := meta.Accessor(obj); err == nil {
+		existing := obj.DeepCopyObject()
+		err := c.Get(ctx, client.ObjectKey{Namespace: m.GetNamespace(), Name: m.GetName()}, existing)
+		if err == nil {
+			if su, err := runtime.DefaultUnstructuredConverter.ToUnstructured(existing); err == nil {
+				conditionsOf(su, &stored)
+			}
+		}
+	}
+
+	conditions := make([]interface{}, 0, len(status.Conditions))
+	for _, cond := range status.Conditions {
+		cond = c.backend.Express(cond)
+		if s := stored.GetCondition(cond.Type); s.Equal(cond) {
+			cond.LastTransitionTime = s.LastTransitionTime
+		}
+		v, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&cond)
+		if err != nil {
+			return errors.Wrap(err, errExpressConditions)
+		}
+		conditions = append(conditions, v)
+	}
+	if err := unstructured.SetNestedSlice(u, conditions, "status", "conditions"); err != nil {
+		return errors.Wrap(err, errExpressConditions)
+	}
+	return errors.Wrap(runtime.DefaultUnstructuredConverter.FromUnstructured(u, obj), errExpressConditions)
+}
+
+// conditionsOf reads the conditions of the unstructured object's status into
+// s, returning false if it has none.
+func conditionsOf(u map[string]interface{}, s *cpv1alpha1.ConditionedStatus) bool {
+	status, ok, err := unstructured.NestedMap(u, "status")
+	if err != nil || !ok {
+		return false
+	}
+	if _, ok := status["conditions"].([]interface{}); !ok {
+		return false
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(status, s) == nil
+}
@@ -0,0 +1,83 @@
+package conditions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestStandardExpress(t *testing.T) {
+	cases := map[string]struct {
+		reason cpv1alpha1.ConditionReason
+		want   cpv1alpha1.ConditionReason
+	}{
+		"Sentence":     {reason: cpv1alpha1.ReasonReconcileSuccess, want: "SuccessfullyReconciledResource"},
+		"Punctuation":  {reason: "Pods can't be scheduled, retrying", want: "PodsCanTBeScheduledRetrying"},
+		"LeadingDigit": {reason: "3 replicas are missing", want: "ReplicasAreMissing"},
+		"CamelCase":    {reason: "ReconcileSuccess", want: "ReconcileSuccess"},
+		"Empty":        {reason: "", want: "Synced"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Standard{}.Express(cpv1alpha1.Condition{Type: cpv1alpha1.TypeSynced, Reason: tc.reason})
+			if got.Reason != tc.want {
+				t.Errorf("Express() reason = %q, want %q", got.Reason, tc.want)
+			}
+		})
+	}
+}
+
+func TestClient(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha2.AddToScheme(scheme)
+
+	before := metav1.NewTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	trait := &v1alpha2.ManualScalerTrait{ObjectMeta: metav1.ObjectMeta{Name: "scaler", Namespace: "apps", Generation: 2}}
+	c := NewClient(fake.NewFakeClientWithScheme(scheme, trait), Standard{})
+	ctx := context.Background()
+	key := client.ObjectKey{Namespace: "apps", Name: "scaler"}
+
+	// the first update expresses the conditions
+	synced := cpv1alpha1.ReconcileSuccess()
+	synced.LastTransitionTime = before
+	trait.Status.SetConditions(synced)
+	if err := c.Status().Update(ctx, trait); err != nil {
+		t.Fatalf("Update() = %v", err)
+	}
+	got := &v1alpha2.ManualScalerTrait{}
+	if err := c.Get(ctx, key, got); err != nil {
+		t.Fatal(err)
+	}
+	if r := got.Status.GetCondition(cpv1alpha1.TypeSynced).Reason; r != "SuccessfullyReconciledResource" {
+		t.Errorf("Update() stored reason %q, want SuccessfullyReconciledResource", r)
+	}
+	if c := got.Status.GetCondition(cpv1alpha1.TypeReady); c.Status != corev1.ConditionTrue {
+		t.Errorf("Update() ready condition = %+v, want true", c)
+	}
+	if got.Status.ObservedGeneration != 2 {
+		t.Errorf("Update() observed generation = %d, want 2", got.Status.ObservedGeneration)
+	}
+
+	// setting the same condition again keeps its last transition time
+	got.Status.SetConditions(cpv1alpha1.ReconcileSuccess())
+	if err := c.Status().Update(ctx, got); err != nil {
+		t.Fatalf("Update() = %v", err)
+	}
+	if err := c.Get(ctx, key, got); err != nil {
+		t.Fatal(err)
+	}
+	if lt := got.Status.GetCondition(cpv1alpha1.TypeSynced).LastTransitionTime; !lt.Equal(&before) {
+		t.Errorf("Update() last transition time = %v, want %v", lt, before)
+	}
+}
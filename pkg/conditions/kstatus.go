@@ -0,0 +1,69 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// pending conditions are those of objects whose changes are yet to take
+// effect, when they have the status.
+var pending = []struct {
+	Type   cpv1alpha1.ConditionType
+	Status corev1.ConditionStatus
+}{
+	{v1alpha2.TypeDeferred, corev1.ConditionTrue},
+	{v1alpha2.TypeDependenciesReady, corev1.ConditionFalse},
+	{v1alpha2.TypeScheduled, corev1.ConditionFalse},
+	{v1alpha2.TypeContainersHealthy, corev1.ConditionFalse},
+	{v1alpha2.TypeEndpointsHealthy, corev1.ConditionFalse},
+	{v1alpha2.TypeExternalReferencesResolved, corev1.ConditionFalse},
+}
+
+// Summarize sets the Ready, Reconciling and Stalled conditions kstatus, and
+// the health checks of e.g. Flux and Argo CD, read from the other conditions
+// of the status. An object failing to sync or whose rollout is degraded is
+// stalled, one that is not synced yet or waits on any of the pending
+// conditions is reconciling, and any other is ready.
+func Summarize(s *cpv1alpha1.ConditionedStatus) {
+	synced := s.GetCondition(cpv1alpha1.TypeSynced)
+	if synced.Status == corev1.ConditionFalse {
+		s.SetConditions(cpv1alpha1.Unavailable(), v1alpha2.NotReconciling(), v1alpha2.Stalled(synced.Message))
+		return
+	}
+	if d := s.GetCondition(v1alpha2.TypeDegraded); d.Status == corev1.ConditionTrue {
+		s.SetConditions(cpv1alpha1.Unavailable(), v1alpha2.NotReconciling(), v1alpha2.Stalled(d.Message))
+		return
+	}
+	if synced.Status != corev1.ConditionTrue {
+		s.SetConditions(cpv1alpha1.Unavailable(), v1alpha2.Reconciling("not synced yet"), v1alpha2.NotStalled())
+		return
+	}
+	for _, p := range pending {
+		if c := s.GetCondition(p.Type); c.Status == p.Status {
+			msg := c.Message
+			if msg == "" {
+				msg = string(c.Reason)
+			}
+			s.SetConditions(cpv1alpha1.Unavailable(), v1alpha2.Reconciling(msg), v1alpha2.NotStalled())
+			return
+		}
+	}
+	s.SetConditions(cpv1alpha1.Available(), v1alpha2.NotReconciling(), v1alpha2.NotStalled())
+}
@@ -0,0 +1,68 @@
+package conditions
+
+import (
+	"testing"
+	"time"
+
+	cpv1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestSummarize(t *testing.T) {
+	cases := map[string]struct {
+		conditions  []cpv1alpha1.Condition
+		ready       corev1.ConditionStatus
+		reconciling corev1.ConditionStatus
+		stalled     corev1.ConditionStatus
+	}{
+		"Synced": {
+			conditions:  []cpv1alpha1.Condition{cpv1alpha1.ReconcileSuccess()},
+			ready:       corev1.ConditionTrue,
+			reconciling: corev1.ConditionFalse,
+			stalled:     corev1.ConditionFalse,
+		},
+		"NotSyncedYet": {
+			conditions:  []cpv1alpha1.Condition{v1alpha2.PermissionGranted()},
+			ready:       corev1.ConditionFalse,
+			reconciling: corev1.ConditionTrue,
+			stalled:     corev1.ConditionFalse,
+		},
+		"ReconcileError": {
+			conditions:  []cpv1alpha1.Condition{cpv1alpha1.ReconcileError(errors.New("boom"))},
+			ready:       corev1.ConditionFalse,
+			reconciling: corev1.ConditionFalse,
+			stalled:     corev1.ConditionTrue,
+		},
+		"Deferred": {
+			conditions:  []cpv1alpha1.Condition{cpv1alpha1.ReconcileSuccess(), v1alpha2.Deferred(time.Now().Add(time.Hour))},
+			ready:       corev1.ConditionFalse,
+			reconciling: corev1.ConditionTrue,
+			stalled:     corev1.ConditionFalse,
+		},
+		"Degraded": {
+			conditions:  []cpv1alpha1.Condition{cpv1alpha1.ReconcileSuccess(), v1alpha2.Degraded("web-deployment")},
+			ready:       corev1.ConditionFalse,
+			reconciling: corev1.ConditionFalse,
+			stalled:     corev1.ConditionTrue,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var s cpv1alpha1.ConditionedStatus
+			s.SetConditions(tc.conditions...)
+			Summarize(&s)
+			for ct, want := range map[cpv1alpha1.ConditionType]corev1.ConditionStatus{
+				cpv1alpha1.TypeReady:     tc.ready,
+				v1alpha2.TypeReconciling: tc.reconciling,
+				v1alpha2.TypeStalled:     tc.stalled,
+			} {
+				if got := s.GetCondition(ct).Status; got != want {
+					t.Errorf("Summarize() %s = %s, want %s", ct, got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package crds installs or upgrades the CRDs of the kinds the manager serves.
+// The manifests of config/crd/bases are embedded in the manager.
+package crds
+
+//go:generate go run gen.go
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;create;update
+
+const (
+	errDecode       = "cannot decode the embedded CRD"
+	errInstall      = "cannot install the CRD"
+	errNotEstablish = "the CRDs were not established"
+)
+
+// CRDs returns the embedded CRDs, ordered by name.
+func CRDs() ([]*apiextensionsv1beta1.CustomResourceDefinition, error) {
+	crds := make([]*apiextensionsv1beta1.CustomResourceDefinition, 0, len(manifests))
+	for file, m := range manifests {
+		crd := &apiextensionsv1beta1.CustomResourceDefinition{}
+		if err := yaml.Unmarshal([]byte(m), crd); err != nil {
+			return nil, errors.Wrapf(err, "%s %s", errDecode, file)
+		}
+		crds = append(crds, crd)
+	}
+	sort.Slice(crds, func(i, j int) bool { return crds[i].Name < crds[j].Name })
+	return crds, nil
+}
+
+// Install creates the CRDs that do not exist and updates the spec of those
+// that differ. It returns the number of CRDs created or updated.
+func Install(ctx context.Context, c client.Client, crds []*apiextensionsv1beta1.CustomResourceDefinition) (int, error) {
+	n := 0
+	for _, crd := range crds {
+		existing := &apiextensionsv1beta1.CustomResourceDefinition{}
+		err := c.Get(ctx, client.ObjectKey{Name: crd.Name}, existing)
+		switch {
+		case apierrors.IsNotFound(err):
+			if err := c.Create(ctx, crd.DeepCopy()); err != nil {
+				return n, errors.Wrapf(err, "%s %s", errInstall, crd.Name)
+			}
+		case err != nil:
+			return n, errors.Wrapf(err, "%s %s", errInstall, crd.Name)
+		case reflect.DeepEqual(existing.Spec, crd.Spec):
+			continue
+		default:
+			existing.Spec = crd.Spec
+			if err := c.Update(ctx, existing); err != nil {
+				return n, errors.Wrapf(err, "%s %s", errInstall, crd.Name)
+			}
+		}
+		n++
+	}
+	return n, nil
+}
+
+// Wait polls the CRDs every interval until all of them are established, so
+// that the manager can watch their kinds, or the context is done.
+func Wait(ctx context.Context, c client.Client, crds []*apiextensionsv1beta1.CustomResourceDefinition,
+	interval time.Duration) error {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		pending := ""
+		for _, crd := range crds {
+			existing := &apiextensionsv1beta1.CustomResourceDefinition{}
+			if err := c.Get(ctx, client.ObjectKey{Name: crd.Name}, existing); err != nil {
+				return errors.Wrap(err, errNotEstablish)
+			}
+			if !established(existing) {
+				pending = crd.Name
+				break
+			}
+		}
+		if pending == "" {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "%s: %s is pending", errNotEstablish, pending)
+		case <-t.C:
+		}
+	}
+}
+
+func established(crd *apiextensionsv1beta1.CustomResourceDefinition) bool {
+	for _, c := range crd.Status.Conditions {
+		if c.Type == apiextensionsv1beta1.Established {
+			return c.Status == apiextensionsv1beta1.ConditionTrue
+		}
+	}
+	return false
+}
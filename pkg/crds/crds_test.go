@@ -0,0 +1,75 @@
+package crds
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestInstall(t *testing.T) {
+	crds, err := CRDs()
+	if err != nil {
+		t.Fatalf("CRDs() error = %v", err)
+	}
+	if len(crds) != len(manifests) {
+		t.Fatalf("CRDs() returned %d CRDs, want %d", len(crds), len(manifests))
+	}
+	for _, crd := range crds {
+		if crd.Spec.Group != "core.oam.dev" {
+			t.Errorf("CRD %s is of group %q, want core.oam.dev", crd.Name, crd.Spec.Group)
+		}
+	}
+
+	s := runtime.NewScheme()
+	_ = apiextensionsv1beta1.AddToScheme(s)
+	outdated := crds[0].DeepCopy()
+	outdated.Spec.Versions = nil
+	c := fake.NewFakeClientWithScheme(s, outdated)
+	ctx := context.Background()
+
+	n, err := Install(ctx, c, crds)
+	if err != nil {
+		t.Fatalf("Install() error = %v", err)
+	}
+	if n != len(crds) {
+		t.Errorf("Install() = %d, want %d", n, len(crds))
+	}
+	got := &apiextensionsv1beta1.CustomResourceDefinition{}
+	if err := c.Get(ctx, client.ObjectKey{Name: crds[0].Name}, got); err != nil {
+		t.Fatalf("cannot get CRD %s: %v", crds[0].Name, err)
+	}
+	if len(got.Spec.Versions) == 0 {
+		t.Errorf("Install() did not upgrade CRD %s", got.Name)
+	}
+	if n, err := Install(ctx, c, crds); err != nil || n != 0 {
+		t.Errorf("Install() of installed CRDs = %d, %v, want 0, nil", n, err)
+	}
+
+	// the fake API server never establishes the CRDs
+	timeout, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if err := Wait(timeout, c, crds, 10*time.Millisecond); err == nil {
+		t.Errorf("Wait() of pending CRDs error = nil")
+	}
+	for _, crd := range crds {
+		got := &apiextensionsv1beta1.CustomResourceDefinition{}
+		_ = c.Get(ctx, client.ObjectKey{Name: crd.Name}, got)
+		got.Status.Conditions = []apiextensionsv1beta1.CustomResourceDefinitionCondition{{
+			Type:               apiextensionsv1beta1.Established,
+			Status:             apiextensionsv1beta1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+		}}
+		if err := c.Update(ctx, got); err != nil {
+			t.Fatalf("cannot establish CRD %s: %v", crd.Name, err)
+		}
+	}
+	if err := Wait(ctx, c, crds, 10*time.Millisecond); err != nil {
+		t.Errorf("Wait() error = %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+//go:build ignore
+// +build ignore
+
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// gen.go embeds the CRD manifests of config/crd/bases in
+// zz_generated.crds.go. Run it through go generate after make manifests.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func main() {
+	files, err := filepath.Glob(filepath.Join("..", "..", "config", "crd", "bases", "*.yaml"))
+	if err != nil {
+		fail(err)
+	}
+	sort.Strings(files)
+	header, err := ioutil.ReadFile(filepath.Join("..", "..", "hack", "boilerplate.go.txt"))
+	if err != nil {
+		fail(err)
+	}
+
+	var b bytes.Buffer
+	b.Write(bytes.TrimRight(header, "\n"))
+	b.WriteString("\n\n// Code generated by gen.go. DO NOT EDIT.\n\npackage crds\n\n")
+	b.WriteString("// manifests of the CRDs, by file name\nvar manifests = map[string]string{\n")
+	for _, f := range files {
+		data, err := ioutil.ReadFile(f)
+		if err != nil {
+			fail(err)
+		}
+		if strings.Contains(string(data), "`") {
+			fail(fmt.Errorf("%s contains a backquote", f))
+		}
+		fmt.Fprintf(&b, "\t%q: `%s`,\n", filepath.Base(f), data)
+	}
+	b.WriteString("}\n")
+	if err := ioutil.WriteFile("zz_generated.crds.go", b.Bytes(), 0644); err != nil {
+		fail(err)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
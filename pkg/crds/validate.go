@@ -0,0 +1,241 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crds
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// A Validator validates objects against the OpenAPI schemas of CRDs. Unlike
+// the API server, which prunes the fields a schema does not declare, it
+// rejects them, so that a misspelled field fails rather than being dropped.
+type Validator struct {
+	schemas map[schema.GroupVersionKind]*apiextensionsv1beta1.JSONSchemaProps
+}
+
+// NewValidator returns a Validator for the versions of the CRDs.
+func NewValidator(crds []*apiextensionsv1beta1.CustomResourceDefinition) *Validator {
+	v := &Validator{schemas: map[schema.GroupVersionKind]*apiextensionsv1beta1.JSONSchemaProps{}}
+	for _, crd := range crds {
+		versions := crd.Spec.Versions
+		if len(versions) == 0 {
+			versions = []apiextensionsv1beta1.CustomResourceDefinitionVersion{{Name: crd.Spec.Version}}
+		}
+		for _, version := range versions {
+			s := version.Schema
+			if s == nil {
+				s = crd.Spec.Validation
+			}
+			if s == nil || s.OpenAPIV3Schema == nil {
+				continue
+			}
+			gvk := schema.GroupVersionKind{Group: crd.Spec.Group, Version: version.Name, Kind: crd.Spec.Names.Kind}
+			v.schemas[gvk] = s.OpenAPIV3Schema
+		}
+	}
+	return v
+}
+
+var embedded struct {
+	once      sync.Once
+	validator *Validator
+	err       error
+}
+
+// EmbeddedValidator returns a Validator for the embedded CRDs.
+func EmbeddedValidator() (*Validator, error) {
+	embedded.once.Do(func() {
+		crds, err := CRDs()
+		embedded.validator, embedded.err = NewValidator(crds), err
+	})
+	return embedded.validator, embedded.err
+}
+
+// Validate returns an invalid error listing the fields of the object that do
+// not match the schema of its kind. Objects of kinds the Validator has no
+// schema for are valid.
+func (v *Validator) Validate(obj *unstructured.Unstructured) error {
+	gvk := obj.GroupVersionKind()
+	s, ok := v.schemas[gvk]
+	if !ok {
+		return nil
+	}
+	errs := validateValue(s, obj.Object, nil)
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(gvk.GroupKind(), obj.GetName(), errs)
+}
+
+// validateValue checks the value against the parts of the schema that
+// controller-gen generates: types, properties, required fields, enums and
+// bounds.
+func validateValue(s *apiextensionsv1beta1.JSONSchemaProps, v interface{}, path *field.Path) field.ErrorList {
+	if v == nil {
+		if s.Nullable {
+			return nil
+		}
+		return field.ErrorList{field.Invalid(path, v, "must not be null")}
+	}
+	if s.XIntOrString {
+		switch v.(type) {
+		case string, int64, float64:
+			return nil
+		}
+		return field.ErrorList{field.Invalid(path, v, "must be an integer or a string")}
+	}
+	if !hasType(s.Type, v) {
+		return field.ErrorList{field.Invalid(path, v, "must be of type "+s.Type)}
+	}
+
+	var errs field.ErrorList
+	if len(s.Enum) > 0 && !inEnum(s.Enum, v) {
+		allowed := make([]string, 0, len(s.Enum))
+		for _, e := range s.Enum {
+			allowed = append(allowed, string(e.Raw))
+		}
+		errs = append(errs, field.NotSupported(path, v, allowed))
+	}
+	switch v := v.(type) {
+	case map[string]interface{}:
+		errs = append(errs, validateObject(s, v, path)...)
+	case []interface{}:
+		if s.MinItems != nil && int64(len(v)) < *s.MinItems {
+			errs = append(errs, field.Invalid(path, len(v), fmt.Sprintf("must have at least %d items", *s.MinItems)))
+		}
+		if s.MaxItems != nil && int64(len(v)) > *s.MaxItems {
+			errs = append(errs, field.TooMany(path, len(v), int(*s.MaxItems)))
+		}
+		if s.Items != nil && s.Items.Schema != nil {
+			for i, item := range v {
+				errs = append(errs, validateValue(s.Items.Schema, item, path.Index(i))...)
+			}
+		}
+	case string:
+		if s.MinLength != nil && int64(len(v)) < *s.MinLength {
+			errs = append(errs, field.Invalid(path, v, fmt.Sprintf("must be at least %d characters", *s.MinLength)))
+		}
+		if s.MaxLength != nil && int64(len(v)) > *s.MaxLength {
+			errs = append(errs, field.TooLong(path, v, int(*s.MaxLength)))
+		}
+	case int64:
+		errs = append(errs, validateBounds(s, float64(v), path)...)
+	case float64:
+		errs = append(errs, validateBounds(s, v, path)...)
+	}
+	return errs
+}
+
+func validateObject(s *apiextensionsv1beta1.JSONSchemaProps, v map[string]interface{},
+	path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for _, name := range s.Required {
+		if _, ok := v[name]; !ok {
+			errs = append(errs, field.Required(path.Child(name), ""))
+		}
+	}
+	// keep the errors in a stable order
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if p, ok := s.Properties[k]; ok {
+			errs = append(errs, validateValue(&p, v[k], path.Child(k))...)
+			continue
+		}
+		switch {
+		case s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil:
+			errs = append(errs, validateValue(s.AdditionalProperties.Schema, v[k], path.Key(k))...)
+		case len(s.Properties) > 0 && !preservesUnknownFields(s):
+			errs = append(errs, field.Invalid(path.Child(k), v[k], "field not declared in schema"))
+		}
+	}
+	return errs
+}
+
+func validateBounds(s *apiextensionsv1beta1.JSONSchemaProps, v float64, path *field.Path) field.ErrorList {
+	if s.Minimum != nil && (v < *s.Minimum || s.ExclusiveMinimum && v == *s.Minimum) {
+		return field.ErrorList{field.Invalid(path, v, fmt.Sprintf("must be at least %v", *s.Minimum))}
+	}
+	if s.Maximum != nil && (v > *s.Maximum || s.ExclusiveMaximum && v == *s.Maximum) {
+		return field.ErrorList{field.Invalid(path, v, fmt.Sprintf("must be at most %v", *s.Maximum))}
+	}
+	return nil
+}
+
+func preservesUnknownFields(s *apiextensionsv1beta1.JSONSchemaProps) bool {
+	return s.XPreserveUnknownFields != nil && *s.XPreserveUnknownFields ||
+		s.AdditionalProperties != nil && s.AdditionalProperties.Allows
+}
+
+// hasType tells whether the value, as decoded from JSON or YAML, is of the
+// OpenAPI type. Any value has the empty type.
+func hasType(t string, v interface{}) bool {
+	switch t {
+	case "object":
+		_, ok := v.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := v.([]interface{})
+		return ok
+	case "string":
+		_, ok := v.(string)
+		return ok
+	case "boolean":
+		_, ok := v.(bool)
+		return ok
+	case "integer":
+		switch n := v.(type) {
+		case int64:
+			return true
+		case float64:
+			return n == float64(int64(n))
+		}
+		return false
+	case "number":
+		switch v.(type) {
+		case int64, float64:
+			return true
+		}
+		return false
+	}
+	return true
+}
+
+func inEnum(enum []apiextensionsv1beta1.JSON, v interface{}) bool {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return false
+	}
+	for _, e := range enum {
+		if bytes.Equal(bytes.TrimSpace(e.Raw), data) {
+			return true
+		}
+	}
+	return false
+}
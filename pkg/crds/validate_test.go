@@ -0,0 +1,117 @@
+package crds
+
+import (
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+func TestValidate(t *testing.T) {
+	v, err := EmbeddedValidator()
+	if err != nil {
+		t.Fatalf("EmbeddedValidator() error = %v", err)
+	}
+	testCases := map[string]struct {
+		manifest string
+		// the invalid fields, if any
+		fields []string
+	}{
+		"Valid": {
+			manifest: `
+apiVersion: core.oam.dev/v1alpha2
+kind: VerticalScalerTrait
+metadata:
+  name: web
+spec:
+  updateMode: Initial
+  containerPolicies:
+  - containerName: web
+    maxAllowed:
+      cpu: "1"
+  workloadRef:
+    apiVersion: core.oam.dev/v1alpha2
+    kind: ContainerizedWorkload
+    name: web
+`,
+		},
+		"UnknownKind": {
+			manifest: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: web
+data:
+  anything: goes
+`,
+		},
+		"Misspelled": {
+			manifest: `
+apiVersion: core.oam.dev/v1alpha2
+kind: VerticalScalerTrait
+metadata:
+  name: web
+spec:
+  updateMod: Initial
+  workloadRef:
+    apiVersion: core.oam.dev/v1alpha2
+    kind: ContainerizedWorkload
+    name: web
+`,
+			fields: []string{"spec.updateMod"},
+		},
+		"Invalid": {
+			manifest: `
+apiVersion: core.oam.dev/v1alpha2
+kind: VerticalScalerTrait
+metadata:
+  name: web
+spec:
+  updateMode: Sometimes
+  containerPolicies:
+  - maxAllowed: 1
+  workloadRef:
+    kind: ContainerizedWorkload
+    name: web
+`,
+			fields: []string{
+				"spec.containerPolicies[0].containerName",
+				"spec.containerPolicies[0].maxAllowed",
+				"spec.updateMode",
+				"spec.workloadRef.apiVersion",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			obj := &unstructured.Unstructured{}
+			if err := yaml.Unmarshal([]byte(tc.manifest), &obj.Object); err != nil {
+				t.Fatal(err)
+			}
+			err := v.Validate(obj)
+			if len(tc.fields) == 0 {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+			status, ok := err.(apierrors.APIStatus)
+			if !ok || !apierrors.IsInvalid(err) {
+				t.Fatalf("Validate() error = %v, want an invalid error", err)
+			}
+			var fields []string
+			for _, c := range status.Status().Details.Causes {
+				fields = append(fields, c.Field)
+			}
+			if len(fields) != len(tc.fields) {
+				t.Fatalf("Validate() invalid fields = %v, want %v", fields, tc.fields)
+			}
+			for i := range fields {
+				if fields[i] != tc.fields[i] {
+					t.Errorf("Validate() invalid fields = %v, want %v", fields, tc.fields)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package debug records the outcome of reconciles and serves it over HTTP to
+// help find out why an object is stuck.
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// +kubebuilder:rbac:groups=core.oam.dev,resources=resourcetrackers,verbs=list;watch
+
+// Path the Handler is served at.
+const Path = "/debug/reconcilers"
+
+// the workqueue metric the queue depth of a controller is read from
+const metricQueueDepth = "workqueue_depth"
+
+// ObjectState is the outcome of the last reconcile of an object.
+type ObjectState struct {
+	Namespace     string        `json:"namespace,omitempty"`
+	Name          string        `json:"name"`
+	LastReconcile time.Time     `json:"lastReconcile"`
+	Duration      time.Duration `json:"duration"`
+	Requeue       bool          `json:"requeue,omitempty"`
+	RequeueAfter  time.Duration `json:"requeueAfter,omitempty"`
+	Error         string        `json:"error,omitempty"`
+	// Children are the resources tracked for the object.
+	Children []v1alpha2.TrackedResource `json:"children,omitempty"`
+}
+
+// ControllerState is the state of a controller and the objects it reconciled.
+type ControllerState struct {
+	Kind       string        `json:"kind"`
+	QueueDepth *float64      `json:"queueDepth,omitempty"`
+	Objects    []ObjectState `json:"objects"`
+}
+
+// A Recorder remembers the last reconcile of every object, by controller.
+type Recorder struct {
+	mu     sync.RWMutex
+	states map[string]map[types.NamespacedName]ObjectState
+}
+
+// NewRecorder returns an empty Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{states: make(map[string]map[types.NamespacedName]ObjectState)}
+}
+
+// Wrap returns a reconciler recording the reconciles of the controller of the
+// given kind. A nil Recorder returns the reconciler unchanged.
+func (r *Recorder) Wrap(kind string, rec reconcile.Reconciler) reconcile.Reconciler {
+	if r == nil {
+		return rec
+	}
+	r.mu.Lock()
+	if _, ok := r.states[kind]; !ok {
+		r.states[kind] = make(map[types.NamespacedName]ObjectState)
+	}
+	r.mu.Unlock()
+	return &recordingReconciler{kind: kind, recorder: r, reconciler: rec}
+}
+
+type recordingReconciler struct {
+	kind       string
+	recorder   *Recorder
+	reconciler reconcile.Reconciler
+}
+
+// Reconcile implements reconcile.Reconciler.
+func (rr *recordingReconciler) Reconcile(req reconcile.Request) (reconcile.Result, error) {
+	start := time.Now()
+	result, err := rr.reconciler.Reconcile(req)
+	state := ObjectState{
+		Namespace:     req.Namespace,
+		Name:          req.Name,
+		LastReconcile: start,
+		Duration:      time.Since(start),
+		Requeue:       result.Requeue,
+		RequeueAfter:  result.RequeueAfter,
+	}
+	if err != nil {
+		state.Error = err.Error()
+	}
+	rr.recorder.mu.Lock()
+	rr.recorder.states[rr.kind][req.NamespacedName] = state
+	rr.recorder.mu.Unlock()
+	return result, err
+}
+
+// snapshot returns the recorded states sorted by kind and object.
+func (r *Recorder) snapshot() []ControllerState {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	controllers := make([]ControllerState, 0, len(r.states))
+	for kind, objects := range r.states {
+		cs := ControllerState{Kind: kind, Objects: make([]ObjectState, 0, len(objects))}
+		for _, o := range objects {
+			cs.Objects = append(cs.Objects, o)
+		}
+		sort.Slice(cs.Objects, func(i, j int) bool {
+			a, b := cs.Objects[i], cs.Objects[j]
+			return a.Namespace < b.Namespace || a.Namespace == b.Namespace && a.Name < b.Name
+		})
+		controllers = append(controllers, cs)
+	}
+	sort.Slice(controllers, func(i, j int) bool { return controllers[i].Kind < controllers[j].Kind })
+	return controllers
+}
+
+// A Handler serves the state recorded by a Recorder as JSON.
+type Handler struct {
+	Recorder *Recorder
+	// Client, if set, is used to add the resources tracked for each object.
+	Client client.Reader
+	// Gatherer, if set, is used to add the workqueue depth of each controller.
+	Gatherer prometheus.Gatherer
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	controllers := h.Recorder.snapshot()
+	depths := h.queueDepths()
+	children, err := h.trackedChildren(req.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	for i := range controllers {
+		c := &controllers[i]
+		// controllers are named after their lower case kind
+		if d, ok := depths[strings.ToLower(c.Kind)]; ok {
+			c.QueueDepth = &d
+		}
+		for j := range c.Objects {
+			o := &c.Objects[j]
+			o.Children = children[trackedKey{Kind: c.Kind, Namespace: o.Namespace, Name: o.Name}]
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(controllers)
+}
+
+// the workqueue depths by controller name
+func (h *Handler) queueDepths() map[string]float64 {
+	depths := make(map[string]float64)
+	if h.Gatherer == nil {
+		return depths
+	}
+	families, err := h.Gatherer.Gather()
+	if err != nil {
+		return depths
+	}
+	for _, f := range families {
+		if f.GetName() != metricQueueDepth {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "name" {
+					depths[l.GetValue()] = m.GetGauge().GetValue()
+				}
+			}
+		}
+	}
+	return depths
+}
+
+type trackedKey struct {
+	Kind, Namespace, Name string
+}
+
+// the tracked resources by owner
+func (h *Handler) trackedChildren(ctx context.Context) (map[trackedKey][]v1alpha2.TrackedResource, error) {
+	children := make(map[trackedKey][]v1alpha2.TrackedResource)
+	if h.Client == nil {
+		return children, nil
+	}
+	var trackers v1alpha2.ResourceTrackerList
+	if err := h.Client.List(ctx, &trackers); err != nil {
+		return nil, err
+	}
+	for _, t := range trackers.Items {
+		owner := t.Spec.Owner
+		k := trackedKey{Kind: owner.Kind, Namespace: owner.Namespace, Name: owner.Name}
+		children[k] = t.Spec.Resources
+	}
+	return children, nil
+}
@@ -0,0 +1,68 @@
+package debug
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+type reconcileFunc func(reconcile.Request) (reconcile.Result, error)
+
+func (f reconcileFunc) Reconcile(req reconcile.Request) (reconcile.Result, error) { return f(req) }
+
+func TestHandler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := v1alpha2.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	children := []v1alpha2.TrackedResource{{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default",
+		Name: "web-deployment"}}
+	tracker := &v1alpha2.ResourceTracker{
+		ObjectMeta: metav1.ObjectMeta{Name: "uid"},
+		Spec: v1alpha2.ResourceTrackerSpec{
+			Owner:     v1alpha2.TrackedResource{Kind: "ContainerizedWorkload", Namespace: "default", Name: "web"},
+			Resources: children,
+		},
+	}
+
+	recorder := NewRecorder()
+	rec := recorder.Wrap("ContainerizedWorkload", reconcileFunc(func(req reconcile.Request) (reconcile.Result, error) {
+		if req.Name == "broken" {
+			return reconcile.Result{RequeueAfter: time.Minute}, errors.New("boom")
+		}
+		return reconcile.Result{}, nil
+	}))
+	for _, name := range []string{"web", "broken"} {
+		_, _ = rec.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: name}})
+	}
+
+	h := &Handler{Recorder: recorder, Client: fake.NewFakeClientWithScheme(scheme, tracker)}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", Path, nil))
+
+	var got []ControllerState
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatalf("cannot decode %q: %v", w.Body.String(), err)
+	}
+	if len(got) != 1 || got[0].Kind != "ContainerizedWorkload" || len(got[0].Objects) != 2 {
+		t.Fatalf("ServeHTTP() = %+v, want the two ContainerizedWorkloads", got)
+	}
+	broken, web := got[0].Objects[0], got[0].Objects[1]
+	if broken.Error != "boom" || broken.RequeueAfter != time.Minute || broken.Children != nil {
+		t.Errorf("ServeHTTP() broken = %+v, want its error and requeue", broken)
+	}
+	if web.Error != "" || !reflect.DeepEqual(web.Children, children) {
+		t.Errorf("ServeHTTP() web = %+v, want its children %v", web, children)
+	}
+}
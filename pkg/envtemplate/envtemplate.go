@@ -0,0 +1,165 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package envtemplate renders the environment variable values of a workload's
+// containers that are Go templates, e.g. "{{ .AppConfig.Name }}", so that a
+// component can identify itself without manual wiring. A value that is only
+// a secret reference, e.g. `{{ secret "db" "password" }}`, is not rendered
+// but read from the key of the Secret when the container starts, so that no
+// plaintext is kept in the workload or its deployment.
+package envtemplate
+
+import (
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Labels the application configuration controller sets on the workloads it
+// renders, read into the AppConfig and Component of a template's Data.
+const (
+	LabelAppName           = "app.oam.dev/name"
+	LabelComponent         = "app.oam.dev/component"
+	LabelComponentRevision = "app.oam.dev/revision"
+)
+
+// Error strings.
+const (
+	errParseTemplate = "cannot parse the template"
+	errSecretValue   = "a secret reference must be the whole value"
+)
+
+// funcs templates may call. A secret is never rendered, it is only valid as
+// the whole value, see SecretReference.
+var funcs = template.FuncMap{
+	"secret": func(name, key string) (string, error) {
+		return "", errors.New(errSecretValue)
+	},
+}
+
+// Data templates are rendered with.
+type Data struct {
+	AppConfig AppConfig
+	Component Component
+	Workload  Workload
+}
+
+// AppConfig is the application configuration the workload is part of. Its
+// fields are empty for workloads created on their own.
+type AppConfig struct {
+	Name string
+}
+
+// Component is the component the workload was rendered from. Its fields are
+// empty for workloads created on their own.
+type Component struct {
+	Name     string
+	Revision string
+}
+
+// Workload is the workload itself.
+type Workload struct {
+	Name        string
+	Namespace   string
+	UID         string
+	Generation  string
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+// ForObject returns the Data of a workload.
+func ForObject(o metav1.Object) Data {
+	labels := o.GetLabels()
+	return Data{
+		AppConfig: AppConfig{Name: labels[LabelAppName]},
+		Component: Component{Name: labels[LabelComponent], Revision: labels[LabelComponentRevision]},
+		Workload: Workload{
+			Name:        o.GetName(),
+			Namespace:   o.GetNamespace(),
+			UID:         string(o.GetUID()),
+			Generation:  strconv.FormatInt(o.GetGeneration(), 10),
+			Labels:      labels,
+			Annotations: o.GetAnnotations(),
+		},
+	}
+}
+
+// IsTemplate tells whether the value is rendered. Values without an action
+// are used as they are.
+func IsTemplate(value string) bool {
+	return strings.Contains(value, "{{")
+}
+
+// Render returns the value rendered with the data. Missing labels and
+// annotations render as empty strings.
+func Render(value string, data Data) (string, error) {
+	t, err := template.New("env").Option("missingkey=zero").Funcs(funcs).Parse(value)
+	if err != nil {
+		return "", errors.Wrap(err, errParseTemplate)
+	}
+	var b strings.Builder
+	if err := t.Execute(&b, data); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// Validate returns an error if the value is not a template that can be
+// rendered, e.g. because it refers to a field Data does not have, or a secret
+// reference.
+func Validate(value string) error {
+	if _, ok := SecretReference(value); ok {
+		return nil
+	}
+	_, err := Render(value, Data{})
+	return err
+}
+
+// SecretReference returns the key of a Secret the value refers to if the
+// value is only a `{{ secret "<name>" "<key>" }}` action.
+func SecretReference(value string) (*corev1.SecretKeySelector, bool) {
+	t, err := template.New("env").Funcs(funcs).Parse(value)
+	if err != nil || t.Tree == nil || len(t.Tree.Root.Nodes) != 1 {
+		return nil, false
+	}
+	action, ok := t.Tree.Root.Nodes[0].(*parse.ActionNode)
+	if !ok || len(action.Pipe.Decl) != 0 || len(action.Pipe.Cmds) != 1 {
+		return nil, false
+	}
+	args := action.Pipe.Cmds[0].Args
+	if len(args) != 3 {
+		return nil, false
+	}
+	if fn, ok := args[0].(*parse.IdentifierNode); !ok || fn.Ident != "secret" {
+		return nil, false
+	}
+	name, ok := args[1].(*parse.StringNode)
+	if !ok || name.Text == "" {
+		return nil, false
+	}
+	key, ok := args[2].(*parse.StringNode)
+	if !ok || key.Text == "" {
+		return nil, false
+	}
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: name.Text},
+		Key:                  key.Text,
+	}, true
+}
@@ -0,0 +1,99 @@
+package envtemplate
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRender(t *testing.T) {
+	data := ForObject(&metav1.ObjectMeta{
+		Name:       "web",
+		Namespace:  "shop",
+		Generation: 3,
+		Labels:     map[string]string{LabelAppName: "storefront", LabelComponentRevision: "web-v2", "tier": "frontend"},
+	})
+	testCases := map[string]struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		"AppConfig": {
+			value: "{{ .AppConfig.Name }}",
+			want:  "storefront",
+		},
+		"Mixed": {
+			value: "{{ .Component.Revision }}@{{ .Workload.Namespace }}/{{ .Workload.Name }}#{{ .Workload.Generation }}",
+			want:  "web-v2@shop/web#3",
+		},
+		"Label": {
+			value: `{{ index .Workload.Labels "tier" }}`,
+			want:  "frontend",
+		},
+		"MissingLabel": {
+			value: "{{ .Workload.Labels.zone }}",
+			want:  "",
+		},
+		"UnknownField": {
+			value:   "{{ .Workload.Image }}",
+			wantErr: true,
+		},
+		"Unparsable": {
+			value:   "{{ .Workload.Name",
+			wantErr: true,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Render(testCase.value, data)
+			if (err != nil) != testCase.wantErr {
+				t.Fatalf("Render() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+			if got != testCase.want {
+				t.Errorf("Render() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestSecretReference(t *testing.T) {
+	testCases := map[string]struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		"Secret": {
+			value: `{{ secret "db" "password" }}`,
+			want:  "db/password",
+		},
+		"Trimmed": {
+			value: `{{- secret "db" "password" -}}`,
+			want:  "db/password",
+		},
+		"Embedded": {
+			value:   `postgres://app:{{ secret "db" "password" }}@db`,
+			wantErr: true,
+		},
+		"NoKey": {
+			value:   `{{ secret "db" }}`,
+			wantErr: true,
+		},
+		"Field": {
+			value: "{{ .Workload.Name }}",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := ""
+			if sel, ok := SecretReference(testCase.value); ok {
+				got = sel.Name + "/" + sel.Key
+			}
+			if got != testCase.want {
+				t.Errorf("SecretReference() = %q, want %q", got, testCase.want)
+			}
+			if err := Validate(testCase.value); (err != nil) != testCase.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package helm renders Helm charts with the helm command line tool, so that
+// their manifests can be applied like any other children.
+package helm
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/oam-dev/core-resource-controller/pkg/bundle"
+)
+
+// Error strings.
+const (
+	errWriteValues = "cannot write the values of the chart"
+	errTemplate    = "cannot render the chart"
+	errParse       = "cannot parse the manifests of the chart"
+)
+
+// AnnotationHook marks the manifests of a chart that are hooks.
+const AnnotationHook = "helm.sh/hook"
+
+// A Chart to render.
+type Chart struct {
+	// Release name the chart is rendered for.
+	Release string
+	// Chart is the name of a chart of Repo or the URL of a chart archive.
+	Chart string
+	// Repo is the URL of the chart repository.
+	Repo string
+	// Version of the chart, the latest one if empty.
+	Version string
+	// Namespace the chart is rendered for.
+	Namespace string
+	// Values the chart is rendered with.
+	Values map[string]interface{}
+}
+
+// A Renderer renders the manifests of charts.
+type Renderer interface {
+	Render(ctx context.Context, c Chart) ([]*unstructured.Unstructured, error)
+}
+
+// Template renders charts with helm template. Test hooks are left out, other
+// hooks are returned along with the manifests of the chart.
+type Template struct {
+	// Binary of helm, looked up in the PATH if it has no slash. Defaults to
+	// helm.
+	Binary string
+}
+
+// Render downloads the chart, unless helm has cached it, and returns the
+// manifests it renders.
+func (t *Template) Render(ctx context.Context, c Chart) ([]*unstructured.Unstructured, error) {
+	values, err := yaml.Marshal(c.Values)
+	if err != nil {
+		return nil, errors.Wrap(err, errWriteValues)
+	}
+	f, err := ioutil.TempFile("", "values-*.yaml")
+	if err != nil {
+		return nil, errors.Wrap(err, errWriteValues)
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(values)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errWriteValues)
+	}
+
+	args := []string{"template", c.Release, c.Chart, "--namespace", c.Namespace, "--values", f.Name()}
+	if c.Repo != "" {
+		args = append(args, "--repo", c.Repo)
+	}
+	if c.Version != "" {
+		args = append(args, "--version", c.Version)
+	}
+	binary := t.Binary
+	if binary == "" {
+		binary = "helm"
+	}
+	cmd := exec.CommandContext(ctx, binary, args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, errors.Wrapf(err, "%s: %s", errTemplate, msg)
+		}
+		return nil, errors.Wrap(err, errTemplate)
+	}
+
+	objs, err := bundle.Parse(out)
+	if err != nil {
+		return nil, errors.Wrap(err, errParse)
+	}
+	manifests := objs[:0]
+	for _, obj := range objs {
+		if isTestHook(obj) {
+			continue
+		}
+		manifests = append(manifests, obj)
+	}
+	return manifests, nil
+}
+
+// test hooks are only run by helm test
+func isTestHook(obj *unstructured.Unstructured) bool {
+	for _, h := range strings.Split(obj.GetAnnotations()[AnnotationHook], ",") {
+		if strings.HasPrefix(strings.TrimSpace(h), "test") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package helm
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// a helm that renders its arguments and values into a config map, along with
+// a test hook
+const fakeHelm = `#!/bin/sh
+[ "$3" = missing ] && { echo "Error: chart \"missing\" not found" >&2; exit 1; }
+cat <<YAML
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: $2
+data:
+  args: "$*"
+  values: |
+$(sed 's/^/    /' "$7")
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: $2-test
+  annotations:
+    helm.sh/hook: test
+YAML
+`
+
+func TestTemplate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "helm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	binary := filepath.Join(dir, "helm")
+	if err := ioutil.WriteFile(binary, []byte(fakeHelm), 0755); err != nil {
+		t.Fatal(err)
+	}
+	h := &Template{Binary: binary}
+
+	objs, err := h.Render(context.Background(), Chart{Release: "cache", Chart: "redis",
+		Repo: "https://charts.bitnami.com/bitnami", Version: "10.5.7", Namespace: "default",
+		Values: map[string]interface{}{"cluster": map[string]interface{}{"enabled": false}}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if len(objs) != 1 || objs[0].GetKind() != "ConfigMap" {
+		t.Fatalf("Render() = %v, want the config map without the test hook", objs)
+	}
+	data := objs[0].Object["data"].(map[string]interface{})
+	args := data["args"].(string)
+	for _, want := range []string{"template cache redis --namespace default --values ",
+		" --repo https://charts.bitnami.com/bitnami --version 10.5.7"} {
+		if !strings.Contains(args, want) {
+			t.Errorf("Render() ran helm %s, want %q", args, want)
+		}
+	}
+	if want := "cluster:\n  enabled: false\n"; data["values"] != want {
+		t.Errorf("Render() values = %q, want %q", data["values"], want)
+	}
+
+	_, err = h.Render(context.Background(), Chart{Release: "cache", Chart: "missing", Namespace: "default"})
+	if err == nil || !strings.Contains(err.Error(), `chart "missing" not found`) {
+		t.Errorf("Render() error = %v, want the error helm reports", err)
+	}
+}
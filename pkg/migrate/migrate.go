@@ -0,0 +1,180 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package migrate converts ManualScalerTraits into KEDAScalerTraits so that
+// workloads scaled by hand can adopt autoscaling.
+package migrate
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// Error strings.
+const (
+	errParse   = "cannot parse the manual scaler traits"
+	errMigrate = "cannot migrate manual scaler trait"
+)
+
+const (
+	kindList              = "List"
+	kindManualScalerTrait = "ManualScalerTrait"
+	kindKEDAScalerTrait   = "KEDAScalerTrait"
+)
+
+// ManualScalerTraits returns a KEDAScalerTrait for each ManualScalerTrait in
+// data, a YAML stream of traits or of lists of traits such as the output of
+// kubectl get manualscalertraits -o yaml. Each KEDAScalerTrait is named after
+// the trait it replaces and scales the same workload on the CPU utilization
+// of its pods, between the replicas the trait currently runs as both its
+// minimum and maximum. The bounds can then be widened one app at a time.
+// The ManualScalerTraits must be deleted before the KEDAScalerTraits are
+// applied, see DeleteCommands.
+func ManualScalerTraits(data []byte, cpuUtilization int32) ([]runtime.Object, error) {
+	traits, err := parse(data)
+	if err != nil {
+		return nil, errors.Wrap(err, errParse)
+	}
+	objs := make([]runtime.Object, 0, len(traits))
+	for i := range traits {
+		t, err := migrate(&traits[i], cpuUtilization)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s %s/%s", errMigrate, traits[i].Namespace, traits[i].Name)
+		}
+		objs = append(objs, t)
+	}
+	return objs, nil
+}
+
+// DeleteCommands returns the kubectl commands deleting the ManualScalerTraits
+// the KEDAScalerTraits returned by ManualScalerTraits replace, one per
+// namespace. Both traits would otherwise scale the same deployment and undo
+// each other's changes. Deleting a ManualScalerTrait leaves the replicas of
+// its workload as they are, so the commands are run first.
+func DeleteCommands(objs []runtime.Object) []string {
+	names := map[string][]string{}
+	for _, o := range objs {
+		t, ok := o.(*v1alpha2.KEDAScalerTrait)
+		if !ok {
+			continue
+		}
+		names[t.Namespace] = append(names[t.Namespace], t.Name)
+	}
+	namespaces := make([]string, 0, len(names))
+	for ns := range names {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	var cmds []string
+	for _, ns := range namespaces {
+		cmd := "kubectl delete manualscalertraits.core.oam.dev"
+		if ns != "" {
+			cmd += " --namespace=" + ns
+		}
+		cmds = append(cmds, cmd+" "+strings.Join(names[ns], " "))
+	}
+	return cmds
+}
+
+func parse(data []byte) ([]v1alpha2.ManualScalerTrait, error) {
+	r := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	var traits []v1alpha2.ManualScalerTrait
+	for {
+		doc, err := r.Read()
+		if err == io.EOF {
+			return traits, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		j, err := yaml.YAMLToJSON(doc)
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(j)) == 0 || string(j) == "null" {
+			continue
+		}
+		var obj struct {
+			metav1.TypeMeta
+			Items []json.RawMessage `json:"items"`
+		}
+		if err := json.Unmarshal(j, &obj); err != nil {
+			return nil, err
+		}
+		items := []json.RawMessage{j}
+		if obj.Kind == kindList || obj.Kind == kindManualScalerTrait+kindList {
+			items = obj.Items
+		}
+		for _, item := range items {
+			var t v1alpha2.ManualScalerTrait
+			if err := json.Unmarshal(item, &t); err != nil {
+				return nil, err
+			}
+			if t.Kind != kindManualScalerTrait {
+				return nil, errors.Errorf("%s %s is not a %s", t.Kind, t.Name, kindManualScalerTrait)
+			}
+			traits = append(traits, t)
+		}
+	}
+}
+
+func migrate(t *v1alpha2.ManualScalerTrait, cpuUtilization int32) (*v1alpha2.KEDAScalerTrait, error) {
+	if len(t.Spec.ZoneReplicas) > 0 {
+		return nil, errors.New("zone replicas cannot be autoscaled")
+	}
+	// the replicas the workload runs now, which may differ from those asked
+	// for, e.g. while the trait is scaling in steps
+	replicas := t.Spec.ReplicaCount
+	if t.Status.ObservedReplicas != nil {
+		replicas = *t.Status.ObservedReplicas
+	}
+	if replicas < 1 {
+		return nil, errors.New("workloads scaled to zero cannot be autoscaled")
+	}
+	minReplicas, maxReplicas := replicas, replicas
+	return &v1alpha2.KEDAScalerTrait{
+		TypeMeta: metav1.TypeMeta{APIVersion: v1alpha2.GroupVersion.String(), Kind: kindKEDAScalerTrait},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: t.Namespace,
+			Name:      t.Name,
+			Labels:    t.Labels,
+		},
+		Spec: v1alpha2.KEDAScalerTraitSpec{
+			MinReplicaCount: &minReplicas,
+			MaxReplicaCount: &maxReplicas,
+			Triggers: []v1alpha2.KEDATrigger{{
+				Type: "cpu",
+				Metadata: map[string]string{
+					"type":  "Utilization",
+					"value": strconv.Itoa(int(cpuUtilization)),
+				},
+			}},
+			WorkloadReference: t.Spec.WorkloadReference,
+		},
+	}, nil
+}
@@ -0,0 +1,90 @@
+package migrate
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestManualScalerTraits(t *testing.T) {
+	testCases := map[string]struct {
+		data         string
+		wantReplicas []int32
+		wantDeletes  []string
+		wantErr      bool
+	}{
+		"List": {
+			data: `
+apiVersion: v1
+kind: List
+items:
+- apiVersion: core.oam.dev/v1alpha2
+  kind: ManualScalerTrait
+  metadata: {name: web, namespace: shop}
+  spec: {replicaCount: 3, workloadRef: {name: web}}
+  status: {observedReplicas: 2}
+- apiVersion: core.oam.dev/v1alpha2
+  kind: ManualScalerTrait
+  metadata: {name: api, namespace: shop}
+  spec: {replicaCount: 4, workloadRef: {name: api}}
+`,
+			wantReplicas: []int32{2, 4},
+			wantDeletes:  []string{"kubectl delete manualscalertraits.core.oam.dev --namespace=shop web api"},
+		},
+		"Stream": {
+			data: `
+apiVersion: core.oam.dev/v1alpha2
+kind: ManualScalerTrait
+metadata: {name: web}
+spec: {replicaCount: 3, workloadRef: {name: web}}
+---
+`,
+			wantReplicas: []int32{3},
+			wantDeletes:  []string{"kubectl delete manualscalertraits.core.oam.dev web"},
+		},
+		"ZoneReplicas": {
+			data: `
+apiVersion: core.oam.dev/v1alpha2
+kind: ManualScalerTrait
+metadata: {name: web}
+spec: {zoneReplicas: {eu-west-1a: 2}, workloadRef: {name: web}}
+`,
+			wantErr: true,
+		},
+		"OtherKind": {
+			data: `
+apiVersion: core.oam.dev/v1alpha2
+kind: PatchTrait
+metadata: {name: web}
+`,
+			wantErr: true,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			objs, err := ManualScalerTraits([]byte(testCase.data), 70)
+			if (err != nil) != testCase.wantErr {
+				t.Fatalf("ManualScalerTraits() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+			if len(objs) != len(testCase.wantReplicas) {
+				t.Fatalf("ManualScalerTraits() = %d traits, want %d", len(objs), len(testCase.wantReplicas))
+			}
+			for i, o := range objs {
+				k := o.(*v1alpha2.KEDAScalerTrait)
+				want := testCase.wantReplicas[i]
+				if *k.Spec.MinReplicaCount != want || *k.Spec.MaxReplicaCount != want {
+					t.Errorf("trait %s scales between %d and %d, want %d", k.Name, *k.Spec.MinReplicaCount,
+						*k.Spec.MaxReplicaCount, want)
+				}
+				if k.Spec.Triggers[0].Metadata["value"] != "70" {
+					t.Errorf("trait %s triggers = %v", k.Name, k.Spec.Triggers)
+				}
+			}
+			// the replaced traits are deleted, or both scale the workload
+			if got := DeleteCommands(objs); !reflect.DeepEqual(got, testCase.wantDeletes) {
+				t.Errorf("DeleteCommands() = %q, want %q", got, testCase.wantDeletes)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package policy lets an external policy engine approve scaling changes
+// before traits apply them.
+package policy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Error strings.
+const (
+	errEncodeRequest = "cannot encode the scale request"
+	errQueryPolicy   = "cannot query the policy endpoint"
+	errDecodeResult  = "cannot decode the policy decision"
+)
+
+// A ScaleRequest describes a scaling change a trait is about to apply.
+type ScaleRequest struct {
+	// Namespace of the trait and its workload.
+	Namespace string `json:"namespace"`
+	// Kind of the trait requesting the change.
+	Kind string `json:"kind"`
+	// Name of the trait requesting the change.
+	Name string `json:"name"`
+	// Workload that is scaled.
+	Workload string `json:"workload"`
+	// Replicas the workload is scaled to.
+	Replicas int32 `json:"replicas"`
+}
+
+// A Decision is the answer of a policy engine to a ScaleRequest.
+type Decision struct {
+	// Allowed is true if the change may be applied.
+	Allowed bool `json:"allowed"`
+	// Reason explains why the change was denied.
+	Reason string `json:"reason,omitempty"`
+}
+
+// A ScaleChecker approves scaling changes. It returns an error if the
+// decision could not be made.
+type ScaleChecker interface {
+	CheckScale(ctx context.Context, req ScaleRequest) (Decision, error)
+}
+
+// A DeniedError is returned to explain why a scaling change was not applied.
+type DeniedError struct {
+	Request ScaleRequest
+	Reason  string
+}
+
+func (e *DeniedError) Error() string {
+	return fmt.Sprintf("scaling %s to %d replicas is denied by policy: %s", e.Request.Workload,
+		e.Request.Replicas, e.Reason)
+}
+
+// Check asks c about req and returns a DeniedError if the change is not
+// allowed. A nil ScaleChecker allows every change.
+func Check(ctx context.Context, c ScaleChecker, req ScaleRequest) error {
+	if c == nil {
+		return nil
+	}
+	d, err := c.CheckScale(ctx, req)
+	if err != nil {
+		return err
+	}
+	if !d.Allowed {
+		return &DeniedError{Request: req, Reason: d.Reason}
+	}
+	return nil
+}
+
+// An OPAChecker queries a policy through the Open Policy Agent data API. The
+// request is posted as the policy input and the policy's result must be a
+// Decision, e.g. http://opa.opa-system:8181/v1/data/oam/scale.
+type OPAChecker struct {
+	URL    string
+	Client *http.Client
+}
+
+// NewOPAChecker returns an OPAChecker querying url.
+func NewOPAChecker(url string) *OPAChecker {
+	return &OPAChecker{URL: url, Client: &http.Client{Timeout: 5 * time.Second}}
+}
+
+// CheckScale posts req to the OPA data API.
+func (o *OPAChecker) CheckScale(ctx context.Context, req ScaleRequest) (Decision, error) {
+	body, err := json.Marshal(struct {
+		Input ScaleRequest `json:"input"`
+	}{Input: req})
+	if err != nil {
+		return Decision{}, errors.Wrap(err, errEncodeRequest)
+	}
+	hr, err := http.NewRequest(http.MethodPost, o.URL, bytes.NewReader(body))
+	if err != nil {
+		return Decision{}, errors.Wrap(err, errQueryPolicy)
+	}
+	hr.Header.Set("Content-Type", "application/json")
+	resp, err := o.Client.Do(hr.WithContext(ctx))
+	if err != nil {
+		return Decision{}, errors.Wrap(err, errQueryPolicy)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return Decision{}, errors.Errorf("%s: unexpected status %s", errQueryPolicy, resp.Status)
+	}
+
+	var result struct {
+		Result *Decision `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return Decision{}, errors.Wrap(err, errDecodeResult)
+	}
+	// OPA omits the result if the policy is not defined
+	if result.Result == nil {
+		return Decision{}, errors.Errorf("%s: policy is not defined", errDecodeResult)
+	}
+	return *result.Result, nil
+}
@@ -0,0 +1,63 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	req := ScaleRequest{Namespace: "dev", Kind: "ManualScalerTrait", Name: "scaler", Workload: "web", Replicas: 60}
+
+	testCases := map[string]struct {
+		response string
+		status   int
+		wantErr  bool
+		denied   bool
+	}{
+		"Allowed": {
+			response: `{"result": {"allowed": true}}`,
+			status:   http.StatusOK,
+		},
+		"Denied": {
+			response: `{"result": {"allowed": false, "reason": "no more than 50 replicas in dev"}}`,
+			status:   http.StatusOK,
+			wantErr:  true,
+			denied:   true,
+		},
+		"Undefined": {
+			response: `{}`,
+			status:   http.StatusOK,
+			wantErr:  true,
+		},
+		"ServerError": {
+			status:  http.StatusInternalServerError,
+			wantErr: true,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var in struct {
+					Input ScaleRequest `json:"input"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&in); err != nil || in.Input != req {
+					t.Errorf("policy input = %v, %v, want %v", in.Input, err, req)
+				}
+				w.WriteHeader(testCase.status)
+				_, _ = w.Write([]byte(testCase.response))
+			}))
+			defer srv.Close()
+
+			err := Check(context.Background(), NewOPAChecker(srv.URL), req)
+			if (err != nil) != testCase.wantErr {
+				t.Fatalf("Check() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+			if _, ok := err.(*DeniedError); ok != testCase.denied {
+				t.Errorf("Check() error = %v, denied %v", err, testCase.denied)
+			}
+		})
+	}
+}
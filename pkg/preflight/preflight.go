@@ -0,0 +1,238 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package preflight checks that a cluster has what the manager needs before
+// it starts its controllers: the APIs it uses, the CRDs it serves, admission
+// webhooks, the permissions of its service account and a default
+// StorageClass.
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+	"github.com/oam-dev/core-resource-controller/pkg/capabilities"
+)
+
+// A Status of a check.
+type Status string
+
+// Statuses of a check.
+const (
+	// StatusPass means the cluster has what was checked for.
+	StatusPass Status = "pass"
+	// StatusWarn means the manager can start, but some features do not work.
+	StatusWarn Status = "warn"
+	// StatusFail means the manager cannot work in the cluster.
+	StatusFail Status = "fail"
+)
+
+// A Check of the cluster and its outcome.
+type Check struct {
+	Name    string `json:"name"`
+	Status  Status `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// A Report lists the checks made, in order.
+type Report struct {
+	Checks []Check `json:"checks"`
+}
+
+// Failed returns true if any check failed.
+func (r *Report) Failed() bool {
+	for _, c := range r.Checks {
+		if c.Status == StatusFail {
+			return true
+		}
+	}
+	return false
+}
+
+// RequiredAPIs are the APIs the manager cannot work without.
+var RequiredAPIs = []capabilities.Capability{
+	{Name: "deployments", GroupVersion: "apps/v1", Kind: "Deployment"},
+	{Name: "services", GroupVersion: "v1", Kind: "Service"},
+	{Name: "pods", GroupVersion: "v1", Kind: "Pod"},
+	{Name: "events", GroupVersion: "v1", Kind: "Event"},
+}
+
+// WebhookAPIs are the admission APIs the manager's webhooks are registered
+// with.
+var WebhookAPIs = []capabilities.Capability{
+	{Name: "mutating-webhooks", GroupVersion: "admissionregistration.k8s.io/v1beta1", Kind: "MutatingWebhookConfiguration"},
+	{Name: "validating-webhooks", GroupVersion: "admissionregistration.k8s.io/v1beta1", Kind: "ValidatingWebhookConfiguration"},
+}
+
+// RequiredPermissions are the permissions the manager needs besides those on
+// the kinds it reconciles.
+var RequiredPermissions = []authorizationv1.ResourceAttributes{
+	{Group: "apps", Resource: "deployments", Verb: "watch"},
+	{Group: "apps", Resource: "deployments", Verb: "create"},
+	{Group: "apps", Resource: "deployments", Verb: "patch"},
+	{Group: "apps", Resource: "deployments", Verb: "delete"},
+	{Resource: "services", Verb: "watch"},
+	{Resource: "services", Verb: "create"},
+	{Resource: "services", Verb: "patch"},
+	{Resource: "pods", Verb: "list"},
+	{Resource: "nodes", Verb: "list"},
+	{Resource: "events", Verb: "create"},
+	{Resource: "configmaps", Verb: "create"},
+	{Group: "core.oam.dev", Resource: "resourcetrackers", Verb: "create"},
+	{Group: "core.oam.dev", Resource: "resourcetrackers", Verb: "delete"},
+}
+
+// Annotations marking the default StorageClass.
+const (
+	annotationDefaultStorageClass     = "storageclass.kubernetes.io/is-default-class"
+	annotationBetaDefaultStorageClass = "storageclass.beta.kubernetes.io/is-default-class"
+)
+
+// A Checker checks a cluster.
+type Checker struct {
+	Discovery discovery.ServerResourcesInterface
+	Client    client.Client
+
+	// Kinds of core.oam.dev/v1alpha2 whose CRDs must be installed.
+	Kinds []string
+
+	// Reconciled kinds of core.oam.dev/v1alpha2, whose objects and status
+	// the manager must be able to watch and update.
+	Reconciled []string
+
+	// Optional capabilities, reported as warnings when absent.
+	Optional []capabilities.Capability
+}
+
+// Run checks the cluster. A check that cannot be made is reported as failed.
+func (c *Checker) Run(ctx context.Context) *Report {
+	r := &Report{}
+	r.Checks = append(r.Checks, c.apis("api", RequiredAPIs, StatusFail)...)
+	r.Checks = append(r.Checks, c.apis("crd", c.crds(), StatusFail)...)
+	r.Checks = append(r.Checks, c.apis("webhook", WebhookAPIs, StatusFail)...)
+	r.Checks = append(r.Checks, c.permissions(ctx))
+	r.Checks = append(r.Checks, c.defaultStorageClass(ctx))
+	r.Checks = append(r.Checks, c.apis("optional", c.Optional, StatusWarn)...)
+	return r
+}
+
+func (c *Checker) crds() []capabilities.Capability {
+	caps := make([]capabilities.Capability, 0, len(c.Kinds))
+	for _, kind := range c.Kinds {
+		caps = append(caps, capabilities.Capability{
+			Name:         strings.ToLower(kind),
+			GroupVersion: v1alpha2.GroupVersion.String(),
+			Kind:         kind,
+		})
+	}
+	return caps
+}
+
+// apis checks that the APIs are served, reporting those that are not with
+// the status given.
+func (c *Checker) apis(prefix string, caps []capabilities.Capability, absent Status) []Check {
+	found := capabilities.Detect(c.Discovery, caps)
+	checks := make([]Check, 0, len(caps))
+	for _, capability := range caps {
+		check := Check{Name: prefix + "/" + capability.Name, Status: StatusPass}
+		if !found.Has(capability) {
+			check.Status = absent
+			check.Message = fmt.Sprintf("%s %s is not served", capability.GroupVersion, capability.Kind)
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// permissions reviews the permissions of the manager's service account on
+// the kinds it reconciles and the resources it manages.
+func (c *Checker) permissions(ctx context.Context) Check {
+	attrs := append([]authorizationv1.ResourceAttributes(nil), RequiredPermissions...)
+	for _, kind := range c.Reconciled {
+		resource := strings.ToLower(kind) + "s"
+		for _, verb := range []string{"watch", "update"} {
+			attrs = append(attrs, authorizationv1.ResourceAttributes{
+				Group: v1alpha2.GroupVersion.Group, Resource: resource, Verb: verb,
+			})
+		}
+		attrs = append(attrs, authorizationv1.ResourceAttributes{
+			Group: v1alpha2.GroupVersion.Group, Resource: resource, Subresource: "status", Verb: "update",
+		})
+	}
+
+	var denied []string
+	for i := range attrs {
+		review := &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &attrs[i]},
+		}
+		if err := c.Client.Create(ctx, review); err != nil {
+			return Check{Name: "rbac", Status: StatusFail, Message: "cannot review permissions: " + err.Error()}
+		}
+		if !review.Status.Allowed {
+			denied = append(denied, describe(attrs[i]))
+		}
+	}
+	if len(denied) > 0 {
+		return Check{Name: "rbac", Status: StatusFail, Message: "cannot " + strings.Join(denied, ", ")}
+	}
+	return Check{Name: "rbac", Status: StatusPass}
+}
+
+// describe returns e.g. "update containerizedworkloads/status.core.oam.dev"
+func describe(a authorizationv1.ResourceAttributes) string {
+	resource := a.Resource
+	if a.Subresource != "" {
+		resource += "/" + a.Subresource
+	}
+	if a.Group != "" {
+		resource += "." + a.Group
+	}
+	return a.Verb + " " + resource
+}
+
+// defaultStorageClass checks for a default StorageClass, which claims the
+// workloads refer to without a storageClassName need to be bound.
+func (c *Checker) defaultStorageClass(ctx context.Context) Check {
+	const name = "storageclass/default"
+	l := &storagev1.StorageClassList{}
+	if err := c.Client.List(ctx, l); err != nil {
+		return Check{Name: name, Status: StatusWarn, Message: "cannot list StorageClasses: " + err.Error()}
+	}
+	var defaults []string
+	for _, sc := range l.Items {
+		if sc.Annotations[annotationDefaultStorageClass] == "true" ||
+			sc.Annotations[annotationBetaDefaultStorageClass] == "true" {
+			defaults = append(defaults, sc.Name)
+		}
+	}
+	sort.Strings(defaults)
+	switch len(defaults) {
+	case 0:
+		return Check{Name: name, Status: StatusWarn,
+			Message: "there is no default StorageClass, claims without a storageClassName stay pending"}
+	case 1:
+		return Check{Name: name, Status: StatusPass, Message: defaults[0]}
+	}
+	return Check{Name: name, Status: StatusWarn,
+		Message: "there are several default StorageClasses: " + strings.Join(defaults, ", ")}
+}
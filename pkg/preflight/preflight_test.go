@@ -0,0 +1,114 @@
+package preflight
+
+import (
+	"context"
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	clienttesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/core-resource-controller/pkg/capabilities"
+)
+
+// reviewClient answers access reviews, denying the given verb and resource
+// pairs
+type reviewClient struct {
+	client.Client
+	denied map[string]bool
+}
+
+func (c *reviewClient) Create(ctx context.Context, obj runtime.Object, opts ...client.CreateOption) error {
+	if r, ok := obj.(*authorizationv1.SelfSubjectAccessReview); ok {
+		r.Status.Allowed = !c.denied[describe(*r.Spec.ResourceAttributes)]
+		return nil
+	}
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func TestCheckerRun(t *testing.T) {
+	served := []*metav1.APIResourceList{
+		{GroupVersion: "apps/v1", APIResources: []metav1.APIResource{{Kind: "Deployment"}}},
+		{GroupVersion: "v1", APIResources: []metav1.APIResource{{Kind: "Service"}, {Kind: "Pod"}, {Kind: "Event"}}},
+		{GroupVersion: "admissionregistration.k8s.io/v1beta1", APIResources: []metav1.APIResource{
+			{Kind: "MutatingWebhookConfiguration"}, {Kind: "ValidatingWebhookConfiguration"},
+		}},
+		{GroupVersion: "core.oam.dev/v1alpha2", APIResources: []metav1.APIResource{{Kind: "ContainerizedWorkload"}}},
+	}
+	standard := &storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{
+		Name:        "standard",
+		Annotations: map[string]string{annotationDefaultStorageClass: "true"},
+	}}
+	testCases := map[string]struct {
+		kinds  []string
+		denied map[string]bool
+		objs   []runtime.Object
+		want   map[string]Status
+		failed bool
+	}{
+		"Ready": {
+			kinds: []string{"ContainerizedWorkload"},
+			objs:  []runtime.Object{standard},
+			want: map[string]Status{
+				"api/deployments":              StatusPass,
+				"crd/containerizedworkload":    StatusPass,
+				"webhook/validating-webhooks":  StatusPass,
+				"rbac":                         StatusPass,
+				"storageclass/default":         StatusPass,
+				"optional/keda":                StatusWarn,
+				"optional/cert-manager":        StatusWarn,
+				"webhook/mutating-webhooks":    StatusPass,
+				"optional/prometheus-operator": StatusWarn,
+			},
+		},
+		"MissingCRD": {
+			kinds:  []string{"ContainerizedWorkload", "ManualScalerTrait"},
+			objs:   []runtime.Object{standard},
+			want:   map[string]Status{"crd/containerizedworkload": StatusPass, "crd/manualscalertrait": StatusFail},
+			failed: true,
+		},
+		"Denied": {
+			kinds:  []string{"ContainerizedWorkload"},
+			denied: map[string]bool{"update containerizedworkloads/status.core.oam.dev": true},
+			objs:   []runtime.Object{standard},
+			want:   map[string]Status{"rbac": StatusFail},
+			failed: true,
+		},
+		"NoDefaultStorageClass": {
+			kinds: []string{"ContainerizedWorkload"},
+			want:  map[string]Status{"storageclass/default": StatusWarn},
+		},
+	}
+	s := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(s)
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c := &Checker{
+				Discovery:  &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{Resources: served}},
+				Client:     &reviewClient{Client: fake.NewFakeClientWithScheme(s, tc.objs...), denied: tc.denied},
+				Kinds:      tc.kinds,
+				Reconciled: tc.kinds,
+				Optional:   capabilities.Known,
+			}
+			r := c.Run(context.Background())
+			got := map[string]Check{}
+			for _, check := range r.Checks {
+				got[check.Name] = check
+			}
+			for name, want := range tc.want {
+				if got[name].Status != want {
+					t.Errorf("check %s = %+v, want status %s", name, got[name], want)
+				}
+			}
+			if r.Failed() != tc.failed {
+				t.Errorf("Failed() = %v, want %v: %+v", r.Failed(), tc.failed, r.Checks)
+			}
+		})
+	}
+}
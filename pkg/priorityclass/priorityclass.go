@@ -0,0 +1,95 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package priorityclass restricts the priority classes the PriorityClassTraits
+// of a namespace may set.
+package priorityclass
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// Error strings.
+const (
+	errGetNamespace = "cannot get the namespace"
+	errDecodeTrait  = "cannot decode the priority class trait"
+)
+
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+// +kubebuilder:webhook:verbs=create;update,path=/validate-core-oam-dev-v1alpha2-priorityclass,mutating=false,failurePolicy=fail,groups=core.oam.dev,resources=priorityclasstraits,versions=v1alpha2,name=priorityclass.validate.core.oam.dev
+
+// Path the Validator is served at.
+const Path = "/validate-core-oam-dev-v1alpha2-priorityclass"
+
+// A Validator denies PriorityClassTraits setting a priority class the
+// v1alpha2.AnnotationAllowedPriorityClasses annotation of their namespace does
+// not list.
+type Validator struct {
+	Client client.Client
+}
+
+var _ admission.Handler = &Validator{}
+
+// Handle implements admission.Handler.
+func (v *Validator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Resource.Resource != "priorityclasstraits" ||
+		(req.Operation != admissionv1beta1.Create && req.Operation != admissionv1beta1.Update) {
+		return admission.Allowed("")
+	}
+	var trait v1alpha2.PriorityClassTrait
+	if err := json.Unmarshal(req.Object.Raw, &trait); err != nil {
+		return admission.Errored(http.StatusBadRequest, errors.Wrap(err, errDecodeTrait))
+	}
+
+	var ns corev1.Namespace
+	if err := v.Client.Get(ctx, client.ObjectKey{Name: req.Namespace}, &ns); err != nil {
+		return admission.Errored(http.StatusInternalServerError, errors.Wrap(err, errGetNamespace))
+	}
+	a, ok := ns.GetAnnotations()[v1alpha2.AnnotationAllowedPriorityClasses]
+	if !ok {
+		return admission.Allowed("")
+	}
+	allowed := allowedClasses(a)
+	for _, c := range allowed {
+		if c == trait.Spec.PriorityClassName {
+			return admission.Allowed("")
+		}
+	}
+	return admission.Denied(fmt.Sprintf("priority class %q is not allowed in namespace %s, allowed: %s",
+		trait.Spec.PriorityClassName, req.Namespace, strings.Join(allowed, ", ")))
+}
+
+// the classes listed by an AnnotationAllowedPriorityClasses annotation
+func allowedClasses(annotation string) []string {
+	var classes []string
+	for _, c := range strings.Split(annotation, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			classes = append(classes, c)
+		}
+	}
+	return classes
+}
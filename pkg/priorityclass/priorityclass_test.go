@@ -0,0 +1,72 @@
+package priorityclass
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestValidator(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha2.AddToScheme(scheme)
+
+	restricted := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:        "restricted",
+		Annotations: map[string]string{v1alpha2.AnnotationAllowedPriorityClasses: "business-critical, batch"},
+	}}
+	open := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "open"}}
+	c := fake.NewFakeClientWithScheme(scheme, restricted, open)
+
+	request := func(op admissionv1beta1.Operation, ns, class string) admission.Request {
+		raw, _ := json.Marshal(&v1alpha2.PriorityClassTrait{
+			ObjectMeta: metav1.ObjectMeta{Name: "priority", Namespace: ns},
+			Spec:       v1alpha2.PriorityClassTraitSpec{PriorityClassName: class},
+		})
+		return admission.Request{AdmissionRequest: admissionv1beta1.AdmissionRequest{
+			Operation: op,
+			Namespace: ns,
+			Resource:  metav1.GroupVersionResource{Group: "core.oam.dev", Version: "v1alpha2", Resource: "priorityclasstraits"},
+			Object:    runtime.RawExtension{Raw: raw},
+		}}
+	}
+	testCases := map[string]struct {
+		req  admission.Request
+		want bool
+	}{
+		"Allowed": {
+			req:  request(admissionv1beta1.Create, "restricted", "batch"),
+			want: true,
+		},
+		"NotAllowed": {
+			req:  request(admissionv1beta1.Create, "restricted", "system-cluster-critical"),
+			want: false,
+		},
+		"UpdateNotAllowed": {
+			req:  request(admissionv1beta1.Update, "restricted", "system-cluster-critical"),
+			want: false,
+		},
+		"NoAllowlist": {
+			req:  request(admissionv1beta1.Create, "open", "system-cluster-critical"),
+			want: true,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			v := &Validator{Client: c}
+			if got := v.Handle(context.Background(), testCase.req); got.Allowed != testCase.want {
+				t.Errorf("Handle() allowed = %v, want %v: %v", got.Allowed, testCase.want, got.Result)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package quota limits the number of OAM objects a namespace may hold.
+package quota
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// AnnotationPrefix of the namespace annotations overriding the quota of a
+// resource, e.g. quota.core.oam.dev/manualscalertraits: "20".
+const AnnotationPrefix = "quota.core.oam.dev/"
+
+// Error strings.
+const (
+	errGetNamespace = "cannot get the namespace"
+	errParseQuota   = "cannot parse the quota annotation"
+	errListObjects  = "cannot count the existing objects"
+)
+
+// the resources a quota applies to
+var lists = map[string]func() runtime.Object{
+	"containerizedworkloads":   func() runtime.Object { return &v1alpha2.ContainerizedWorkloadList{} },
+	"manualscalertraits":       func() runtime.Object { return &v1alpha2.ManualScalerTraitList{} },
+	"kedascalertraits":         func() runtime.Object { return &v1alpha2.KEDAScalerTraitList{} },
+	"patchtraits":              func() runtime.Object { return &v1alpha2.PatchTraitList{} },
+	"inittraits":               func() runtime.Object { return &v1alpha2.InitTraitList{} },
+	"spreadtraits":             func() runtime.Object { return &v1alpha2.SpreadTraitList{} },
+	"verticalscalertraits":     func() runtime.Object { return &v1alpha2.VerticalScalerTraitList{} },
+	"runtimeclasstraits":       func() runtime.Object { return &v1alpha2.RuntimeClassTraitList{} },
+	"priorityclasstraits":      func() runtime.Object { return &v1alpha2.PriorityClassTraitList{} },
+	"identitytraits":           func() runtime.Object { return &v1alpha2.IdentityTraitList{} },
+	"deploymentstrategytraits": func() runtime.Object { return &v1alpha2.DeploymentStrategyTraitList{} },
+	"helmcharttraits":          func() runtime.Object { return &v1alpha2.HelmChartTraitList{} },
+	"templatedworkloads":       func() runtime.Object { return &v1alpha2.TemplatedWorkloadList{} },
+	"costtraits":               func() runtime.Object { return &v1alpha2.CostTraitList{} },
+	"restarttraits":            func() runtime.Object { return &v1alpha2.RestartTraitList{} },
+	"debugtraits":              func() runtime.Object { return &v1alpha2.DebugTraitList{} },
+	"scratchstoragetraits":     func() runtime.Object { return &v1alpha2.ScratchStorageTraitList{} },
+}
+
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+// +kubebuilder:webhook:verbs=create,path=/validate-core-oam-dev-v1alpha2-quota,mutating=false,failurePolicy=fail,groups=core.oam.dev,resources=containerizedworkloads;manualscalertraits;kedascalertraits;patchtraits;inittraits;spreadtraits;verticalscalertraits;runtimeclasstraits;priorityclasstraits;identitytraits;deploymentstrategytraits;helmcharttraits;templatedworkloads;costtraits;restarttraits;debugtraits;scratchstoragetraits,versions=v1alpha2,name=quota.validate.core.oam.dev
+
+// Path the Validator is served at.
+const Path = "/validate-core-oam-dev-v1alpha2-quota"
+
+// A Validator denies the creation of OAM objects once their namespace holds
+// as many of them as its quota allows.
+type Validator struct {
+	Client client.Client
+	// Default quota of namespaces without a quota annotation for the
+	// resource. Zero or less means unlimited.
+	Default int
+}
+
+var _ admission.Handler = &Validator{}
+
+// Handle implements admission.Handler.
+func (v *Validator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	newList, ok := lists[req.Resource.Resource]
+	if !ok || req.Operation != admissionv1beta1.Create {
+		return admission.Allowed("")
+	}
+
+	limit, err := v.limit(ctx, req.Namespace, req.Resource.Resource)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if limit <= 0 {
+		return admission.Allowed("")
+	}
+
+	l := newList()
+	if err := v.Client.List(ctx, l, client.InNamespace(req.Namespace)); err != nil {
+		return admission.Errored(http.StatusInternalServerError, errors.Wrap(err, errListObjects))
+	}
+	if n := meta.LenList(l); n >= limit {
+		return admission.Denied(fmt.Sprintf("namespace %s already holds %d %s, its quota is %d",
+			req.Namespace, n, req.Resource.Resource, limit))
+	}
+	return admission.Allowed("")
+}
+
+// the quota of a resource in a namespace
+func (v *Validator) limit(ctx context.Context, namespace, resource string) (int, error) {
+	var ns corev1.Namespace
+	if err := v.Client.Get(ctx, client.ObjectKey{Name: namespace}, &ns); err != nil {
+		return 0, errors.Wrap(err, errGetNamespace)
+	}
+	a, ok := ns.GetAnnotations()[AnnotationPrefix+resource]
+	if !ok {
+		return v.Default, nil
+	}
+	limit, err := strconv.Atoi(a)
+	return limit, errors.Wrap(err, errParseQuota)
+}
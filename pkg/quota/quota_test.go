@@ -0,0 +1,75 @@
+package quota
+
+import (
+	"context"
+	"testing"
+
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestValidator(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha2.AddToScheme(scheme)
+
+	limited := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:        "limited",
+		Annotations: map[string]string{AnnotationPrefix + "manualscalertraits": "1"},
+	}}
+	open := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "open"}}
+	existing := func(ns string) *v1alpha2.ManualScalerTrait {
+		return &v1alpha2.ManualScalerTrait{ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: ns}}
+	}
+	c := fake.NewFakeClientWithScheme(scheme, limited, open, existing("limited"), existing("open"))
+
+	request := func(op admissionv1beta1.Operation, ns, resource string) admission.Request {
+		return admission.Request{AdmissionRequest: admissionv1beta1.AdmissionRequest{
+			Operation: op,
+			Namespace: ns,
+			Resource:  metav1.GroupVersionResource{Group: "core.oam.dev", Version: "v1alpha2", Resource: resource},
+		}}
+	}
+	testCases := map[string]struct {
+		defaultQuota int
+		req          admission.Request
+		want         bool
+	}{
+		"AnnotationQuotaReached": {
+			req:  request(admissionv1beta1.Create, "limited", "manualscalertraits"),
+			want: false,
+		},
+		"AnnotationOnlyAppliesToItsResource": {
+			req:  request(admissionv1beta1.Create, "limited", "containerizedworkloads"),
+			want: true,
+		},
+		"Unlimited": {
+			req:  request(admissionv1beta1.Create, "open", "manualscalertraits"),
+			want: true,
+		},
+		"DefaultQuotaReached": {
+			defaultQuota: 1,
+			req:          request(admissionv1beta1.Create, "open", "manualscalertraits"),
+			want:         false,
+		},
+		"UpdatesAreAllowed": {
+			req:  request(admissionv1beta1.Update, "limited", "manualscalertraits"),
+			want: true,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			v := &Validator{Client: c, Default: testCase.defaultQuota}
+			if got := v.Handle(context.Background(), testCase.req); got.Allowed != testCase.want {
+				t.Errorf("Handle() allowed = %v, want %v: %v", got.Allowed, testCase.want, got.Result)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package renderhook lets external webhooks change a workload before it is
+// rendered and the objects rendered from it before they are applied, so that
+// platform teams can add their own mutations without forking the controller.
+package renderhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Error strings.
+const (
+	errEncodeReview = "cannot encode the render review"
+	errCallHook     = "cannot call the render hook"
+	errDecodeReview = "cannot decode the render review"
+)
+
+// A Phase of rendering a hook is called in.
+type Phase string
+
+// Phases of rendering.
+const (
+	// PreRender hooks are called with the workload before it is rendered.
+	PreRender Phase = "PreRender"
+	// PostRender hooks are called with the objects rendered from the
+	// workload before they are applied.
+	PostRender Phase = "PostRender"
+)
+
+// A Review is posted to a hook, which answers with the review changed.
+type Review struct {
+	// Phase the hook is called in.
+	Phase Phase `json:"phase"`
+	// Workload being rendered. Pre-render hooks may change its spec.
+	Workload *unstructured.Unstructured `json:"workload"`
+	// Objects rendered from the workload, in the PostRender phase. Hooks may
+	// change them but not add, remove or rename any.
+	Objects []*unstructured.Unstructured `json:"objects,omitempty"`
+}
+
+// A Hook changes a review. It returns an error if it cannot.
+type Hook interface {
+	Call(ctx context.Context, review *Review) (*Review, error)
+}
+
+// A HookFunc is a function used as a Hook.
+type HookFunc func(ctx context.Context, review *Review) (*Review, error)
+
+// Call calls fn.
+func (fn HookFunc) Call(ctx context.Context, review *Review) (*Review, error) {
+	return fn(ctx, review)
+}
+
+// An HTTPHook posts reviews to a URL as JSON and reads the changed review
+// from the response.
+type HTTPHook struct {
+	URL    string
+	Client *http.Client
+}
+
+// NewHTTPHook returns an HTTPHook posting to url.
+func NewHTTPHook(url string) *HTTPHook {
+	return &HTTPHook{URL: url, Client: &http.Client{Timeout: 5 * time.Second}}
+}
+
+// Call posts the review to the hook.
+func (h *HTTPHook) Call(ctx context.Context, review *Review) (*Review, error) {
+	body, err := json.Marshal(review)
+	if err != nil {
+		return nil, errors.Wrap(err, errEncodeReview)
+	}
+	hr, err := http.NewRequest(http.MethodPost, h.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, errCallHook)
+	}
+	hr.Header.Set("Content-Type", "application/json")
+	resp, err := h.Client.Do(hr.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, errCallHook)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("%s: unexpected status %s", errCallHook, resp.Status)
+	}
+
+	changed := &Review{}
+	if err := json.NewDecoder(resp.Body).Decode(changed); err != nil {
+		return nil, errors.Wrap(err, errDecodeReview)
+	}
+	if changed.Phase != review.Phase || changed.Workload == nil {
+		return nil, errors.Errorf("%s: the hook did not return the %s review", errDecodeReview, review.Phase)
+	}
+	return changed, nil
+}
@@ -0,0 +1,66 @@
+package renderhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestHTTPHookCall(t *testing.T) {
+	workload := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "core.oam.dev/v1alpha2",
+		"kind":       "ContainerizedWorkload",
+		"metadata":   map[string]interface{}{"namespace": "default", "name": "web"},
+	}}
+	testCases := map[string]struct {
+		response string
+		status   int
+		wantErr  bool
+	}{
+		"Changed": {
+			response: `{"phase": "PreRender", "workload": {"apiVersion": "core.oam.dev/v1alpha2",
+				"kind": "ContainerizedWorkload", "metadata": {"name": "web", "labels": {"team": "orders"}}}}`,
+			status: http.StatusOK,
+		},
+		"OtherPhase": {
+			response: `{"phase": "PostRender", "workload": {"kind": "ContainerizedWorkload"}}`,
+			status:   http.StatusOK,
+			wantErr:  true,
+		},
+		"NoWorkload": {
+			response: `{"phase": "PreRender"}`,
+			status:   http.StatusOK,
+			wantErr:  true,
+		},
+		"ServerError": {
+			status:  http.StatusInternalServerError,
+			wantErr: true,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				in := &Review{}
+				if err := json.NewDecoder(r.Body).Decode(in); err != nil || in.Phase != PreRender ||
+					in.Workload.GetName() != "web" {
+					t.Errorf("review = %+v, %v, want the PreRender review of web", in, err)
+				}
+				w.WriteHeader(testCase.status)
+				_, _ = w.Write([]byte(testCase.response))
+			}))
+			defer srv.Close()
+
+			got, err := NewHTTPHook(srv.URL).Call(context.Background(), &Review{Phase: PreRender, Workload: workload})
+			if (err != nil) != testCase.wantErr {
+				t.Fatalf("Call() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+			if err == nil && got.Workload.GetLabels()["team"] != "orders" {
+				t.Errorf("Call() = %v, want the workload labelled team=orders", got.Workload)
+			}
+		})
+	}
+}
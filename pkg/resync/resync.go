@@ -0,0 +1,88 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resync makes the controllers reconcile every OAM object of a
+// namespace, e.g. after a CRD upgrade or an RBAC fix, without restarting the
+// manager.
+package resync
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// Error strings.
+const (
+	errListObjects = "cannot list the objects"
+	errPatchObject = "cannot annotate the object"
+)
+
+// kinds of v1alpha2 that are not namespaced
+var clusterScoped = map[string]bool{"ResourceQuotaScope": true, "ResourceTracker": true, "WorkloadTemplate": true}
+
+// Kinds returns the namespaced kinds of v1alpha2 known to the scheme, sorted.
+func Kinds(s *runtime.Scheme) []string {
+	var kinds []string
+	for kind := range s.KnownTypes(v1alpha2.GroupVersion) {
+		item := strings.TrimSuffix(kind, "List")
+		if item == kind || clusterScoped[item] {
+			continue
+		}
+		if _, ok := s.KnownTypes(v1alpha2.GroupVersion)[item]; ok {
+			kinds = append(kinds, item)
+		}
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// Namespace sets the v1alpha2.AnnotationForceSync annotation of every object
+// of the kinds in the namespace to value, e.g. the current time. The change
+// makes their controllers reconcile them right away, even if the writes of
+// the namespace are rate limited. It returns the number of objects
+// annotated.
+func Namespace(ctx context.Context, c client.Client, namespace string, kinds []string, value string) (int, error) {
+	n := 0
+	for _, kind := range kinds {
+		l := &unstructured.UnstructuredList{}
+		l.SetGroupVersionKind(v1alpha2.GroupVersion.WithKind(kind + "List"))
+		if err := c.List(ctx, l, client.InNamespace(namespace)); err != nil {
+			return n, errors.Wrapf(err, "%s %s", errListObjects, kind)
+		}
+		for i := range l.Items {
+			obj := &l.Items[i]
+			patch := client.MergeFrom(obj.DeepCopy())
+			annotations := obj.GetAnnotations()
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			annotations[v1alpha2.AnnotationForceSync] = value
+			obj.SetAnnotations(annotations)
+			if err := c.Patch(ctx, obj, patch); err != nil {
+				return n, errors.Wrapf(err, "%s %s %s", errPatchObject, kind, obj.GetName())
+			}
+			n++
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,54 @@
+package resync
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+func TestNamespace(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha2.AddToScheme(scheme)
+
+	kinds := Kinds(scheme)
+	if !sort.StringsAreSorted(kinds) || len(kinds) == 0 || kinds[0] != "ContainerizedWorkload" {
+		t.Errorf("Kinds() = %v", kinds)
+	}
+	for _, k := range kinds {
+		if k == "ResourceTracker" {
+			t.Errorf("Kinds() includes the cluster scoped ResourceTracker")
+		}
+	}
+
+	workload := &v1alpha2.ContainerizedWorkload{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "apps"}}
+	trait := &v1alpha2.ManualScalerTrait{ObjectMeta: metav1.ObjectMeta{Name: "scaler", Namespace: "apps"}}
+	other := &v1alpha2.ManualScalerTrait{ObjectMeta: metav1.ObjectMeta{Name: "scaler", Namespace: "other"}}
+	c := fake.NewFakeClientWithScheme(scheme, workload, trait, other)
+
+	const now = "2020-04-01T10:00:00Z"
+	n, err := Namespace(context.Background(), c, "apps", kinds, now)
+	if err != nil {
+		t.Fatalf("Namespace() = %v", err)
+	}
+	if n != 2 {
+		t.Errorf("Namespace() annotated %d objects, want 2", n)
+	}
+	for obj, want := range map[runtime.Object]string{workload: now, trait: now, other: ""} {
+		m := obj.(metav1.Object)
+		if err := c.Get(context.Background(), client.ObjectKey{Namespace: m.GetNamespace(), Name: m.GetName()}, obj); err != nil {
+			t.Fatal(err)
+		}
+		if got := m.GetAnnotations()[v1alpha2.AnnotationForceSync]; got != want {
+			t.Errorf("%s/%s force sync annotation = %q, want %q", m.GetNamespace(), m.GetName(), got, want)
+		}
+	}
+}
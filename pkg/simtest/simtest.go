@@ -0,0 +1,305 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package simtest simulates a ContainerizedWorkload and the children it
+// rendered so that trait reconcilers can be unit tested without a control
+// plane. A Harness is a fake client that records the patches reconcilers make:
+//
+//	h, err := simtest.New(workload, []runtime.Object{deploy}, trait)
+//	r := &controllers.ManualScalerTraitReconciler{Client: h, Scheme: h.Scheme, Log: log}
+//	_, err = h.Reconcile(r, trait)
+//	h.AssertPatched(t, deploy, "spec.replicas", 3)
+package simtest
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/oam-dev/core-resource-controller/api/v1alpha2"
+)
+
+// A Patch made through a Harness.
+type Patch struct {
+	schema.GroupVersionKind
+	Namespace string
+	Name      string
+	Type      types.PatchType
+	Data      []byte
+	// Status is true for patches of the status subresource.
+	Status bool
+}
+
+// A Harness is a fake client holding a workload, its children and the
+// objects under test.
+type Harness struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Workload *v1alpha2.ContainerizedWorkload
+
+	mu      sync.Mutex
+	patches []Patch
+}
+
+// New returns a Harness holding the workload, its children and the other
+// objects, e.g. the traits under test, along with the workload's namespace
+// unless the objects include it. The workload's status lists the
+// children the way the ContainerizedWorkload controller does. The workload
+// and children without a UID get one.
+func New(workload *v1alpha2.ContainerizedWorkload, children []runtime.Object, objs ...runtime.Object) (*Harness, error) {
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		return nil, err
+	}
+	if err := v1alpha2.AddToScheme(s); err != nil {
+		return nil, err
+	}
+
+	w := workload.DeepCopy()
+	if w.UID == "" {
+		w.UID = types.UID(w.Name + "-uid")
+	}
+	w.Status.Resources = nil
+	all := []runtime.Object{w}
+	for _, c := range children {
+		c = c.DeepCopyObject()
+		m, err := meta.Accessor(c)
+		if err != nil {
+			return nil, err
+		}
+		if m.GetUID() == "" {
+			m.SetUID(types.UID(m.GetName() + "-uid"))
+		}
+		gvk, err := apiutil.GVKForObject(c, s)
+		if err != nil {
+			return nil, err
+		}
+		uid := m.GetUID()
+		w.Status.Resources = append(w.Status.Resources, v1alpha2.ResourceReference{
+			APIVersion: gvk.GroupVersion().String(),
+			Kind:       gvk.Kind,
+			Name:       m.GetName(),
+			UID:        &uid,
+		})
+		all = append(all, c)
+	}
+	all = append(all, objs...)
+	if !hasNamespace(all, w.Namespace) {
+		all = append(all, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: w.Namespace}})
+	}
+	return &Harness{Client: fake.NewFakeClientWithScheme(s, all...), Scheme: s, Workload: w}, nil
+}
+
+// controllers read the namespace, e.g. for its maintenance windows
+func hasNamespace(objs []runtime.Object, name string) bool {
+	for _, o := range objs {
+		if ns, ok := o.(*corev1.Namespace); ok && ns.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// WorkloadReference returns the reference traits use to point at the
+// workload of the Harness.
+func (h *Harness) WorkloadReference() v1alpha2.ResourceReference {
+	uid := h.Workload.UID
+	return v1alpha2.ResourceReference{
+		APIVersion: v1alpha2.GroupVersion.String(),
+		Kind:       "ContainerizedWorkload",
+		Name:       h.Workload.Name,
+		UID:        &uid,
+	}
+}
+
+// Reconcile runs the reconciler for the object.
+func (h *Harness) Reconcile(r reconcile.Reconciler, obj runtime.Object) (reconcile.Result, error) {
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	return r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{
+		Namespace: m.GetNamespace(),
+		Name:      m.GetName(),
+	}})
+}
+
+// Patch records the patch and applies it. The fake client cannot server side
+// apply, so an applied configuration is merged into the existing object or,
+// if there is none, created.
+func (h *Harness) Patch(ctx context.Context, obj runtime.Object, patch client.Patch,
+	opts ...client.PatchOption) error {
+	data, err := h.record(obj, patch, false)
+	if err != nil {
+		return err
+	}
+	if patch.Type() != types.ApplyPatchType {
+		return h.Client.Patch(ctx, obj, patch, opts...)
+	}
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	existing := obj.DeepCopyObject()
+	err = h.Client.Get(ctx, client.ObjectKey{Namespace: m.GetNamespace(), Name: m.GetName()}, existing)
+	if kerrors.IsNotFound(err) {
+		return h.Client.Create(ctx, obj)
+	}
+	if err != nil {
+		return err
+	}
+	return h.Client.Patch(ctx, obj, client.ConstantPatch(types.MergePatchType, data))
+}
+
+// Status returns a status writer recording its patches.
+func (h *Harness) Status() client.StatusWriter {
+	return &statusWriter{StatusWriter: h.Client.Status(), harness: h}
+}
+
+type statusWriter struct {
+	client.StatusWriter
+	harness *Harness
+}
+
+func (sw *statusWriter) Patch(ctx context.Context, obj runtime.Object, patch client.Patch,
+	opts ...client.PatchOption) error {
+	if _, err := sw.harness.record(obj, patch, true); err != nil {
+		return err
+	}
+	return sw.StatusWriter.Patch(ctx, obj, patch, opts...)
+}
+
+func (h *Harness) record(obj runtime.Object, patch client.Patch, status bool) ([]byte, error) {
+	data, err := patch.Data(obj)
+	if err != nil {
+		return nil, err
+	}
+	gvk, err := apiutil.GVKForObject(obj, h.Scheme)
+	if err != nil {
+		return nil, err
+	}
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.patches = append(h.patches, Patch{
+		GroupVersionKind: gvk,
+		Namespace:        m.GetNamespace(),
+		Name:             m.GetName(),
+		Type:             patch.Type(),
+		Data:             data,
+		Status:           status,
+	})
+	return data, nil
+}
+
+// Patches returns the patches made so far, oldest first.
+func (h *Harness) Patches() []Patch {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]Patch(nil), h.patches...)
+}
+
+// lastPatch returns the last patch of the object.
+func (h *Harness) lastPatch(obj runtime.Object) (Patch, bool, error) {
+	gvk, err := apiutil.GVKForObject(obj, h.Scheme)
+	if err != nil {
+		return Patch{}, false, err
+	}
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return Patch{}, false, err
+	}
+	patches := h.Patches()
+	for i := len(patches) - 1; i >= 0; i-- {
+		p := patches[i]
+		if p.GroupVersionKind == gvk && p.Namespace == m.GetNamespace() && p.Name == m.GetName() {
+			return p, true, nil
+		}
+	}
+	return Patch{}, false, nil
+}
+
+// AssertPatched fails the test unless the last patch of the object sets the
+// field at the dot separated path, e.g. spec.replicas, to want.
+func (h *Harness) AssertPatched(t testing.TB, obj runtime.Object, path string, want interface{}) {
+	t.Helper()
+	p, ok, err := h.lastPatch(obj)
+	if err != nil {
+		t.Fatalf("cannot find the patches of %T: %v", obj, err)
+	}
+	if !ok {
+		t.Errorf("%s was not patched", describe(obj))
+		return
+	}
+	var patched map[string]interface{}
+	if err := json.Unmarshal(p.Data, &patched); err != nil {
+		t.Fatalf("cannot parse the %s patch of %s: %v", p.Type, describe(obj), err)
+	}
+	got, found, err := unstructured.NestedFieldNoCopy(patched, strings.Split(path, ".")...)
+	if err != nil || !found {
+		t.Errorf("the last patch of %s does not set %s: %s", describe(obj), path, p.Data)
+		return
+	}
+	// compare the JSON forms, the patch holds e.g. numbers as float64
+	w, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("cannot marshal %v: %v", want, err)
+	}
+	var wantJSON interface{}
+	_ = json.Unmarshal(w, &wantJSON)
+	if !reflect.DeepEqual(got, wantJSON) {
+		t.Errorf("the last patch of %s sets %s to %v, want %v", describe(obj), path, got, wantJSON)
+	}
+}
+
+// AssertNotPatched fails the test if the object was patched.
+func (h *Harness) AssertNotPatched(t testing.TB, obj runtime.Object) {
+	t.Helper()
+	p, ok, err := h.lastPatch(obj)
+	if err != nil {
+		t.Fatalf("cannot find the patches of %T: %v", obj, err)
+	}
+	if ok {
+		t.Errorf("%s was patched: %s", describe(obj), p.Data)
+	}
+}
+
+func describe(obj runtime.Object) string {
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return reflect.TypeOf(obj).String()
+	}
+	return reflect.TypeOf(obj).Elem().Name() + " " + m.GetNamespace() + "/" + m.GetName()
+}
@@ -0,0 +1,103 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package workloadtemplate renders the children of a TemplatedWorkload from
+// the Go template of its WorkloadTemplate, e.g.
+//
+//	apiVersion: apps/v1
+//	kind: Deployment
+//	metadata:
+//	  name: {{ .Workload.Name }}
+//	spec:
+//	  replicas: {{ .Parameters.replicas | default 1 }}
+//
+// The template renders a YAML stream, one manifest per document.
+package workloadtemplate
+
+import (
+	"encoding/json"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/oam-dev/core-resource-controller/pkg/bundle"
+	"github.com/oam-dev/core-resource-controller/pkg/envtemplate"
+)
+
+// Error strings.
+const (
+	errParseTemplate   = "cannot parse the template"
+	errExecuteTemplate = "cannot render the template"
+	errParseManifests  = "cannot parse the rendered manifests"
+	errMissingKind     = "a rendered manifest has no apiVersion or kind"
+	errMissingName     = "a rendered manifest has no name"
+)
+
+// funcs templates may call.
+var funcs = template.FuncMap{
+	// toJSON renders a value as JSON, which is valid YAML, e.g. to copy a
+	// list or an object of the parameters into a manifest.
+	"toJSON": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+	// default returns the value unless it is missing or empty.
+	"default": func(def, v interface{}) interface{} {
+		if v == nil || v == "" {
+			return def
+		}
+		return v
+	},
+}
+
+// Data templates are rendered with.
+type Data struct {
+	Workload   envtemplate.Workload
+	Parameters map[string]interface{}
+}
+
+// Render returns the manifests the template renders with the data. Missing
+// parameters render as "<no value>" unless a default is given.
+func Render(text string, data Data) ([]*unstructured.Unstructured, error) {
+	t, err := template.New("workload").Funcs(funcs).Parse(text)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseTemplate)
+	}
+	var b strings.Builder
+	if err := t.Execute(&b, data); err != nil {
+		return nil, errors.Wrap(err, errExecuteTemplate)
+	}
+	objs, err := bundle.Parse([]byte(b.String()))
+	if err != nil {
+		return nil, errors.Wrap(err, errParseManifests)
+	}
+	for _, obj := range objs {
+		if obj.GetAPIVersion() == "" || obj.GetKind() == "" {
+			return nil, errors.New(errMissingKind)
+		}
+		if obj.GetName() == "" {
+			return nil, errors.Errorf("%s: %s", errMissingName, obj.GetKind())
+		}
+	}
+	return objs, nil
+}
+
+// Validate returns an error if the template cannot be parsed.
+func Validate(text string) error {
+	_, err := template.New("workload").Funcs(funcs).Parse(text)
+	return errors.Wrap(err, errParseTemplate)
+}
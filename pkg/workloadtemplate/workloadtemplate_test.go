@@ -0,0 +1,80 @@
+package workloadtemplate
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/oam-dev/core-resource-controller/pkg/envtemplate"
+)
+
+func TestRender(t *testing.T) {
+	data := Data{
+		Workload:   envtemplate.Workload{Name: "web", Namespace: "shop"},
+		Parameters: map[string]interface{}{"image": "nginx", "ports": []interface{}{80.0, 443.0}},
+	}
+	testCases := map[string]struct {
+		text    string
+		want    []map[string]interface{}
+		wantErr bool
+	}{
+		"Stream": {
+			text: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .Workload.Name }}
+spec:
+  replicas: {{ .Parameters.replicas | default 2 }}
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: {{ .Workload.Name }}-svc
+spec:
+  ports: {{ toJSON .Parameters.ports }}
+`,
+			want: []map[string]interface{}{{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "web"},
+				"spec":       map[string]interface{}{"replicas": float64(2)},
+			}, {
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata":   map[string]interface{}{"name": "web-svc"},
+				"spec":       map[string]interface{}{"ports": []interface{}{80.0, 443.0}},
+			}},
+		},
+		"MissingName": {
+			text:    "apiVersion: v1\nkind: Service\n",
+			wantErr: true,
+		},
+		"MissingKind": {
+			text:    "metadata:\n  name: {{ .Workload.Name }}\n",
+			wantErr: true,
+		},
+		"UnknownField": {
+			text:    "{{ .Workload.Image }}",
+			wantErr: true,
+		},
+		"Unparsable": {
+			text:    "{{ .Workload.Name",
+			wantErr: true,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			objs, err := Render(testCase.text, data)
+			if (err != nil) != testCase.wantErr {
+				t.Fatalf("Render() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+			var got []map[string]interface{}
+			for _, obj := range objs {
+				got = append(got, obj.Object)
+			}
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("Render() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}